@@ -6,6 +6,7 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/git"
 	"github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/mgmtfetcher"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/templates"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/estimation"
 	gitauth "github.com/weaveworks/weave-gitops-enterprise/pkg/gitauth/server"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/helm"
@@ -13,6 +14,7 @@ import (
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/monitoring/metrics"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/monitoring/profiling"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/collector"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/configuration"
 	"github.com/weaveworks/weave-gitops/core/clustersmngr"
 	core "github.com/weaveworks/weave-gitops/core/server"
 	"github.com/weaveworks/weave-gitops/pkg/kube"
@@ -56,12 +58,23 @@ type Options struct {
 	ManagementFetcher         *mgmtfetcher.ManagementCrossNamespacesFetcher
 	Cluster                   string
 	Estimator                 estimation.Estimator
+	TemplateSchemaValidator   templates.SchemaValidator
 	UIConfig                  string
 	PipelineControllerAddress string
 	CollectorServiceAccount   collector.ImpersonateServiceAccount
 	MonitoringOptions         monitoring.Options
 	ExplorerCleanerDisabled   bool
 	ExplorerEnabledFor        []string
+	ExplorerStoreType         string
+	ExplorerStoreURI          string
+	ExplorerObjectKindsFile   string
+	ExplorerSavedQueriesFile  string
+	ExplorerQueriesReadOnly   bool
+	ExplorerShardingEnabled   bool
+	ExplorerShardingNamespace string
+	ExplorerEventsEnabled     bool
+	ExplorerEventFilter       configuration.EventFilter
+	ExplorerEventsRetention   configuration.RetentionPolicy
 }
 
 type Option func(*Options)
@@ -247,6 +260,14 @@ func WithTemplateCostEstimator(estimator estimation.Estimator) Option {
 	}
 }
 
+// WithTemplateSchemaValidator is used to validate the resources rendered
+// from templates against the schemas of their kinds.
+func WithTemplateSchemaValidator(validator templates.SchemaValidator) Option {
+	return func(o *Options) {
+		o.TemplateSchemaValidator = validator
+	}
+}
+
 func WithUIConfig(uiConfig string) Option {
 	return func(o *Options) {
 		o.UIConfig = uiConfig
@@ -300,6 +321,49 @@ func WithExplorerEnabledFor(enabledFor []string) Option {
 	}
 }
 
+// WithExplorerStore configures the storage backend used by the explorer
+func WithExplorerStore(storeType, uri string) Option {
+	return func(o *Options) {
+		o.ExplorerStoreType = storeType
+		o.ExplorerStoreURI = uri
+	}
+}
+
+// WithExplorerObjectKindsFile configures the file defining additional object kinds for the explorer
+func WithExplorerObjectKindsFile(path string) Option {
+	return func(o *Options) {
+		o.ExplorerObjectKindsFile = path
+	}
+}
+
+// WithExplorerSavedQueries configures the file of curated saved queries for the explorer,
+// and whether users can save their own queries
+func WithExplorerSavedQueries(path string, readOnly bool) Option {
+	return func(o *Options) {
+		o.ExplorerSavedQueriesFile = path
+		o.ExplorerQueriesReadOnly = readOnly
+	}
+}
+
+// WithExplorerSharding configures whether the explorer replicas share the clusters to collect,
+// coordinating through leases in the given namespace
+func WithExplorerSharding(enabled bool, namespace string) Option {
+	return func(o *Options) {
+		o.ExplorerShardingEnabled = enabled
+		o.ExplorerShardingNamespace = namespace
+	}
+}
+
+// WithExplorerEvents configures whether the explorer collects the Kubernetes events matching the filter,
+// and for how long they are kept
+func WithExplorerEvents(enabled bool, filter configuration.EventFilter, retention configuration.RetentionPolicy) Option {
+	return func(o *Options) {
+		o.ExplorerEventsEnabled = enabled
+		o.ExplorerEventFilter = filter
+		o.ExplorerEventsRetention = retention
+	}
+}
+
 func WithRoutePrefix(routePrefix string) Option {
 	return func(o *Options) {
 		o.RoutePrefix = routePrefix
@@ -50,6 +50,7 @@ import (
 	"github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/mgmtfetcher"
 	capi_proto "github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/protos"
 	"github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/server"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/templates"
 	"github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/version"
 	"github.com/weaveworks/weave-gitops-enterprise/common/entitlement"
 	gitauth "github.com/weaveworks/weave-gitops-enterprise/pkg/api/gitauth"
@@ -86,6 +87,7 @@ import (
 	"google.golang.org/protobuf/reflect/protoreflect"
 	authv1 "k8s.io/api/authentication/v1"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/discovery"
@@ -119,6 +121,53 @@ func EnterprisePublicRoutes() []string {
 	return core.PublicRoutes
 }
 
+// streamingRoutes are the API routes that stream their responses.
+var streamingRoutes = []string{
+	"/v1/query/watch",
+	"/v1/query/export",
+}
+
+// loadAndSaveSession loads the session for every request. LoadAndSave buffers the whole response
+// to add the session cookie, which would hold back streams until they end, so streaming routes
+// only load the session: they read it but never change it.
+func loadAndSaveSession(sm auth.SessionManager, next http.Handler) http.Handler {
+	buffered := sm.LoadAndSave(next)
+
+	scsManager, ok := sm.(*scs.SessionManager)
+	if !ok {
+		return buffered
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !isStreamingRoute(r.URL.Path) {
+			buffered.ServeHTTP(w, r)
+			return
+		}
+
+		var token string
+		if cookie, err := r.Cookie(scsManager.Cookie.Name); err == nil {
+			token = cookie.Value
+		}
+
+		ctx, err := scsManager.Load(r.Context(), token)
+		if err != nil {
+			scsManager.ErrorFunc(w, r, err)
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func isStreamingRoute(path string) bool {
+	for _, route := range streamingRoutes {
+		if strings.HasSuffix(path, route) {
+			return true
+		}
+	}
+	return false
+}
+
 // Options contains all the options for the `ui run` command.
 type Params struct {
 	EntitlementSecretName             string                    `mapstructure:"entitlement-secret-name"`
@@ -166,6 +215,18 @@ type Params struct {
 	ExplorerCleanerDisabled           bool                      `mapstructure:"explorer-cleaner-disabled"`
 	NoAuthUser                        string                    `mapstructure:"insecure-no-authentication-user"`
 	ExplorerEnabledFor                []string                  `mapstructure:"explorer-enabled-for"`
+	ExplorerStoreType                 string                    `mapstructure:"explorer-store-type"`
+	ExplorerStoreURI                  string                    `mapstructure:"explorer-store-uri"`
+	ExplorerObjectKindsFile           string                    `mapstructure:"explorer-object-kinds-file"`
+	ExplorerSavedQueriesFile          string                    `mapstructure:"explorer-saved-queries-file"`
+	ExplorerSavedQueriesReadOnly      bool                      `mapstructure:"explorer-saved-queries-read-only"`
+	ExplorerShardingEnabled           bool                      `mapstructure:"explorer-sharding-enabled"`
+	ExplorerShardingNamespace         string                    `mapstructure:"explorer-sharding-namespace"`
+	ExplorerEventsEnabled             bool                      `mapstructure:"explorer-events-enabled"`
+	ExplorerEventsReasons             []string                  `mapstructure:"explorer-events-reasons"`
+	ExplorerEventsInvolvedKinds       []string                  `mapstructure:"explorer-events-involved-kinds"`
+	ExplorerEventsWarningsOnly        bool                      `mapstructure:"explorer-events-warnings-only"`
+	ExplorerEventsRetention           time.Duration             `mapstructure:"explorer-events-retention"`
 }
 
 type OIDCAuthenticationOptions struct {
@@ -270,6 +331,18 @@ func NewAPIServerCommand() *cobra.Command {
 	cmdFlags.String("collector-serviceaccount-namespace", "", "namespace of the serviceaccount that collector impersonates to watch leaf clusters.")
 	cmdFlags.Bool("explorer-cleaner-disabled", false, "Enables the Explorer object cleaner that manages retaining objects")
 	cmdFlags.StringSlice("explorer-enabled-for", []string{}, "List of components that the Explorer is enabled for")
+	cmdFlags.String("explorer-store-type", "sqlite", "Storage backend for the Explorer: sqlite or postgres")
+	cmdFlags.String("explorer-store-uri", "", "Connection string of the Explorer store. Required for postgres")
+	cmdFlags.String("explorer-object-kinds-file", "", "Path to a file defining object kinds that the Explorer collects in addition to the supported ones")
+	cmdFlags.String("explorer-saved-queries-file", "", "Path to a file defining saved queries that are published to every Explorer user")
+	cmdFlags.Bool("explorer-saved-queries-read-only", false, "Only serves the saved queries of the Explorer saved queries file: users cannot save queries")
+	cmdFlags.Bool("explorer-sharding-enabled", false, "Spreads the clusters collected by the Explorer over the replicas. Requires the postgres store")
+	cmdFlags.String("explorer-sharding-namespace", os.Getenv("RUNTIME_NAMESPACE"), "Namespace of the leases the Explorer replicas coordinate through")
+	cmdFlags.Bool("explorer-events-enabled", false, "Collects Kubernetes events in the Explorer, in addition to the policy agent audit events, linked to the object they are about")
+	cmdFlags.StringSlice("explorer-events-reasons", []string{}, "Only collects the Explorer events with these reasons, like UpgradeFailed")
+	cmdFlags.StringSlice("explorer-events-involved-kinds", []string{}, "Only collects the Explorer events about objects of these kinds, like HelmRelease")
+	cmdFlags.Bool("explorer-events-warnings-only", false, "Only collects the Explorer events of type Warning")
+	cmdFlags.Duration("explorer-events-retention", time.Duration(configuration.DefaultEventsRetentionPolicy), "How long the Explorer keeps events when events are enabled. The policy agent audit events are kept for 24h regardless")
 
 	// Monitoring
 	cmdFlags.Bool("monitoring-enabled", false, "creates monitoring server")
@@ -371,6 +444,7 @@ func StartServer(ctx context.Context, p Params, logOptions flux_logger.Options)
 		sourcev1.AddToScheme,
 		gitopsv1alpha1.AddToScheme,
 		authv1.AddToScheme,
+		apiextensionsv1.AddToScheme,
 	}
 
 	if p.CAPIEnabled {
@@ -584,12 +658,22 @@ func StartServer(ctx context.Context, p Params, logOptions flux_logger.Options)
 		WithKubernetesClientSet(kubernetesClientSet),
 		WithManagementCluster(p.Cluster),
 		WithTemplateCostEstimator(estimator),
+		WithTemplateSchemaValidator(templates.NewClusterSchemaValidator(kubeClient, kubeClient.RESTMapper())),
 		WithUIConfig(p.UIConfig),
 		WithPipelineControllerAddress(p.PipelineControllerAddress),
 		WithCollectorServiceAccount(p.CollectorServiceAccountName, p.CollectorServiceAccountNamespace),
 		WithMonitoring(p.MonitoringEnabled, p.MonitoringBindAddress, p.MetricsEnabled, p.ProfilingEnabled, log),
 		WithExplorerCleanerDisabled(p.ExplorerCleanerDisabled),
 		WithExplorerEnabledFor(p.ExplorerEnabledFor),
+		WithExplorerStore(p.ExplorerStoreType, p.ExplorerStoreURI),
+		WithExplorerObjectKindsFile(p.ExplorerObjectKindsFile),
+		WithExplorerSavedQueries(p.ExplorerSavedQueriesFile, p.ExplorerSavedQueriesReadOnly),
+		WithExplorerSharding(p.ExplorerShardingEnabled, p.ExplorerShardingNamespace),
+		WithExplorerEvents(p.ExplorerEventsEnabled, configuration.EventFilter{
+			Reasons:       p.ExplorerEventsReasons,
+			InvolvedKinds: p.ExplorerEventsInvolvedKinds,
+			WarningsOnly:  p.ExplorerEventsWarningsOnly,
+		}, configuration.RetentionPolicy(p.ExplorerEventsRetention)),
 		WithRoutePrefix(p.RoutePrefix),
 	)
 }
@@ -669,6 +753,7 @@ func RunInProcessGateway(ctx context.Context, addr string, setters ...Option) er
 			ManagementFetcher:     args.ManagementFetcher,
 			Cluster:               args.Cluster,
 			Estimator:             estimator,
+			SchemaValidator:       args.TemplateSchemaValidator,
 			UIConfig:              args.UIConfig,
 		},
 	)
@@ -703,15 +788,44 @@ func RunInProcessGateway(ctx context.Context, addr string, setters ...Option) er
 	}
 
 	if featureflags.Get("WEAVE_GITOPS_FEATURE_EXPLORER") != "" {
-		_, err := queryserver.Hydrate(ctx, grpcMux, queryserver.ServerOpts{
+		objectKinds := append([]configuration.ObjectKind{}, configuration.SupportedObjectKinds...)
+		if args.ExplorerObjectKindsFile != "" {
+			userObjectKinds, err := configuration.ReadUserObjectKinds(args.ExplorerObjectKindsFile)
+			if err != nil {
+				return fmt.Errorf("reading explorer object kinds: %w", err)
+			}
+			objectKinds = append(objectKinds, userObjectKinds...)
+		}
+		if args.ExplorerEventsEnabled {
+			objectKinds = configuration.WithEventsObjectKind(objectKinds, args.ExplorerEventFilter, args.ExplorerEventsRetention)
+		}
+
+		var curatedQueries []configuration.CuratedQuery
+		if args.ExplorerSavedQueriesFile != "" {
+			curatedQueries, err = configuration.ReadCuratedQueries(args.ExplorerSavedQueriesFile)
+			if err != nil {
+				return fmt.Errorf("reading explorer saved queries: %w", err)
+			}
+		}
+
+		_, err = queryserver.Hydrate(ctx, grpcMux, queryserver.ServerOpts{
 			Logger:              args.Log,
 			DiscoveryClient:     args.DiscoveryClient,
 			ClustersManager:     args.ClustersManager,
 			SkipCollection:      false,
-			ObjectKinds:         configuration.SupportedObjectKinds,
+			ObjectKinds:         objectKinds,
 			ServiceAccount:      args.CollectorServiceAccount,
 			EnableObjectCleaner: !args.ExplorerCleanerDisabled,
 			EnabledFor:          args.ExplorerEnabledFor,
+			StoreType:           args.ExplorerStoreType,
+			StoreURI:            args.ExplorerStoreURI,
+
+			CuratedQueries:       curatedQueries,
+			SavedQueriesReadOnly: args.ExplorerQueriesReadOnly,
+			ManagementCluster:    args.Cluster,
+			EnableSharding:       args.ExplorerShardingEnabled,
+			ShardingNamespace:    args.ExplorerShardingNamespace,
+			LeasesClient:         args.KubernetesClientSet.CoordinationV1(),
 		})
 		if err != nil {
 			return fmt.Errorf("hydrating query server: %w", err)
@@ -837,6 +951,8 @@ func RunInProcessGateway(ctx context.Context, addr string, setters ...Option) er
 
 	// Secure `/v1` and `/gitops/api` API routes
 	grpcHttpHandler = auth.WithAPIAuth(grpcHttpHandler, srv, EnterprisePublicRoutes(), args.SessionManager)
+	// Streams skip the request logging, whose response writer cannot be flushed
+	streamingHandler := auth.WithAPIAuth(grpcMux, srv, EnterprisePublicRoutes(), args.SessionManager)
 
 	// monitoring server
 	var monitoringServer *http.Server
@@ -865,6 +981,9 @@ func RunInProcessGateway(ctx context.Context, addr string, setters ...Option) er
 	}
 
 	mux.Handle("/v1/", commonMiddleware(grpcHttpHandler))
+	for _, route := range streamingRoutes {
+		mux.Handle(route, commonMiddleware(streamingHandler))
+	}
 
 	staticAssetsWithGz := gziphandler.GzipHandler(assetHandler)
 
@@ -875,7 +994,7 @@ func RunInProcessGateway(ctx context.Context, addr string, setters ...Option) er
 	}
 
 	handler := http.Handler(mux)
-	handler = args.SessionManager.LoadAndSave(handler)
+	handler = loadAndSaveSession(args.SessionManager, handler)
 
 	s := &http.Server{
 		Addr:    addr,
@@ -38,6 +38,13 @@ type ClustersServiceClient interface {
 	// In the request include one of the resources originally generated by the template.
 	// Then a pull request will be created to delete all resources generated by the template.
 	CreateDeletionPullRequest(ctx context.Context, in *CreateDeletionPullRequestRequest, opts ...grpc.CallOption) (*CreateDeletionPullRequestResponse, error)
+	// Create a pull request to upgrade templated resources
+	//
+	// The resources created from the template are rendered again with the
+	// current version of the template and the parameter values they were
+	// created with, as recorded in their create-request annotation. Files the
+	// template no longer renders are deleted.
+	UpgradeFromTemplate(ctx context.Context, in *UpgradeFromTemplateRequest, opts ...grpc.CallOption) (*UpgradeFromTemplateResponse, error)
 	// Preview adding automations to a cluster
 	//
 	// Add kustomizations, helm releases, external secrets, policy configs, and sops secrets
@@ -179,6 +186,15 @@ func (c *clustersServiceClient) CreateDeletionPullRequest(ctx context.Context, i
 	return out, nil
 }
 
+func (c *clustersServiceClient) UpgradeFromTemplate(ctx context.Context, in *UpgradeFromTemplateRequest, opts ...grpc.CallOption) (*UpgradeFromTemplateResponse, error) {
+	out := new(UpgradeFromTemplateResponse)
+	err := c.cc.Invoke(ctx, "/cluster_services.v1.ClustersService/UpgradeFromTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clustersServiceClient) RenderAutomation(ctx context.Context, in *RenderAutomationRequest, opts ...grpc.CallOption) (*RenderAutomationResponse, error) {
 	out := new(RenderAutomationResponse)
 	err := c.cc.Invoke(ctx, "/cluster_services.v1.ClustersService/RenderAutomation", in, out, opts...)
@@ -436,6 +452,13 @@ type ClustersServiceServer interface {
 	// In the request include one of the resources originally generated by the template.
 	// Then a pull request will be created to delete all resources generated by the template.
 	CreateDeletionPullRequest(context.Context, *CreateDeletionPullRequestRequest) (*CreateDeletionPullRequestResponse, error)
+	// Create a pull request to upgrade templated resources
+	//
+	// The resources created from the template are rendered again with the
+	// current version of the template and the parameter values they were
+	// created with, as recorded in their create-request annotation. Files the
+	// template no longer renders are deleted.
+	UpgradeFromTemplate(context.Context, *UpgradeFromTemplateRequest) (*UpgradeFromTemplateResponse, error)
 	// Preview adding automations to a cluster
 	//
 	// Add kustomizations, helm releases, external secrets, policy configs, and sops secrets
@@ -532,6 +555,9 @@ func (UnimplementedClustersServiceServer) CreatePullRequest(context.Context, *Cr
 func (UnimplementedClustersServiceServer) CreateDeletionPullRequest(context.Context, *CreateDeletionPullRequestRequest) (*CreateDeletionPullRequestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateDeletionPullRequest not implemented")
 }
+func (UnimplementedClustersServiceServer) UpgradeFromTemplate(context.Context, *UpgradeFromTemplateRequest) (*UpgradeFromTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeFromTemplate not implemented")
+}
 func (UnimplementedClustersServiceServer) RenderAutomation(context.Context, *RenderAutomationRequest) (*RenderAutomationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RenderAutomation not implemented")
 }
@@ -749,6 +775,24 @@ func _ClustersService_CreateDeletionPullRequest_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClustersService_UpgradeFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeFromTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClustersServiceServer).UpgradeFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cluster_services.v1.ClustersService/UpgradeFromTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClustersServiceServer).UpgradeFromTemplate(ctx, req.(*UpgradeFromTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClustersService_RenderAutomation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RenderAutomationRequest)
 	if err := dec(in); err != nil {
@@ -1252,6 +1296,10 @@ var ClustersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateDeletionPullRequest",
 			Handler:    _ClustersService_CreateDeletionPullRequest_Handler,
 		},
+		{
+			MethodName: "UpgradeFromTemplate",
+			Handler:    _ClustersService_UpgradeFromTemplate_Handler,
+		},
 		{
 			MethodName: "RenderAutomation",
 			Handler:    _ClustersService_RenderAutomation_Handler,
@@ -215,6 +215,8 @@ func (s *server) CreatePullRequest(ctx context.Context, msg *capiv1_proto.Create
 			Profiles:         msg.Values,
 			Kustomizations:   msg.Kustomizations,
 			ExternalSecrets:  msg.ExternalSecrets,
+			// only the new resources are validated, as the previous ones are deleted
+			SchemaValidator: s.schemaValidator,
 		},
 		msg,
 	)
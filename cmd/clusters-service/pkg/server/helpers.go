@@ -17,7 +17,7 @@ import (
 func renderTemplateWithValues(t apitemplates.Template, name, namespace string, values map[string]string, mapper meta.RESTMapper) ([]templates.RenderedTemplate, error) {
 	opts := []templates.RenderOptFunc{
 		templates.InjectLabels(map[string]string{
-			"templates.weave.works/template-name":      name,
+			templates.TemplateNameLabel:                name,
 			"templates.weave.works/template-namespace": viper.GetString("capi-templates-namespace"),
 		}),
 	}
@@ -40,7 +40,7 @@ func renderTemplateWithValues(t apitemplates.Template, name, namespace string, v
 		if missing, ok := isMissingVariableError(err); ok {
 			return nil, fmt.Errorf("error rendering template %v due to missing variables: %s", name, missing)
 		}
-		return nil, fmt.Errorf("error rendering template %v, %v", name, err)
+		return nil, fmt.Errorf("error rendering template %v, %w", name, err)
 	}
 
 	return templateBits, nil
@@ -11,6 +11,7 @@ import (
 	"github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/git"
 	"github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/mgmtfetcher"
 	capiv1_proto "github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/protos"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/templates"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/estimation"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/helm"
 )
@@ -49,6 +50,7 @@ type server struct {
 	chartsCache       helm.ChartsCacheReader
 	managementFetcher *mgmtfetcher.ManagementCrossNamespacesFetcher
 	estimator         estimation.Estimator
+	schemaValidator   templates.SchemaValidator
 	uiConfig          string
 }
 
@@ -68,7 +70,9 @@ type ServerOpts struct {
 	ValuesFetcher         helm.ValuesFetcher
 	ManagementFetcher     *mgmtfetcher.ManagementCrossNamespacesFetcher
 	Estimator             estimation.Estimator
-	UIConfig              string
+	// SchemaValidator validates the resources rendered from templates, when set.
+	SchemaValidator templates.SchemaValidator
+	UIConfig        string
 }
 
 func NewClusterServer(opts ServerOpts) capiv1_proto.ClustersServiceServer {
@@ -88,6 +92,7 @@ func NewClusterServer(opts ServerOpts) capiv1_proto.ClustersServiceServer {
 		managementFetcher:     opts.ManagementFetcher,
 		cluster:               opts.Cluster,
 		estimator:             opts.Estimator,
+		schemaValidator:       opts.SchemaValidator,
 		uiConfig:              opts.UIConfig,
 	}
 }
@@ -20,6 +20,7 @@ import (
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/estimation"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/git"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/helm"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -35,6 +36,8 @@ type GetFilesRequest struct {
 	Kustomizations   []*capiv1_proto.Kustomization
 	ExternalSecrets  []*capiv1_proto.ExternalSecret
 	HelmRepository   *sourcev1.HelmRepository
+	// SchemaValidator validates the rendered resources against the schemas of their kinds, when set.
+	SchemaValidator templates.SchemaValidator
 }
 
 type GetFilesReturn struct {
@@ -45,7 +48,38 @@ type GetFilesReturn struct {
 	ExternalSecretsFiles []git.CommitFile
 }
 
+// getTemplate gets a template, composed with the templates it references.
 func (s *server) getTemplate(ctx context.Context, name, namespace, templateKind string) (templatesv1.Template, error) {
+	t, err := s.fetchTemplate(ctx, name, namespace, templateKind)
+	if err != nil || t == nil {
+		return t, err
+	}
+
+	composed, err := templates.ComposeTemplate(ctx, t, templateResolver{s})
+	if err != nil {
+		return nil, fmt.Errorf("error composing template %s/%s: %w", namespace, name, err)
+	}
+	return composed, nil
+}
+
+// templateResolver resolves the templates referenced by other templates in
+// the management cluster.
+type templateResolver struct {
+	s *server
+}
+
+func (r templateResolver) ResolveTemplate(ctx context.Context, ref templates.TemplateReference) (templatesv1.Template, error) {
+	t, err := r.s.fetchTemplate(ctx, ref.Name, ref.Namespace, ref.Kind)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return nil, fmt.Errorf("unknown template kind %s", ref.Kind)
+	}
+	return t, nil
+}
+
+func (s *server) fetchTemplate(ctx context.Context, name, namespace, templateKind string) (templatesv1.Template, error) {
 	if namespace == "" {
 		return nil, errors.New("need to specify template namespace")
 	}
@@ -85,7 +119,8 @@ func (s *server) getTemplate(ctx context.Context, name, namespace, templateKind
 }
 
 func (s *server) ListTemplates(ctx context.Context, msg *capiv1_proto.ListTemplatesRequest) (*capiv1_proto.ListTemplatesResponse, error) {
-	templates := []*capiv1_proto.Template{}
+	// templates reference templates of the same kind, so they are composed with the ones listed
+	listed := []templatesv1.Template{}
 	errors := []*capiv1_proto.ListError{}
 	includeGitopsTemplates := msg.TemplateKind == "" || msg.TemplateKind == gapiv1.Kind
 	includeCAPITemplates := msg.TemplateKind == "" || msg.TemplateKind == capiv1.Kind
@@ -106,8 +141,8 @@ func (s *server) ListTemplates(ctx context.Context, msg *capiv1_proto.ListTempla
 				})
 			}
 			templatesList := namespacedList.List.(*gapiv1.GitOpsTemplateList)
-			for _, t := range templatesList.Items {
-				templates = append(templates, ToTemplateResponse(&t))
+			for i := range templatesList.Items {
+				listed = append(listed, &templatesList.Items[i])
 			}
 		}
 	}
@@ -128,12 +163,22 @@ func (s *server) ListTemplates(ctx context.Context, msg *capiv1_proto.ListTempla
 				})
 			}
 			templatesList := namespacedList.List.(*capiv1.CAPITemplateList)
-			for _, t := range templatesList.Items {
-				templates = append(templates, ToTemplateResponse(&t))
+			for i := range templatesList.Items {
+				listed = append(listed, &templatesList.Items[i])
 			}
 		}
 	}
 
+	resolver, err := templates.NewTemplateListResolver(listed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve templates: %w", err)
+	}
+
+	templates := []*capiv1_proto.Template{}
+	for _, t := range listed {
+		templates = append(templates, toComposedTemplateResponse(ctx, t, resolver))
+	}
+
 	total := int32(len(templates))
 	if msg.Provider != "" {
 		if !isProviderRecognised(msg.Provider) {
@@ -151,6 +196,18 @@ func (s *server) ListTemplates(ctx context.Context, msg *capiv1_proto.ListTempla
 	}, nil
 }
 
+// toComposedTemplateResponse composes a template with the templates it
+// references before converting it.
+func toComposedTemplateResponse(ctx context.Context, t templatesv1.Template, resolver templates.TemplateResolver) *capiv1_proto.Template {
+	composed, err := templates.ComposeTemplate(ctx, t, resolver)
+	if err != nil {
+		res := ToTemplateResponse(t)
+		res.Error = fmt.Sprintf("Couldn't compose template: %s", err.Error())
+		return res
+	}
+	return ToTemplateResponse(composed)
+}
+
 func (s *server) GetTemplate(ctx context.Context, msg *capiv1_proto.GetTemplateRequest) (*capiv1_proto.GetTemplateResponse, error) {
 	// Default to CAPI kind to ease transition
 	if msg.TemplateKind == "" {
@@ -181,7 +238,16 @@ func (s *server) ListTemplateParams(ctx context.Context, msg *capiv1_proto.ListT
 		return nil, fmt.Errorf("error looking up template params for %v, %v", msg.Name, t.Error)
 	}
 
-	return &capiv1_proto.ListTemplateParamsResponse{Parameters: t.Parameters, Objects: t.Objects}, err
+	params, err := templates.ParamsFromTemplate(tm)
+	if err != nil {
+		return nil, fmt.Errorf("error looking up template params for %v, %v", msg.Name, err)
+	}
+	schema, err := structpb.NewStruct(templates.ParamsJSONSchema(params))
+	if err != nil {
+		return nil, fmt.Errorf("error creating schema of template params for %v: %w", msg.Name, err)
+	}
+
+	return &capiv1_proto.ListTemplateParamsResponse{Parameters: t.Parameters, Objects: t.Objects, Schema: schema}, nil
 }
 
 func (s *server) ListTemplateProfiles(ctx context.Context, msg *capiv1_proto.ListTemplateProfilesRequest) (*capiv1_proto.ListTemplateProfilesResponse, error) {
@@ -253,6 +319,7 @@ func (s *server) RenderTemplate(ctx context.Context, msg *capiv1_proto.RenderTem
 			Profiles:         msg.Profiles,
 			Kustomizations:   msg.Kustomizations,
 			ExternalSecrets:  msg.ExternalSecrets,
+			SchemaValidator:  s.schemaValidator,
 		},
 		nil,
 	)
@@ -291,7 +358,7 @@ func GetFiles(
 	for _, renderedTemplate := range renderedTemplates {
 		tmplWithValues := renderedTemplate.Data
 		if createRequestMessage != nil {
-			tmplWithValues, err = templates.InjectJSONAnnotation(tmplWithValues, "templates.weave.works/create-request", createRequestMessage)
+			tmplWithValues, err = templates.InjectJSONAnnotation(tmplWithValues, templates.CreateRequestAnnotation, createRequestMessage)
 			if err != nil {
 				return nil, fmt.Errorf("failed to annotate template with parameter values: %w", err)
 			}
@@ -302,6 +369,13 @@ func GetFiles(
 			return nil, fmt.Errorf("validation error rendering template %v, %v", msg.TemplateName, err)
 		}
 
+		if msg.SchemaValidator != nil {
+			err = templates.ValidateRenderedSchemas(ctx, tmplWithValues, msg.SchemaValidator)
+			if err != nil {
+				return nil, fmt.Errorf("validation error rendering template %v, %v", msg.TemplateName, err)
+			}
+		}
+
 		if client != nil {
 			tmplWithValues, err = credentials.CheckAndInjectCredentials(log, client, tmplWithValues, msg.Credentials, msg.TemplateName)
 			if err != nil {
@@ -331,7 +405,7 @@ func GetFiles(
 	}(rendered)
 
 	if len(rendered) > 0 {
-		annotated, err := templates.InjectJSONAnnotation(rendered[0].content, "templates.weave.works/created-files", map[string]any{
+		annotated, err := templates.InjectJSONAnnotation(rendered[0].content, templates.CreatedFilesAnnotation, map[string]any{
 			"files": filenames,
 		})
 		if err != nil {
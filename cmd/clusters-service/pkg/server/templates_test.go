@@ -13,6 +13,8 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
 	"google.golang.org/protobuf/testing/protocmp"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -23,6 +25,7 @@ import (
 	templatesv1 "github.com/weaveworks/templates-controller/apis/core"
 	gapiv1 "github.com/weaveworks/templates-controller/apis/gitops/v1alpha2"
 	capiv1_protos "github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/protos"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/templates"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/estimation"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/git"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/helm"
@@ -495,6 +498,116 @@ func TestGetTemplate(t *testing.T) {
 	}
 }
 
+func TestListTemplateParams_Schema(t *testing.T) {
+	s := createServer(t, serverOptions{
+		clusterState: []runtime.Object{
+			makeCAPITemplate(t, func(ct *capiv1.CAPITemplate) {
+				ct.Spec.Params[0].Required = true
+				ct.Annotations = map[string]string{templates.ParamTypesAnnotation: "CLUSTER_NAME: {pattern: '^[a-z-]+$'}"}
+			}),
+		},
+		namespace: "default",
+	})
+
+	listTemplateParamsResponse, err := s.ListTemplateParams(context.Background(), &capiv1_protos.ListTemplateParamsRequest{
+		Name:      "cluster-template-1",
+		Namespace: "default",
+	})
+	if err != nil {
+		t.Fatalf("failed to read the template params:\n%s", err)
+	}
+
+	want := map[string]interface{}{
+		"$schema": "http://json-schema.org/draft-07/schema#",
+		"type":    "object",
+		"properties": map[string]interface{}{
+			"CLUSTER_NAME": map[string]interface{}{
+				"type":        "string",
+				"description": "This is used for the cluster naming.",
+				"pattern":     "^[a-z-]+$",
+			},
+		},
+		"required": []interface{}{"CLUSTER_NAME"},
+	}
+	if diff := cmp.Diff(want, listTemplateParamsResponse.Schema.AsMap()); diff != "" {
+		t.Fatalf("schema didn't match:\n%s", diff)
+	}
+}
+
+func TestListTemplates_WithBaseTemplate(t *testing.T) {
+	s := createServer(t, serverOptions{
+		clusterState: []runtime.Object{
+			makeCAPITemplate(t),
+			makeCAPITemplate(t, func(ct *capiv1.CAPITemplate) {
+				ct.Name = "cluster-template-2"
+				ct.Annotations = map[string]string{templates.BaseTemplateAnnotation: "cluster-template-1"}
+				ct.Spec.ResourceTemplates = nil
+			}),
+			makeCAPITemplate(t, func(ct *capiv1.CAPITemplate) {
+				ct.Name = "cluster-template-3"
+				ct.Annotations = map[string]string{templates.BaseTemplateAnnotation: "missing"}
+			}),
+		},
+		namespace: "default",
+	})
+
+	listTemplatesResponse, err := s.ListTemplates(context.Background(), &capiv1_protos.ListTemplatesRequest{})
+	if err != nil {
+		t.Fatalf("failed to list templates:\n%s", err)
+	}
+
+	if len(listTemplatesResponse.Templates) != 3 {
+		t.Fatalf("expected 3 templates, got %d", len(listTemplatesResponse.Templates))
+	}
+
+	// the base is resolved from the listed templates
+	composed := listTemplatesResponse.Templates[1]
+	if composed.Error != "" {
+		t.Fatalf("failed to compose template: %s", composed.Error)
+	}
+	if len(composed.Objects) != 1 {
+		t.Fatalf("expected the objects of the base template, got %d objects", len(composed.Objects))
+	}
+
+	unresolved := listTemplatesResponse.Templates[2]
+	if diff := cmp.Diff("Couldn't compose template: failed to resolve template default/missing referenced by default/cluster-template-3: CAPITemplate default/missing not found", unresolved.Error); diff != "" {
+		t.Fatalf("template error didn't match expected:\n%s", diff)
+	}
+}
+
+func TestListTemplateParams_WithBaseTemplate(t *testing.T) {
+	s := createServer(t, serverOptions{
+		clusterState: []runtime.Object{
+			makeCAPITemplate(t),
+			makeCAPITemplate(t, func(ct *capiv1.CAPITemplate) {
+				ct.Name = "cluster-template-2"
+				ct.Annotations = map[string]string{templates.BaseTemplateAnnotation: "cluster-template-1"}
+				ct.Spec.Params[0].Description = "Name of the workload cluster."
+				ct.Spec.ResourceTemplates = nil
+			}),
+		},
+		namespace: "default",
+	})
+
+	listTemplateParamsResponse, err := s.ListTemplateParams(context.Background(), &capiv1_protos.ListTemplateParamsRequest{
+		Name:      "cluster-template-2",
+		Namespace: "default",
+	})
+	if err != nil {
+		t.Fatalf("failed to read the template params:\n%s", err)
+	}
+
+	expected := []*capiv1_protos.Parameter{
+		{
+			Name:        "CLUSTER_NAME",
+			Description: "Name of the workload cluster.",
+		},
+	}
+	if diff := cmp.Diff(expected, listTemplateParamsResponse.Parameters, protocmp.Transform()); diff != "" {
+		t.Fatalf("template params didn't match expected:\n%s", diff)
+	}
+}
+
 func TestListTemplateParams(t *testing.T) {
 	testCases := []struct {
 		name             string
@@ -1441,6 +1554,33 @@ func TestRenderTemplate_MissingRequiredVariable(t *testing.T) {
 	}
 }
 
+func TestRenderTemplate_InvalidParameterValues(t *testing.T) {
+	clusterState := []runtime.Object{
+		makeCAPITemplate(t, func(ct *capiv1.CAPITemplate) {
+			ct.Annotations = map[string]string{templates.ParamTypesAnnotation: "CLUSTER_NAME: {pattern: '^[a-z-]+$'}"}
+		}),
+	}
+	s := createServer(t, serverOptions{
+		clusterState: clusterState,
+		namespace:    "default",
+	})
+
+	renderTemplateRequest := &capiv1_protos.RenderTemplateRequest{
+		Name:      "cluster-template-1",
+		Namespace: "default",
+		Values:    map[string]string{"CLUSTER_NAME": "Cluster_1"},
+	}
+
+	_, err := s.RenderTemplate(context.Background(), renderTemplateRequest)
+	if diff := cmp.Diff(err.Error(), "failed to render template with parameter values: error rendering template cluster-template-1, invalid value for parameter CLUSTER_NAME: must match ^[a-z-]+$"); diff != "" {
+		t.Fatalf("got the wrong error:\n%s", diff)
+	}
+
+	st, _ := grpcStatus.FromError(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Equal(t, err.Error(), st.Message())
+}
+
 func TestRenderTemplate_ValidateVariables(t *testing.T) {
 	testCases := []struct {
 		name         string
@@ -0,0 +1,318 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+	capiv1 "github.com/weaveworks/templates-controller/apis/capi/v1alpha2"
+	templatesv1 "github.com/weaveworks/templates-controller/apis/core"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	csgit "github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/git"
+	capiv1_proto "github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/protos"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/templates"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/git"
+)
+
+func (s *server) UpgradeFromTemplate(ctx context.Context, msg *capiv1_proto.UpgradeFromTemplateRequest) (*capiv1_proto.UpgradeFromTemplateResponse, error) {
+	if msg.TemplateKind == "" {
+		msg.TemplateKind = capiv1.Kind
+	}
+	if msg.Name == "" {
+		return nil, grpcStatus.Error(codes.InvalidArgument, "template name must be specified")
+	}
+
+	gp, err := getGitProvider(ctx, msg.RepositoryUrl)
+	if err != nil {
+		return nil, grpcStatus.Errorf(codes.Unauthenticated, "error creating pull request: %s", err.Error())
+	}
+
+	tmpl, err := s.getTemplate(ctx, msg.Name, msg.Namespace, msg.TemplateKind)
+	if err != nil {
+		return nil, fmt.Errorf("error looking up template %v: %v", msg.Name, err)
+	}
+	if tmpl == nil {
+		return nil, grpcStatus.Errorf(codes.InvalidArgument, "unknown template kind %s", msg.TemplateKind)
+	}
+
+	client, err := s.clientGetter.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := msg.Resources
+	if len(resources) == 0 {
+		resources, err = templatedResources(ctx, client, tmpl)
+		if err != nil {
+			return nil, fmt.Errorf("error looking up resources created from template %s: %w", msg.Name, err)
+		}
+	}
+
+	repositoryURL := viper.GetString("capi-templates-repository-url")
+	if msg.RepositoryUrl != "" {
+		repositoryURL = msg.RepositoryUrl
+	}
+	baseBranch := viper.GetString("capi-templates-repository-base-branch")
+	if msg.BaseBranch != "" {
+		baseBranch = msg.BaseBranch
+	}
+
+	var files []git.CommitFile
+	written := map[string]bool{}
+	upgraded := 0
+	for _, r := range resources {
+		createRequest, err := parseCreateRequest(r.CreateRequest)
+		if err != nil {
+			return nil, grpcStatus.Errorf(codes.InvalidArgument, "invalid %s annotation: %s", templates.CreateRequestAnnotation, err)
+		}
+		if !createdFromTemplate(createRequest, msg) {
+			continue
+		}
+		// resources created in other repositories are upgraded by their own pull requests
+		createdInRepository := createRequest.RepositoryUrl
+		if createdInRepository == "" {
+			createdInRepository = viper.GetString("capi-templates-repository-url")
+		}
+		if !sameRepository(createdInRepository, repositoryURL) {
+			continue
+		}
+
+		upgradedFiles, err := s.upgradeFiles(ctx, client, tmpl, createRequest, r.CreatedFiles)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range upgradedFiles {
+			if written[f.Path] {
+				return nil, grpcStatus.Errorf(codes.FailedPrecondition, "more than one set of resources created from template %s is written to %s", msg.Name, f.Path)
+			}
+			written[f.Path] = true
+		}
+		files = append(files, upgradedFiles...)
+		upgraded++
+	}
+
+	if upgraded == 0 {
+		return nil, grpcStatus.Errorf(codes.NotFound, "no resources created from template %s in %s found", msg.Name, repositoryURL)
+	}
+
+	if msg.HeadBranch == "" {
+		msg.HeadBranch = getHash(append([]string{repositoryURL, msg.Namespace, msg.Name, baseBranch}, commitFilesHashInputs(files)...)...)
+	}
+	if msg.Title == "" {
+		msg.Title = fmt.Sprintf("Gitops upgrade resources of template %s", msg.Name)
+	}
+	if msg.Description == "" {
+		msg.Description = fmt.Sprintf("Pull request to upgrade %d sets of resources created from template %s", upgraded, msg.Name)
+	}
+	if msg.CommitMessage == "" {
+		msg.CommitMessage = "Upgrade Templated Resources"
+	}
+
+	_, err = s.provider.GetRepository(ctx, *gp, repositoryURL)
+	if err != nil {
+		return nil, grpcStatus.Errorf(codes.Unauthenticated, "failed to access repo %s: %s", repositoryURL, err)
+	}
+
+	res, err := s.provider.WriteFilesToBranchAndCreatePullRequest(ctx, csgit.WriteFilesToBranchAndCreatePullRequestRequest{
+		GitProvider:   *gp,
+		RepositoryURL: repositoryURL,
+		HeadBranch:    msg.HeadBranch,
+		BaseBranch:    baseBranch,
+		Title:         msg.Title,
+		Description:   msg.Description,
+		CommitMessage: msg.CommitMessage,
+		Files:         files,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create pull request for %q: %w", msg.Name, err)
+	}
+
+	return &capiv1_proto.UpgradeFromTemplateResponse{
+		WebUrl:   res.WebURL,
+		Upgraded: int32(upgraded),
+	}, nil
+}
+
+// upgradeFiles renders the template again with the values of the pull
+// request the resources were created by, and deletes the files that were
+// created then but are no longer rendered.
+func (s *server) upgradeFiles(ctx context.Context, client client.Client, tmpl templatesv1.Template, createRequest *capiv1_proto.CreatePullRequestRequest, createdFiles []string) ([]git.CommitFile, error) {
+	applyCreateClusterDefaults(createRequest)
+	createRequest.PreviousValues = nil
+
+	gitFiles, err := GetFiles(
+		ctx,
+		client,
+		client.RESTMapper(),
+		s.log,
+		s.estimator,
+		s.chartsCache,
+		types.NamespacedName{Name: s.cluster},
+		s.profileHelmRepository,
+		tmpl,
+		GetFilesRequest{
+			ClusterNamespace: getClusterNamespace(createRequest.ParameterValues["NAMESPACE"]),
+			TemplateName:     createRequest.Name,
+			ParameterValues:  createRequest.ParameterValues,
+			Credentials:      createRequest.Credentials,
+			Profiles:         createRequest.Values,
+			Kustomizations:   createRequest.Kustomizations,
+			ExternalSecrets:  createRequest.ExternalSecrets,
+			SchemaValidator:  s.schemaValidator,
+		},
+		createRequest,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	files := []git.CommitFile{}
+	files = append(files, gitFiles.RenderedTemplate...)
+	files = append(files, gitFiles.ProfileFiles...)
+	files = append(files, gitFiles.KustomizationFiles...)
+	files = append(files, gitFiles.ExternalSecretsFiles...)
+
+	rendered := map[string]bool{}
+	for _, f := range files {
+		rendered[f.Path] = true
+	}
+	for _, path := range createdFiles {
+		if !rendered[path] {
+			rendered[path] = true
+			files = append(files, git.CommitFile{Path: path, Content: nil})
+		}
+	}
+
+	return files, nil
+}
+
+// templatedResources reads the annotations of the resources created from the
+// template in the management cluster. The resources are found by the kinds
+// the template renders, so resources of kinds the template no longer renders
+// are not found.
+func templatedResources(ctx context.Context, cl client.Client, tmpl templatesv1.Template) ([]*capiv1_proto.TemplatedResources, error) {
+	tm, err := templates.ParseTemplateMeta(tmpl, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template: %w", err)
+	}
+
+	listed := map[schema.GroupVersionKind]bool{}
+	byCreateRequest := map[string]*capiv1_proto.TemplatedResources{}
+	var resources []*capiv1_proto.TemplatedResources
+	for _, o := range tm.Objects {
+		if strings.ContainsAny(o.APIVersion+o.Kind, "${}") {
+			// kinds rendered from params can't be listed
+			continue
+		}
+		gv, err := schema.ParseGroupVersion(o.APIVersion)
+		if err != nil {
+			continue
+		}
+		gvk := gv.WithKind(o.Kind)
+		if listed[gvk] {
+			continue
+		}
+		listed[gvk] = true
+
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(gv.WithKind(o.Kind + "List"))
+		if err := cl.List(ctx, list, client.MatchingLabels{templates.TemplateNameLabel: tmpl.GetName()}); err != nil {
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to list %s: %w", gvk, err)
+		}
+
+		for _, item := range list.Items {
+			annotations := item.GetAnnotations()
+			createRequest := annotations[templates.CreateRequestAnnotation]
+			if createRequest == "" {
+				continue
+			}
+
+			r, ok := byCreateRequest[createRequest]
+			if !ok {
+				r = &capiv1_proto.TemplatedResources{CreateRequest: createRequest}
+				byCreateRequest[createRequest] = r
+				resources = append(resources, r)
+			}
+
+			if createdFiles := annotations[templates.CreatedFilesAnnotation]; createdFiles != "" {
+				var created struct {
+					Files []string `json:"files"`
+				}
+				if err := json.Unmarshal([]byte(createdFiles), &created); err != nil {
+					return nil, fmt.Errorf("invalid %s annotation of %s %s/%s: %w", templates.CreatedFilesAnnotation, o.Kind, item.GetNamespace(), item.GetName(), err)
+				}
+				r.CreatedFiles = created.Files
+			}
+		}
+	}
+
+	return resources, nil
+}
+
+// parseCreateRequest parses a create-request annotation, including the ones
+// written before the template_name and template_namespace fields were
+// renamed to name and namespace.
+func parseCreateRequest(s string) (*capiv1_proto.CreatePullRequestRequest, error) {
+	createRequest := &capiv1_proto.CreatePullRequestRequest{}
+	if err := json.Unmarshal([]byte(s), createRequest); err != nil {
+		return nil, err
+	}
+
+	if createRequest.Name == "" {
+		var v1 struct {
+			TemplateName      string `json:"template_name"`
+			TemplateNamespace string `json:"template_namespace"`
+		}
+		if err := json.Unmarshal([]byte(s), &v1); err != nil {
+			return nil, err
+		}
+		createRequest.Name = v1.TemplateName
+		createRequest.Namespace = v1.TemplateNamespace
+	}
+
+	return createRequest, nil
+}
+
+func createdFromTemplate(createRequest *capiv1_proto.CreatePullRequestRequest, msg *capiv1_proto.UpgradeFromTemplateRequest) bool {
+	kind := createRequest.TemplateKind
+	if kind == "" {
+		kind = capiv1.Kind
+	}
+	return createRequest.Name == msg.Name && kind == msg.TemplateKind &&
+		(createRequest.Namespace == "" || createRequest.Namespace == msg.Namespace)
+}
+
+func sameRepository(a, b string) bool {
+	normalize := func(url string) string {
+		return strings.TrimSuffix(strings.TrimSuffix(url, "/"), ".git")
+	}
+	return normalize(a) == normalize(b)
+}
+
+// commitFilesHashInputs returns the paths and contents of the files in a
+// stable order, so that a branch is only reused for the same changes.
+func commitFilesHashInputs(files []git.CommitFile) []string {
+	var inputs []string
+	for _, f := range files {
+		content := ""
+		if f.Content != nil {
+			content = *f.Content
+		}
+		inputs = append(inputs, f.Path, content)
+	}
+	sort.Strings(inputs)
+	return inputs
+}
@@ -0,0 +1,236 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	csgit "github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/git"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/git/gitfakes"
+	capiv1_protos "github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/protos"
+)
+
+func TestUpgradeFromTemplate(t *testing.T) {
+	setViperWithTestCleanup(t, map[string]string{
+		"capi-repository-path":     "clusters/my-cluster/clusters",
+		"capi-templates-namespace": "default",
+	})
+
+	createRequest := `{"repository_url":"https://github.com/org/repo.git","name":"cluster-template-1","namespace":"default","parameter_values":{"CLUSTER_NAME":"foo","NAMESPACE":"default"},"template_kind":"CAPITemplate"}`
+
+	testCases := []struct {
+		name         string
+		clusterState []runtime.Object
+		provider     csgit.Provider
+		req          *capiv1_protos.UpgradeFromTemplateRequest
+		// paths of the committed files, deleted files are suffixed with " (deleted)"
+		committed []string
+		upgraded  int32
+		err       error
+	}{
+		{
+			name: "validation errors",
+			req:  &capiv1_protos.UpgradeFromTemplateRequest{},
+			err:  errors.New("rpc error: code = InvalidArgument desc = template name must be specified"),
+		},
+		{
+			name: "upgrade resources",
+			clusterState: []runtime.Object{
+				makeCAPITemplate(t),
+			},
+			provider: gitfakes.NewFakeGitProvider("https://github.com/org/repo/pull/1", nil, nil, nil, nil),
+			req: &capiv1_protos.UpgradeFromTemplateRequest{
+				Name:      "cluster-template-1",
+				Namespace: "default",
+				Resources: []*capiv1_protos.TemplatedResources{
+					{
+						CreateRequest: createRequest,
+						CreatedFiles: []string{
+							"clusters/my-cluster/clusters/default/foo.yaml",
+							"clusters/my-cluster/clusters/default/foo-removed.yaml",
+						},
+					},
+				},
+				RepositoryUrl: "https://github.com/org/repo.git",
+				BaseBranch:    "main",
+			},
+			committed: []string{
+				"clusters/my-cluster/clusters/default/foo-removed.yaml (deleted)",
+				"clusters/my-cluster/clusters/default/foo.yaml",
+			},
+			upgraded: 1,
+		},
+		{
+			name: "upgrade resources created with template_name",
+			clusterState: []runtime.Object{
+				makeCAPITemplate(t),
+			},
+			provider: gitfakes.NewFakeGitProvider("https://github.com/org/repo/pull/1", nil, nil, nil, nil),
+			req: &capiv1_protos.UpgradeFromTemplateRequest{
+				Name:      "cluster-template-1",
+				Namespace: "default",
+				Resources: []*capiv1_protos.TemplatedResources{
+					{
+						CreateRequest: `{"repository_url":"https://github.com/org/repo","template_name":"cluster-template-1","parameter_values":{"CLUSTER_NAME":"foo","NAMESPACE":"default"}}`,
+					},
+					{
+						CreateRequest: strings.ReplaceAll(createRequest, `"foo"`, `"bar"`),
+					},
+				},
+				RepositoryUrl: "https://github.com/org/repo.git",
+				BaseBranch:    "main",
+			},
+			committed: []string{
+				"clusters/my-cluster/clusters/default/bar.yaml",
+				"clusters/my-cluster/clusters/default/foo.yaml",
+			},
+			upgraded: 2,
+		},
+		{
+			name: "resources written to the same files",
+			clusterState: []runtime.Object{
+				makeCAPITemplate(t),
+			},
+			provider: gitfakes.NewFakeGitProvider("https://github.com/org/repo/pull/1", nil, nil, nil, nil),
+			req: &capiv1_protos.UpgradeFromTemplateRequest{
+				Name:      "cluster-template-1",
+				Namespace: "default",
+				Resources: []*capiv1_protos.TemplatedResources{
+					{CreateRequest: createRequest},
+					{CreateRequest: strings.ReplaceAll(createRequest, `"name"`, `"title":"Other","name"`)},
+				},
+				RepositoryUrl: "https://github.com/org/repo.git",
+			},
+			err: errors.New("rpc error: code = FailedPrecondition desc = more than one set of resources created from template cluster-template-1 is written to clusters/my-cluster/clusters/default/foo.yaml"),
+		},
+		{
+			name: "resources of other templates and repositories are skipped",
+			clusterState: []runtime.Object{
+				makeCAPITemplate(t),
+			},
+			provider: gitfakes.NewFakeGitProvider("https://github.com/org/repo/pull/1", nil, nil, nil, nil),
+			req: &capiv1_protos.UpgradeFromTemplateRequest{
+				Name:      "cluster-template-1",
+				Namespace: "default",
+				Resources: []*capiv1_protos.TemplatedResources{
+					{CreateRequest: strings.ReplaceAll(createRequest, "org/repo", "org/other")},
+					{CreateRequest: strings.ReplaceAll(createRequest, "CAPITemplate", "GitOpsTemplate")},
+				},
+				RepositoryUrl: "https://github.com/org/repo.git",
+			},
+			err: errors.New("rpc error: code = NotFound desc = no resources created from template cluster-template-1 in https://github.com/org/repo.git found"),
+		},
+		{
+			name: "upgrade resources in the cluster",
+			clusterState: []runtime.Object{
+				makeCAPITemplate(t),
+				&unstructured.Unstructured{Object: map[string]interface{}{
+					"apiVersion": "fooversion",
+					"kind":       "fookind",
+					"metadata": map[string]interface{}{
+						"name":      "foo",
+						"namespace": "default",
+						"labels": map[string]interface{}{
+							"templates.weave.works/template-name": "cluster-template-1",
+						},
+						"annotations": map[string]interface{}{
+							"templates.weave.works/create-request": createRequest,
+							"templates.weave.works/created-files":  `{"files":["clusters/my-cluster/clusters/default/foo.yaml","clusters/my-cluster/clusters/default/foo-removed.yaml"]}`,
+						},
+					},
+				}},
+			},
+			provider: gitfakes.NewFakeGitProvider("https://github.com/org/repo/pull/1", nil, nil, nil, nil),
+			req: &capiv1_protos.UpgradeFromTemplateRequest{
+				Name:          "cluster-template-1",
+				Namespace:     "default",
+				RepositoryUrl: "https://github.com/org/repo.git",
+			},
+			committed: []string{
+				"clusters/my-cluster/clusters/default/foo-removed.yaml (deleted)",
+				"clusters/my-cluster/clusters/default/foo.yaml",
+			},
+			upgraded: 1,
+		},
+		{
+			name: "no resources in the cluster",
+			clusterState: []runtime.Object{
+				makeCAPITemplate(t),
+			},
+			provider: gitfakes.NewFakeGitProvider("https://github.com/org/repo/pull/1", nil, nil, nil, nil),
+			req: &capiv1_protos.UpgradeFromTemplateRequest{
+				Name:          "cluster-template-1",
+				Namespace:     "default",
+				RepositoryUrl: "https://github.com/org/repo.git",
+			},
+			err: errors.New("rpc error: code = NotFound desc = no resources created from template cluster-template-1 in https://github.com/org/repo.git found"),
+		},
+		{
+			name: "invalid create request",
+			clusterState: []runtime.Object{
+				makeCAPITemplate(t),
+			},
+			provider: gitfakes.NewFakeGitProvider("https://github.com/org/repo/pull/1", nil, nil, nil, nil),
+			req: &capiv1_protos.UpgradeFromTemplateRequest{
+				Name:          "cluster-template-1",
+				Namespace:     "default",
+				Resources:     []*capiv1_protos.TemplatedResources{{CreateRequest: "{"}},
+				RepositoryUrl: "https://github.com/org/repo.git",
+			},
+			err: errors.New("rpc error: code = InvalidArgument desc = invalid templates.weave.works/create-request annotation: unexpected end of JSON input"),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			s := createServer(t, serverOptions{
+				clusterState: tt.clusterState,
+				namespace:    "default",
+				provider:     tt.provider,
+			})
+
+			res, err := s.UpgradeFromTemplate(context.Background(), tt.req)
+			if err != nil {
+				if tt.err == nil {
+					t.Fatalf("failed to upgrade resources:\n%s", err)
+				}
+				if diff := cmp.Diff(tt.err.Error(), err.Error()); diff != "" {
+					t.Fatalf("got the wrong error:\n%s", diff)
+				}
+				return
+			}
+			if tt.err != nil {
+				t.Fatalf("expected error %q, got none", tt.err)
+			}
+
+			if diff := cmp.Diff("https://github.com/org/repo/pull/1", res.WebUrl); diff != "" {
+				t.Fatalf("pull request url didn't match expected:\n%s", diff)
+			}
+			if res.Upgraded != tt.upgraded {
+				t.Fatalf("expected %d upgraded sets of resources, got %d", tt.upgraded, res.Upgraded)
+			}
+
+			var committed []string
+			for _, f := range tt.provider.(*gitfakes.FakeGitProvider).CommittedFiles {
+				if f.Content == nil {
+					committed = append(committed, f.Path+" (deleted)")
+					continue
+				}
+				committed = append(committed, f.Path)
+				if !strings.Contains(*f.Content, "templates.weave.works/create-request") {
+					t.Errorf("file %s is not annotated with the create request:\n%s", f.Path, *f.Content)
+				}
+			}
+			sort.Strings(committed)
+			if diff := cmp.Diff(tt.committed, committed); diff != "" {
+				t.Fatalf("committed files didn't match expected:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,437 @@
+package templates
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	capiv1 "github.com/weaveworks/templates-controller/apis/capi/v1alpha2"
+	templatesv1 "github.com/weaveworks/templates-controller/apis/core"
+	gapiv1 "github.com/weaveworks/templates-controller/apis/gitops/v1alpha2"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// BaseTemplateAnnotation can be added to a Template to extend another
+	// template of the same kind, referenced as "name" in the namespace of the
+	// template or as "namespace/name".
+	//
+	// The resource templates and params of the base are merged into the
+	// template.
+	BaseTemplateAnnotation string = "templates.weave.works/base"
+
+	// IncludesAnnotation can be added to a Template to include the resource
+	// templates and params of other templates of the same kind, as a comma
+	// separated list of references like the BaseTemplateAnnotation.
+	//
+	// A reference suffixed with "#partial" only includes the resource
+	// templates of a partial of the referenced template, see
+	// PartialsAnnotation.
+	IncludesAnnotation string = "templates.weave.works/includes"
+
+	// PartialsAnnotation can be added to a Template to name sets of its
+	// resource templates, that other templates can include on their own. It
+	// maps the name of each partial to the paths of its resource templates,
+	// in YAML.
+	//
+	// The resource templates of a partial can be inherited by the template
+	// from the templates it references.
+	PartialsAnnotation string = "templates.weave.works/partials"
+)
+
+// TemplateReference identifies a template referenced by another.
+type TemplateReference struct {
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+func (r TemplateReference) String() string {
+	return r.Namespace + "/" + r.Name
+}
+
+// TemplateResolver gets the templates referenced by other templates.
+type TemplateResolver interface {
+	ResolveTemplate(ctx context.Context, ref TemplateReference) (templatesv1.Template, error)
+}
+
+// HasTemplateReferences returns true if the template has a base or includes
+// other templates.
+func HasTemplateReferences(t templatesv1.Template) bool {
+	annotations := t.GetAnnotations()
+	return annotations[BaseTemplateAnnotation] != "" || annotations[IncludesAnnotation] != ""
+}
+
+// ComposeTemplate returns a copy of the template with the resource templates
+// and params of its base and included templates, and of the ones they
+// reference in turn.
+//
+// The resource templates of the base come first, then the ones of the
+// includes in order, and then the ones of the template. Params are merged
+// the same way, so that a template overrides the declarations of the params
+// it inherits, as well as their types.
+//
+// A partial only brings the resource templates it names, along with the
+// params of its template.
+//
+// A template or partial referenced more than once is only merged once, and
+// references that lead back to a template are an error.
+//
+// The copy has no references left, so templates without references are
+// returned as they are.
+func ComposeTemplate(ctx context.Context, t templatesv1.Template, resolver TemplateResolver) (templatesv1.Template, error) {
+	if !HasTemplateReferences(t) {
+		return t, nil
+	}
+
+	c := &composer{
+		ctx:      ctx,
+		resolver: resolver,
+		merged:   map[templateInclude]bool{},
+		types:    map[string]paramTypeDeclaration{},
+	}
+	if err := c.compose(t, nil); err != nil {
+		return nil, err
+	}
+
+	return c.composed(t)
+}
+
+// templateInclude is a template, or one of its partials, merged into
+// another.
+type templateInclude struct {
+	ref     TemplateReference
+	partial string
+}
+
+func (i templateInclude) String() string {
+	if i.partial == "" {
+		return i.ref.String()
+	}
+	return i.ref.String() + "#" + i.partial
+}
+
+type composer struct {
+	ctx      context.Context
+	resolver TemplateResolver
+
+	merged            map[templateInclude]bool
+	resourceTemplates []templatesv1.ResourceTemplate
+	params            []templatesv1.TemplateParam
+	types             map[string]paramTypeDeclaration
+}
+
+// compose merges the templates referenced by t, and then t itself. path is
+// the chain of references that led to t.
+func (c *composer) compose(t templatesv1.Template, path []TemplateReference) error {
+	ref, err := referenceOf(t)
+	if err != nil {
+		return err
+	}
+	for _, v := range path {
+		if v == ref {
+			return fmt.Errorf("template reference cycle: %s", formatReferences(append(path, ref)))
+		}
+	}
+	if c.merged[templateInclude{ref: ref}] {
+		return nil
+	}
+	path = append(path, ref)
+
+	includes, err := templateIncludes(t, ref)
+	if err != nil {
+		return err
+	}
+	for _, inc := range includes {
+		referenced, err := c.resolver.ResolveTemplate(c.ctx, inc.ref)
+		if err != nil {
+			return fmt.Errorf("failed to resolve template %s referenced by %s: %w", inc.ref, ref, err)
+		}
+		if referenced.GetSpec().RenderType != t.GetSpec().RenderType {
+			return fmt.Errorf("template %s referenced by %s has renderType %q, not %q",
+				inc.ref, ref, referenced.GetSpec().RenderType, t.GetSpec().RenderType)
+		}
+
+		if inc.partial == "" {
+			err = c.compose(referenced, path)
+		} else {
+			err = c.composePartial(referenced, inc, path)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	types, err := paramTypes(t)
+	if err != nil {
+		return fmt.Errorf("invalid template %s: %w", ref, err)
+	}
+	c.merge(t.GetSpec().ResourceTemplates, t.GetSpec().Params, types)
+	c.merged[templateInclude{ref: ref}] = true
+
+	return nil
+}
+
+// composePartial merges the resource templates of a partial of t, once t is
+// composed on its own, along with the params of t. Partials of templates
+// merged as a whole are already merged.
+func (c *composer) composePartial(t templatesv1.Template, inc templateInclude, path []TemplateReference) error {
+	if c.merged[inc] || c.merged[templateInclude{ref: inc.ref}] {
+		return nil
+	}
+
+	partials, err := templatePartials(t)
+	if err != nil {
+		return fmt.Errorf("invalid template %s: %w", inc.ref, err)
+	}
+	paths, ok := partials[inc.partial]
+	if !ok {
+		return fmt.Errorf("template %s has no partial %q", inc.ref, inc.partial)
+	}
+
+	whole := &composer{
+		ctx:      c.ctx,
+		resolver: c.resolver,
+		merged:   map[templateInclude]bool{},
+		types:    map[string]paramTypeDeclaration{},
+	}
+	if err := whole.compose(t, path); err != nil {
+		return err
+	}
+
+	var resourceTemplates []templatesv1.ResourceTemplate
+	for _, p := range paths {
+		found := false
+		for _, rt := range whole.resourceTemplates {
+			if rt.Path == p {
+				resourceTemplates = append(resourceTemplates, rt)
+				found = true
+			}
+		}
+		if !found {
+			return fmt.Errorf("partial %s has no resource template with path %q", inc, p)
+		}
+	}
+
+	c.merge(resourceTemplates, whole.params, whole.types)
+	c.merged[inc] = true
+
+	return nil
+}
+
+// merge appends resource templates, and overrides the params and their types.
+func (c *composer) merge(resourceTemplates []templatesv1.ResourceTemplate, params []templatesv1.TemplateParam, types map[string]paramTypeDeclaration) {
+	c.resourceTemplates = append(c.resourceTemplates, resourceTemplates...)
+	for _, p := range params {
+		c.mergeParam(p)
+	}
+	for name, d := range types {
+		c.types[name] = d
+	}
+}
+
+// mergeParam overrides the declaration of an inherited param, keeping its
+// position, or adds a new one.
+func (c *composer) mergeParam(p templatesv1.TemplateParam) {
+	for i := range c.params {
+		if c.params[i].Name == p.Name {
+			c.params[i] = p
+			return
+		}
+	}
+	c.params = append(c.params, p)
+}
+
+// composed copies t with the merged resource templates, params and types.
+func (c *composer) composed(t templatesv1.Template) (templatesv1.Template, error) {
+	annotations := map[string]string{}
+	for k, v := range t.GetAnnotations() {
+		annotations[k] = v
+	}
+	delete(annotations, BaseTemplateAnnotation)
+	delete(annotations, IncludesAnnotation)
+	if len(c.types) > 0 {
+		b, err := yaml.Marshal(c.types)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal param types: %w", err)
+		}
+		annotations[ParamTypesAnnotation] = string(b)
+	}
+
+	switch v := t.(type) {
+	case *gapiv1.GitOpsTemplate:
+		composed := v.DeepCopy()
+		composed.Annotations = annotations
+		composed.Spec.ResourceTemplates = c.resourceTemplates
+		composed.Spec.Params = c.params
+		return composed, nil
+	case *capiv1.CAPITemplate:
+		composed := v.DeepCopy()
+		composed.Annotations = annotations
+		composed.Spec.ResourceTemplates = c.resourceTemplates
+		composed.Spec.Params = c.params
+		return composed, nil
+	}
+
+	return nil, fmt.Errorf("cannot compose template of type %T", t)
+}
+
+func referenceOf(t templatesv1.Template) (TemplateReference, error) {
+	ref := TemplateReference{Namespace: t.GetNamespace(), Name: t.GetName()}
+	switch t.(type) {
+	case *gapiv1.GitOpsTemplate:
+		ref.Kind = gapiv1.Kind
+	case *capiv1.CAPITemplate:
+		ref.Kind = capiv1.Kind
+	default:
+		return ref, fmt.Errorf("cannot compose template of type %T", t)
+	}
+	return ref, nil
+}
+
+// templateIncludes parses the base and includes of a template, in the
+// order they are merged. The base cannot be a partial.
+func templateIncludes(t templatesv1.Template, from TemplateReference) ([]templateInclude, error) {
+	var values []string
+	base := strings.TrimSpace(t.GetAnnotations()[BaseTemplateAnnotation])
+	if base != "" {
+		if strings.Contains(base, "#") {
+			return nil, fmt.Errorf("invalid base %q in template %s: a base cannot be a partial", base, from)
+		}
+		values = append(values, base)
+	}
+	for _, v := range strings.Split(t.GetAnnotations()[IncludesAnnotation], ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+
+	var includes []templateInclude
+	for _, v := range values {
+		name, partial, isPartial := strings.Cut(v, "#")
+		ref := TemplateReference{Kind: from.Kind, Namespace: from.Namespace, Name: name}
+		if namespace, name, ok := strings.Cut(name, "/"); ok {
+			ref.Namespace, ref.Name = namespace, name
+		}
+		if ref.Name == "" || strings.Contains(ref.Name, "/") || (isPartial && partial == "") {
+			return nil, fmt.Errorf("invalid reference %q in template %s", v, from)
+		}
+		includes = append(includes, templateInclude{ref: ref, partial: partial})
+	}
+
+	return includes, nil
+}
+
+// templatePartials parses the partials of a template.
+func templatePartials(t templatesv1.Template) (map[string][]string, error) {
+	partials := map[string][]string{}
+	if v := t.GetAnnotations()[PartialsAnnotation]; v != "" {
+		if err := yaml.Unmarshal([]byte(v), &partials); err != nil {
+			return nil, fmt.Errorf("failed to parse %s annotation: %w", PartialsAnnotation, err)
+		}
+	}
+	return partials, nil
+}
+
+func formatReferences(refs []TemplateReference) string {
+	s := make([]string, len(refs))
+	for i := range refs {
+		s[i] = refs[i].String()
+	}
+	return strings.Join(s, " -> ")
+}
+
+// NewTemplateListResolver resolves references to the templates of a list,
+// like the templates already listed from a cluster.
+func NewTemplateListResolver(list []templatesv1.Template) (TemplateResolver, error) {
+	r := listTemplateResolver{}
+	for _, t := range list {
+		if err := r.add(t); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// LoadTemplateResolver resolves references to the templates in the YAML or
+// JSON files of a directory and its subdirectories. Other resources in the
+// files are ignored.
+func LoadTemplateResolver(dir string) (TemplateResolver, error) {
+	r := listTemplateResolver{}
+	err := filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".yaml", ".yml", ".json":
+		default:
+			return nil
+		}
+
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read template file: %w", err)
+		}
+
+		objs, err := decodeResources(b)
+		if err != nil {
+			return fmt.Errorf("failed to parse template file %s: %w", path, err)
+		}
+
+		for _, obj := range objs {
+			var t templatesv1.Template
+			switch obj.GroupVersionKind() {
+			case gapiv1.GroupVersion.WithKind(gapiv1.Kind):
+				t = &gapiv1.GitOpsTemplate{}
+			case capiv1.GroupVersion.WithKind(capiv1.Kind):
+				t = &capiv1.CAPITemplate{}
+			default:
+				continue
+			}
+
+			// the content of resource templates is raw JSON
+			j, err := obj.MarshalJSON()
+			if err != nil {
+				return fmt.Errorf("failed to parse template %s in %s: %w", obj.GetName(), path, err)
+			}
+			if err := json.Unmarshal(j, t); err != nil {
+				return fmt.Errorf("failed to parse template %s in %s: %w", obj.GetName(), path, err)
+			}
+			if err := r.add(t); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load templates from %s: %w", dir, err)
+	}
+
+	return r, nil
+}
+
+type listTemplateResolver map[TemplateReference]templatesv1.Template
+
+func (r listTemplateResolver) add(t templatesv1.Template) error {
+	ref, err := referenceOf(t)
+	if err != nil {
+		return err
+	}
+	r[ref] = t
+	return nil
+}
+
+func (r listTemplateResolver) ResolveTemplate(ctx context.Context, ref TemplateReference) (templatesv1.Template, error) {
+	t, ok := r[ref]
+	if !ok {
+		return nil, fmt.Errorf("%s %s not found", ref.Kind, ref)
+	}
+	return t, nil
+}
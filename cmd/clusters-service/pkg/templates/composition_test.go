@@ -0,0 +1,318 @@
+package templates
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	templatesv1 "github.com/weaveworks/templates-controller/apis/core"
+	gapiv1 "github.com/weaveworks/templates-controller/apis/gitops/v1alpha2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type mapTemplateResolver map[string]templatesv1.Template
+
+func (r mapTemplateResolver) ResolveTemplate(ctx context.Context, ref TemplateReference) (templatesv1.Template, error) {
+	t, ok := r[ref.Namespace+"/"+ref.Name]
+	if !ok || ref.Kind != gapiv1.Kind {
+		return nil, fmt.Errorf("%s %s not found", ref.Kind, ref)
+	}
+	return t, nil
+}
+
+func makeComposedTemplate(name string, annotations map[string]string, params []templatesv1.TemplateParam, paths ...string) *gapiv1.GitOpsTemplate {
+	t := &gapiv1.GitOpsTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "default",
+			Annotations: annotations,
+		},
+		Spec: templatesv1.TemplateSpec{
+			Params: params,
+		},
+	}
+	for _, path := range paths {
+		t.Spec.ResourceTemplates = append(t.Spec.ResourceTemplates, templatesv1.ResourceTemplate{
+			Path:    path,
+			Content: []templatesv1.ResourceTemplateContent{{RawExtension: rawExtension(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"${NAME}"}}`)}},
+		})
+	}
+	return t
+}
+
+func resourceTemplatePaths(t templatesv1.Template) []string {
+	var paths []string
+	for _, rt := range t.GetSpec().ResourceTemplates {
+		paths = append(paths, rt.Path)
+	}
+	return paths
+}
+
+func TestComposeTemplate(t *testing.T) {
+	resolver := mapTemplateResolver{
+		"default/base": makeComposedTemplate("base", map[string]string{
+			BaseTemplateAnnotation: "root",
+			ParamTypesAnnotation:   "REPLICAS: {type: integer}",
+		}, []templatesv1.TemplateParam{
+			{Name: "NAME", Description: "base name"},
+			{Name: "REPLICAS", Default: "1"},
+		}, "base.yaml"),
+		"default/root": makeComposedTemplate("root", nil, []templatesv1.TemplateParam{
+			{Name: "NAMESPACE", Default: "default"},
+		}, "root.yaml"),
+		"default/rbac": makeComposedTemplate("rbac", nil, nil, "rbac.yaml"),
+		"shared/namespace": makeComposedTemplate("namespace", map[string]string{
+			IncludesAnnotation: "default/rbac",
+		}, []templatesv1.TemplateParam{
+			{Name: "NAME", Description: "namespace name"},
+		}, "namespace.yaml"),
+	}
+
+	tm := makeComposedTemplate("cluster", map[string]string{
+		BaseTemplateAnnotation: "base",
+		IncludesAnnotation:     "shared/namespace, rbac",
+		"example.com/other":    "kept",
+	}, []templatesv1.TemplateParam{
+		{Name: "REPLICAS", Default: "3"},
+		{Name: "CLUSTER_NAME"},
+	}, "cluster.yaml")
+
+	composed, err := ComposeTemplate(context.Background(), tm, resolver)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// rbac is included by namespace, and only merged once
+	assert.Equal(t, []string{"root.yaml", "base.yaml", "rbac.yaml", "namespace.yaml", "cluster.yaml"}, resourceTemplatePaths(composed))
+
+	want := []templatesv1.TemplateParam{
+		{Name: "NAMESPACE", Default: "default"},
+		{Name: "NAME", Description: "namespace name"},
+		{Name: "REPLICAS", Default: "3"},
+		{Name: "CLUSTER_NAME"},
+	}
+	if diff := cmp.Diff(want, composed.GetSpec().Params); diff != "" {
+		t.Fatalf("failed to merge params:\n%s", diff)
+	}
+
+	assert.Equal(t, map[string]string{
+		"example.com/other":  "kept",
+		ParamTypesAnnotation: "REPLICAS:\n  type: integer\n",
+	}, composed.GetAnnotations())
+
+	// the template is not modified
+	assert.Equal(t, []string{"cluster.yaml"}, resourceTemplatePaths(tm))
+}
+
+func TestComposeTemplate_partials(t *testing.T) {
+	resolver := mapTemplateResolver{
+		"default/boilerplate": makeComposedTemplate("boilerplate", map[string]string{
+			BaseTemplateAnnotation: "root",
+			PartialsAnnotation:     "namespace: [root.yaml, namespace.yaml]\nrbac: [rbac.yaml]",
+		}, []templatesv1.TemplateParam{
+			{Name: "NAME", Description: "boilerplate name"},
+		}, "namespace.yaml", "rbac.yaml", "kustomization.yaml"),
+		"default/root": makeComposedTemplate("root", nil, []templatesv1.TemplateParam{
+			{Name: "NAMESPACE", Default: "default"},
+		}, "root.yaml"),
+	}
+
+	tm := makeComposedTemplate("cluster", map[string]string{
+		IncludesAnnotation: "boilerplate#namespace, default/boilerplate#rbac, boilerplate#rbac",
+	}, []templatesv1.TemplateParam{
+		{Name: "NAME", Description: "cluster name"},
+	}, "cluster.yaml")
+
+	composed, err := ComposeTemplate(context.Background(), tm, resolver)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// only the resource templates of the partials are included, inherited ones too, and each partial once
+	assert.Equal(t, []string{"root.yaml", "namespace.yaml", "rbac.yaml", "cluster.yaml"}, resourceTemplatePaths(composed))
+
+	want := []templatesv1.TemplateParam{
+		{Name: "NAMESPACE", Default: "default"},
+		{Name: "NAME", Description: "cluster name"},
+	}
+	if diff := cmp.Diff(want, composed.GetSpec().Params); diff != "" {
+		t.Fatalf("failed to merge params:\n%s", diff)
+	}
+}
+
+func TestComposeTemplate_without_references(t *testing.T) {
+	tm := makeComposedTemplate("cluster", nil, nil, "cluster.yaml")
+
+	composed, err := ComposeTemplate(context.Background(), tm, mapTemplateResolver{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Same(t, tm, composed)
+}
+
+func TestComposeTemplate_errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		templates []*gapiv1.GitOpsTemplate
+		wantErr   string
+	}{
+		{
+			name: "cycle",
+			templates: []*gapiv1.GitOpsTemplate{
+				makeComposedTemplate("a", map[string]string{BaseTemplateAnnotation: "b"}, nil),
+				makeComposedTemplate("b", map[string]string{IncludesAnnotation: "c"}, nil),
+				makeComposedTemplate("c", map[string]string{BaseTemplateAnnotation: "a"}, nil),
+			},
+			wantErr: "template reference cycle: default/a -> default/b -> default/c -> default/a",
+		},
+		{
+			name: "self reference",
+			templates: []*gapiv1.GitOpsTemplate{
+				makeComposedTemplate("a", map[string]string{IncludesAnnotation: "a"}, nil),
+			},
+			wantErr: "template reference cycle: default/a -> default/a",
+		},
+		{
+			name: "missing reference",
+			templates: []*gapiv1.GitOpsTemplate{
+				makeComposedTemplate("a", map[string]string{BaseTemplateAnnotation: "missing"}, nil),
+			},
+			wantErr: "failed to resolve template default/missing referenced by default/a: GitOpsTemplate default/missing not found",
+		},
+		{
+			name: "invalid reference",
+			templates: []*gapiv1.GitOpsTemplate{
+				makeComposedTemplate("a", map[string]string{IncludesAnnotation: "a/b/c"}, nil),
+			},
+			wantErr: `invalid reference "a/b/c" in template default/a`,
+		},
+		{
+			name: "unknown partial",
+			templates: []*gapiv1.GitOpsTemplate{
+				makeComposedTemplate("a", map[string]string{IncludesAnnotation: "b#rbac"}, nil),
+				makeComposedTemplate("b", nil, nil, "rbac.yaml"),
+			},
+			wantErr: `template default/b has no partial "rbac"`,
+		},
+		{
+			name: "partial without its resource template",
+			templates: []*gapiv1.GitOpsTemplate{
+				makeComposedTemplate("a", map[string]string{IncludesAnnotation: "b#rbac"}, nil),
+				makeComposedTemplate("b", map[string]string{PartialsAnnotation: "rbac: [rbac.yaml]"}, nil, "namespace.yaml"),
+			},
+			wantErr: `partial default/b#rbac has no resource template with path "rbac.yaml"`,
+		},
+		{
+			name: "partial cycle",
+			templates: []*gapiv1.GitOpsTemplate{
+				makeComposedTemplate("a", map[string]string{IncludesAnnotation: "b#rbac"}, nil),
+				makeComposedTemplate("b", map[string]string{BaseTemplateAnnotation: "a", PartialsAnnotation: "rbac: [rbac.yaml]"}, nil, "rbac.yaml"),
+			},
+			wantErr: "template reference cycle: default/a -> default/b -> default/a",
+		},
+		{
+			name: "partial base",
+			templates: []*gapiv1.GitOpsTemplate{
+				makeComposedTemplate("a", map[string]string{BaseTemplateAnnotation: "b#rbac"}, nil),
+			},
+			wantErr: `invalid base "b#rbac" in template default/a: a base cannot be a partial`,
+		},
+		{
+			name: "different render types",
+			templates: func() []*gapiv1.GitOpsTemplate {
+				b := makeComposedTemplate("b", nil, nil)
+				b.Spec.RenderType = templatesv1.RenderTypeTemplating
+				return []*gapiv1.GitOpsTemplate{
+					makeComposedTemplate("a", map[string]string{BaseTemplateAnnotation: "b"}, nil),
+					b,
+				}
+			}(),
+			wantErr: `template default/b referenced by default/a has renderType "templating", not ""`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolver := mapTemplateResolver{}
+			for _, v := range tt.templates {
+				resolver["default/"+v.Name] = v
+			}
+
+			_, err := ComposeTemplate(context.Background(), tt.templates[0], resolver)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestNewProcessorForTemplate_with_references(t *testing.T) {
+	resolver := mapTemplateResolver{
+		"default/base": makeComposedTemplate("base", nil, []templatesv1.TemplateParam{
+			{Name: "NAME", Description: "name of the config map", Required: true},
+		}, "base.yaml"),
+	}
+	tm := makeComposedTemplate("cluster", map[string]string{BaseTemplateAnnotation: "base"}, nil)
+
+	_, err := NewProcessorForTemplate(tm)
+	assert.EqualError(t, err, "template default/cluster references other templates, which cannot be resolved")
+
+	params, err := ParamsFromTemplate(tm, WithTemplateResolver(context.Background(), resolver))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Param{{Name: "NAME", Description: "name of the config map", Required: true}}
+	if diff := cmp.Diff(want, params); diff != "" {
+		t.Fatalf("failed to get params:\n%s", diff)
+	}
+
+	processor, err := NewProcessorForTemplate(tm, WithTemplateResolver(context.Background(), resolver))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rendered, err := processor.RenderTemplates(map[string]string{"NAME": "test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, rendered, 1)
+	assert.Equal(t, "base.yaml", rendered[0].Path)
+	assert.Contains(t, string(rendered[0].Data[0]), "name: test")
+}
+
+func TestNewTemplateListResolver(t *testing.T) {
+	base := makeComposedTemplate("base", nil, nil, "base.yaml")
+	resolver, err := NewTemplateListResolver([]templatesv1.Template{base, makeComposedTemplate("cluster", nil, nil)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resolved, err := resolver.ResolveTemplate(context.Background(), TemplateReference{Kind: gapiv1.Kind, Namespace: "default", Name: "base"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Same(t, base, resolved)
+
+	_, err = resolver.ResolveTemplate(context.Background(), TemplateReference{Kind: gapiv1.Kind, Namespace: "other", Name: "base"})
+	assert.EqualError(t, err, "GitOpsTemplate other/base not found")
+}
+
+func TestLoadTemplateResolver(t *testing.T) {
+	resolver, err := LoadTemplateResolver("testdata/composition")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tm, err := resolver.ResolveTemplate(context.Background(), TemplateReference{Kind: gapiv1.Kind, Namespace: "default", Name: "cluster"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	composed, err := ComposeTemplate(context.Background(), tm, resolver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"namespace.yaml", "cluster.yaml"}, resourceTemplatePaths(composed))
+
+	_, err = resolver.ResolveTemplate(context.Background(), TemplateReference{Kind: "CAPITemplate", Namespace: "default", Name: "cluster"})
+	assert.EqualError(t, err, "CAPITemplate default/cluster not found")
+}
@@ -15,4 +15,11 @@ type Param struct {
 	Required    bool     `json:"required"`
 	Options     []string `json:"options"`
 	Default     string   `json:"default"`
+
+	// Type, Pattern, Minimum and Maximum constrain the values of the
+	// parameter, as declared in the ParamTypesAnnotation of the template.
+	Type    ParamType `json:"type,omitempty"`
+	Pattern string    `json:"pattern,omitempty"`
+	Minimum *int64    `json:"minimum,omitempty"`
+	Maximum *int64    `json:"maximum,omitempty"`
 }
@@ -18,19 +18,25 @@ const (
 	InjectPruneAnnotationAnnotation = "templates.weave.works/inject-prune-annotation"
 	ProfilesAnnotation              = "capi.weave.works/profile-"
 	SopsKustomizationAnnotation     = "templates.weave.works/sops-enabled"
+	// CreateRequestAnnotation records the pull request a resource was rendered for
+	CreateRequestAnnotation = "templates.weave.works/create-request"
+	// CreatedFilesAnnotation lists the files rendered with a resource
+	CreatedFilesAnnotation = "templates.weave.works/created-files"
+	// TemplateNameLabel is the name of the template a resource was rendered from
+	TemplateNameLabel = "templates.weave.works/template-name"
 )
 
 // ParseTemplateMeta parses a byte slice into a TemplateMeta struct which
 // contains the objects that are in the template, along with the parameters used
 // by each of the objects.
-func ParseTemplateMeta(s apitemplates.Template, annotation string) (*TemplateMeta, error) {
-	processor, err := NewProcessorForTemplate(s)
+func ParseTemplateMeta(s apitemplates.Template, annotation string, opts ...ProcessorOption) (*TemplateMeta, error) {
+	processor, err := NewProcessorForTemplate(s, opts...)
 	if err != nil {
 		return nil, err
 	}
 
 	var objects []Object
-	for _, resourcetemplateDefinition := range s.GetSpec().ResourceTemplates {
+	for _, resourcetemplateDefinition := range processor.GetSpec().ResourceTemplates {
 		for _, v := range resourcetemplateDefinition.Content {
 			params, err := processor.ParamNames(v.Raw)
 			if err != nil {
@@ -0,0 +1,294 @@
+package templates
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	templatesv1 "github.com/weaveworks/templates-controller/apis/core"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sigs.k8s.io/yaml"
+)
+
+// ParamTypesAnnotation can be added to a Template to declare the types of
+// its parameters.
+//
+// It's a YAML or JSON map of parameter names to their type, pattern, minimum
+// and maximum, e.g.
+//
+//	REPLICAS:
+//	  type: integer
+//	  minimum: 1
+//	  maximum: 5
+//	ENVIRONMENT:
+//	  type: enum
+//	CLUSTER_NAME:
+//	  pattern: "^[a-z0-9-]+$"
+//
+// The values of enum parameters are the options declared in the params of
+// the template.
+const ParamTypesAnnotation string = "templates.weave.works/param-types"
+
+// ParamType is the type of the values of a parameter.
+type ParamType string
+
+const (
+	ParamTypeString  ParamType = "string"
+	ParamTypeInteger ParamType = "integer"
+	ParamTypeBoolean ParamType = "boolean"
+	ParamTypeEnum    ParamType = "enum"
+)
+
+type paramTypeDeclaration struct {
+	Type    ParamType `json:"type,omitempty"`
+	Pattern string    `json:"pattern,omitempty"`
+	Minimum *int64    `json:"minimum,omitempty"`
+	Maximum *int64    `json:"maximum,omitempty"`
+}
+
+// paramTypes parses the ParamTypesAnnotation of a template.
+func paramTypes(t templatesv1.Template) (map[string]paramTypeDeclaration, error) {
+	ann, ok := t.GetAnnotations()[ParamTypesAnnotation]
+	if !ok {
+		return nil, nil
+	}
+
+	declarations := map[string]paramTypeDeclaration{}
+	if err := yaml.Unmarshal([]byte(ann), &declarations); err != nil {
+		return nil, fmt.Errorf("failed to parse %s annotation: %w", ParamTypesAnnotation, err)
+	}
+
+	return declarations, nil
+}
+
+// applyType constrains the values of the param with its declared type.
+func (p *Param) applyType(d paramTypeDeclaration) error {
+	switch d.Type {
+	case "", ParamTypeString, ParamTypeInteger, ParamTypeBoolean:
+	case ParamTypeEnum:
+		if len(p.Options) == 0 {
+			return fmt.Errorf("enum parameter %s has no options", p.Name)
+		}
+	default:
+		return fmt.Errorf("unknown type %q for parameter %s", d.Type, p.Name)
+	}
+
+	if (d.Minimum != nil || d.Maximum != nil) && d.Type != ParamTypeInteger {
+		return fmt.Errorf("minimum and maximum of parameter %s require the integer type", p.Name)
+	}
+
+	if d.Pattern != "" {
+		if _, err := regexp.Compile(d.Pattern); err != nil {
+			return fmt.Errorf("invalid pattern for parameter %s: %w", p.Name, err)
+		}
+	}
+
+	p.Type = d.Type
+	p.Pattern = d.Pattern
+	p.Minimum = d.Minimum
+	p.Maximum = d.Maximum
+
+	return nil
+}
+
+// ParamError is a parameter whose value is missing or invalid.
+type ParamError struct {
+	Param string
+	// Description tells why the value is invalid, and is empty when the
+	// value of a required parameter is missing.
+	Description string
+}
+
+func (e ParamError) Error() string {
+	if e.Description == "" {
+		return fmt.Sprintf("missing required parameter: %s", e.Param)
+	}
+	return fmt.Sprintf("invalid value for parameter %s: %s", e.Param, e.Description)
+}
+
+// ParamErrors holds every parameter whose value is missing or invalid.
+type ParamErrors []ParamError
+
+func (e ParamErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i := range e {
+		msgs[i] = e[i].Error()
+	}
+	return strings.Join(msgs, ", ")
+}
+
+// GRPCStatus returns the parameters as the field violations of an
+// InvalidArgument status, so that clients can tell which are invalid.
+func (e ParamErrors) GRPCStatus() *status.Status {
+	badRequest := &errdetails.BadRequest{}
+	for _, pe := range e {
+		description := pe.Description
+		if description == "" {
+			description = "is required"
+		}
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       pe.Param,
+			Description: description,
+		})
+	}
+
+	st := status.New(codes.InvalidArgument, e.Error())
+	if withDetails, err := st.WithDetails(badRequest); err == nil {
+		return withDetails
+	}
+	return st
+}
+
+// ValidateParams checks the values of the parameters, or their default
+// when they have no value, against their declared types.
+//
+// All the parameters that are missing or invalid are returned as
+// ParamErrors.
+func ValidateParams(params []Param, values map[string]string) error {
+	var errs ParamErrors
+	for _, p := range params {
+		val := values[p.Name]
+		if val == "" {
+			val = p.Default
+		}
+		if val == "" {
+			if p.Required {
+				errs = append(errs, ParamError{Param: p.Name})
+			}
+			continue
+		}
+
+		if description := p.validate(val); description != "" {
+			errs = append(errs, ParamError{Param: p.Name, Description: description})
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validate returns why the value is invalid, or an empty string when it is valid.
+func (p Param) validate(val string) string {
+	switch p.Type {
+	case ParamTypeInteger:
+		i, err := strconv.ParseInt(val, 10, 64)
+		if err != nil {
+			return "must be an integer"
+		}
+		if p.Minimum != nil && i < *p.Minimum {
+			return fmt.Sprintf("must be at least %d", *p.Minimum)
+		}
+		if p.Maximum != nil && i > *p.Maximum {
+			return fmt.Sprintf("must be at most %d", *p.Maximum)
+		}
+	case ParamTypeBoolean:
+		if val != "true" && val != "false" {
+			return "must be true or false"
+		}
+	case ParamTypeEnum:
+		if !contains(p.Options, val) {
+			return fmt.Sprintf("must be one of %s", strings.Join(p.Options, ", "))
+		}
+	}
+
+	if p.Pattern != "" {
+		// the pattern is checked when the template is parsed
+		if !regexp.MustCompile(p.Pattern).MatchString(val) {
+			return fmt.Sprintf("must match %s", p.Pattern)
+		}
+	}
+
+	return ""
+}
+
+// ParamsJSONSchema returns a JSON Schema of an object with the parameters as
+// properties, which clients can build forms from.
+func ParamsJSONSchema(params []Param) map[string]interface{} {
+	properties := map[string]interface{}{}
+	required := []interface{}{}
+
+	for _, p := range params {
+		property := map[string]interface{}{}
+		if p.Description != "" {
+			property["description"] = p.Description
+		}
+
+		switch p.Type {
+		case ParamTypeInteger:
+			property["type"] = "integer"
+			if p.Minimum != nil {
+				property["minimum"] = *p.Minimum
+			}
+			if p.Maximum != nil {
+				property["maximum"] = *p.Maximum
+			}
+		case ParamTypeBoolean:
+			property["type"] = "boolean"
+		default:
+			property["type"] = "string"
+		}
+
+		if p.Type == ParamTypeEnum {
+			enum := make([]interface{}, len(p.Options))
+			for i := range p.Options {
+				enum[i] = p.Options[i]
+			}
+			property["enum"] = enum
+		}
+
+		if p.Pattern != "" {
+			property["pattern"] = p.Pattern
+		}
+
+		if p.Default != "" {
+			property["default"] = typedValue(p.Type, p.Default)
+		}
+
+		properties[p.Name] = property
+
+		if p.Required && p.Default == "" {
+			required = append(required, p.Name)
+		}
+	}
+
+	schema := map[string]interface{}{
+		"$schema":    "http://json-schema.org/draft-07/schema#",
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+
+	return schema
+}
+
+// typedValue converts the string value of a parameter to its type, if it
+// is valid.
+func typedValue(t ParamType, val string) interface{} {
+	switch t {
+	case ParamTypeInteger:
+		if i, err := strconv.ParseInt(val, 10, 64); err == nil {
+			return i
+		}
+	case ParamTypeBoolean:
+		if b, err := strconv.ParseBool(val); err == nil {
+			return b
+		}
+	}
+	return val
+}
+
+func contains(values []string, val string) bool {
+	for _, v := range values {
+		if v == val {
+			return true
+		}
+	}
+	return false
+}
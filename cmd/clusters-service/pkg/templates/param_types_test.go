@@ -0,0 +1,208 @@
+package templates
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestProcessor_Params_with_types(t *testing.T) {
+	parsed := parseCAPITemplateFromFile(t, "testdata/typed-template.yaml")
+	params, err := ParamsFromTemplate(parsed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	one, five := int64(1), int64(5)
+	want := []Param{
+		{Name: "CLUSTER_NAME", Required: true, Pattern: "^[a-z0-9-]+$"},
+		{Name: "REPLICAS", Description: "Number of control plane machines", Default: "1", Type: ParamTypeInteger, Minimum: &one, Maximum: &five},
+		{Name: "ENVIRONMENT", Options: []string{"dev", "prod"}, Type: ParamTypeEnum},
+		{Name: "HIGH_AVAILABILITY", Type: ParamTypeBoolean},
+	}
+	if diff := cmp.Diff(want, params); diff != "" {
+		t.Fatalf("failed to read params:\n%s", diff)
+	}
+}
+
+func TestProcessor_Params_with_invalid_types(t *testing.T) {
+	tests := []struct {
+		name       string
+		annotation string
+		wantErr    string
+	}{
+		{
+			name:       "unknown type",
+			annotation: "CLUSTER_NAME: {type: float}",
+			wantErr:    `unknown type "float" for parameter CLUSTER_NAME`,
+		},
+		{
+			name:       "enum without options",
+			annotation: "CLUSTER_NAME: {type: enum}",
+			wantErr:    "enum parameter CLUSTER_NAME has no options",
+		},
+		{
+			name:       "maximum of a string",
+			annotation: "CLUSTER_NAME: {maximum: 3}",
+			wantErr:    "minimum and maximum of parameter CLUSTER_NAME require the integer type",
+		},
+		{
+			name:       "invalid pattern",
+			annotation: "CLUSTER_NAME: {pattern: '[a-z'}",
+			wantErr:    "invalid pattern for parameter CLUSTER_NAME",
+		},
+		{
+			name:       "invalid annotation",
+			annotation: "CLUSTER_NAME",
+			wantErr:    "failed to parse templates.weave.works/param-types annotation",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm := makeCAPITemplate(t)
+			tm.SetAnnotations(map[string]string{ParamTypesAnnotation: tt.annotation})
+
+			_, err := ParamsFromTemplate(tm)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestValidateParams(t *testing.T) {
+	parsed := parseCAPITemplateFromFile(t, "testdata/typed-template.yaml")
+	params, err := ParamsFromTemplate(parsed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		values map[string]string
+		want   ParamErrors
+	}{
+		{
+			name:   "valid values",
+			values: map[string]string{"CLUSTER_NAME": "dev-1", "REPLICAS": "3", "ENVIRONMENT": "dev", "HIGH_AVAILABILITY": "true"},
+		},
+		{
+			name:   "defaults and optional values",
+			values: map[string]string{"CLUSTER_NAME": "dev-1"},
+		},
+		{
+			name:   "missing required value",
+			values: map[string]string{},
+			want:   ParamErrors{{Param: "CLUSTER_NAME"}},
+		},
+		{
+			name:   "invalid values",
+			values: map[string]string{"CLUSTER_NAME": "Dev_1", "REPLICAS": "three", "ENVIRONMENT": "staging", "HIGH_AVAILABILITY": "yes"},
+			want: ParamErrors{
+				{Param: "CLUSTER_NAME", Description: "must match ^[a-z0-9-]+$"},
+				{Param: "REPLICAS", Description: "must be an integer"},
+				{Param: "ENVIRONMENT", Description: "must be one of dev, prod"},
+				{Param: "HIGH_AVAILABILITY", Description: "must be true or false"},
+			},
+		},
+		{
+			name:   "out of range",
+			values: map[string]string{"CLUSTER_NAME": "dev-1", "REPLICAS": "7"},
+			want:   ParamErrors{{Param: "REPLICAS", Description: "must be at most 5"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateParams(params, tt.values)
+			if tt.want == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			var got ParamErrors
+			if !errors.As(err, &got) {
+				t.Fatalf("got %v, want ParamErrors", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Fatalf("failed to validate params:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRender_invalid_parameters(t *testing.T) {
+	parsed := parseCAPITemplateFromFile(t, "testdata/typed-template.yaml")
+	processor, err := NewProcessorForTemplate(parsed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = processor.RenderTemplates(map[string]string{"REPLICAS": "0"})
+	assert.EqualError(t, err, "missing required parameter: CLUSTER_NAME, invalid value for parameter REPLICAS: must be at least 1")
+
+	st, ok := status.FromError(err)
+	if !ok {
+		t.Fatalf("got %v, want a gRPC status", err)
+	}
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+
+	want := []*errdetails.BadRequest_FieldViolation{
+		{Field: "CLUSTER_NAME", Description: "is required"},
+		{Field: "REPLICAS", Description: "must be at least 1"},
+	}
+	details := st.Details()
+	if len(details) != 1 {
+		t.Fatalf("got %d details, want 1", len(details))
+	}
+	badRequest, ok := details[0].(*errdetails.BadRequest)
+	if !ok {
+		t.Fatalf("got %T, want BadRequest", details[0])
+	}
+	assert.Len(t, badRequest.FieldViolations, len(want))
+	for i := range want {
+		assert.Equal(t, want[i].Field, badRequest.FieldViolations[i].Field)
+		assert.Equal(t, want[i].Description, badRequest.FieldViolations[i].Description)
+	}
+}
+
+func TestParamsJSONSchema(t *testing.T) {
+	parsed := parseCAPITemplateFromFile(t, "testdata/typed-template.yaml")
+	params, err := ParamsFromTemplate(parsed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]interface{}{
+		"$schema": "http://json-schema.org/draft-07/schema#",
+		"type":    "object",
+		"properties": map[string]interface{}{
+			"CLUSTER_NAME": map[string]interface{}{
+				"type":    "string",
+				"pattern": "^[a-z0-9-]+$",
+			},
+			"REPLICAS": map[string]interface{}{
+				"type":        "integer",
+				"description": "Number of control plane machines",
+				"minimum":     int64(1),
+				"maximum":     int64(5),
+				"default":     int64(1),
+			},
+			"ENVIRONMENT": map[string]interface{}{
+				"type": "string",
+				"enum": []interface{}{"dev", "prod"},
+			},
+			"HIGH_AVAILABILITY": map[string]interface{}{
+				"type": "boolean",
+			},
+		},
+		"required": []interface{}{"CLUSTER_NAME"},
+	}
+	if diff := cmp.Diff(want, ParamsJSONSchema(params)); diff != "" {
+		t.Fatalf("schema didn't match:\n%s", diff)
+	}
+}
@@ -8,8 +8,11 @@ import templatesv1 "github.com/weaveworks/templates-controller/apis/core"
 //
 // Any fields in the templates, but not in the params will not be enriched, and
 // only the name will be returned.
-func ParamsFromTemplate(t templatesv1.Template) ([]Param, error) {
-	proc, err := NewProcessorForTemplate(t)
+//
+// The params of the templates referenced by the template are included, see
+// ComposeTemplate.
+func ParamsFromTemplate(t templatesv1.Template, opts ...ProcessorOption) ([]Param, error) {
+	proc, err := NewProcessorForTemplate(t, opts...)
 	if err != nil {
 		return nil, err
 	}
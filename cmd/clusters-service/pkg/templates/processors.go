@@ -2,6 +2,7 @@ package templates
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"sort"
 	"strings"
@@ -44,9 +45,45 @@ type Processor interface {
 // RenderOptFunc is a functional option for Rendering templates.
 type RenderOptFunc func(uns *unstructured.Unstructured) error
 
+// ProcessorOption is a functional option for creating processors.
+type ProcessorOption func(*processorOptions)
+
+type processorOptions struct {
+	ctx      context.Context
+	resolver TemplateResolver
+}
+
+// WithTemplateResolver resolves the templates referenced by the template of
+// the processor, see ComposeTemplate.
+func WithTemplateResolver(ctx context.Context, resolver TemplateResolver) ProcessorOption {
+	return func(o *processorOptions) {
+		o.ctx = ctx
+		o.resolver = resolver
+	}
+}
+
 // NewProcessorForTemplate creates and returns an appropriate processor for a
 // template based on its declared type.
-func NewProcessorForTemplate(t templatesv1.Template) (*TemplateProcessor, error) {
+//
+// Templates which reference other templates are composed with them, which
+// requires a TemplateResolver.
+func NewProcessorForTemplate(t templatesv1.Template, opts ...ProcessorOption) (*TemplateProcessor, error) {
+	if HasTemplateReferences(t) {
+		var o processorOptions
+		for _, opt := range opts {
+			opt(&o)
+		}
+		if o.resolver == nil {
+			return nil, fmt.Errorf("template %s/%s references other templates, which cannot be resolved", t.GetNamespace(), t.GetName())
+		}
+
+		composed, err := ComposeTemplate(o.ctx, t, o.resolver)
+		if err != nil {
+			return nil, err
+		}
+		t = composed
+	}
+
 	switch t.GetSpec().RenderType {
 	case "", templatesv1.RenderTypeEnvsubst:
 		return &TemplateProcessor{Processor: NewEnvsubstTemplateProcessor(), Template: t}, nil
@@ -158,6 +195,19 @@ func (p TemplateProcessor) Params() ([]Param, error) {
 		}
 	}
 
+	declaredTypes, err := paramTypes(p.Template)
+	if err != nil {
+		return nil, err
+	}
+	for name, d := range declaredTypes {
+		if m, ok := paramsMeta[name]; ok {
+			if err := m.applyType(d); err != nil {
+				return nil, fmt.Errorf("invalid %s annotation: %w", ParamTypesAnnotation, err)
+			}
+			paramsMeta[name] = m
+		}
+	}
+
 	var params []Param
 	for _, v := range paramsMeta {
 		params = append(params, v)
@@ -196,18 +246,14 @@ func (p TemplateProcessor) RenderTemplates(vars map[string]string, opts ...Rende
 		vars = map[string]string{}
 	}
 
+	if err := ValidateParams(params, vars); err != nil {
+		return nil, err
+	}
+
 	for _, param := range params {
 		val, ok := vars[param.Name]
 		if !ok || val == "" {
-			if param.Default != "" {
-				vars[param.Name] = param.Default
-			} else {
-				if param.Required {
-					return nil, fmt.Errorf("missing required parameter: %s", param.Name)
-				}
-				vars[param.Name] = ""
-			}
-
+			vars[param.Name] = param.Default
 		}
 	}
 
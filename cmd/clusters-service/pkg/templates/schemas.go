@@ -0,0 +1,314 @@
+package templates
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/pruning"
+	apiservervalidation "k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// SchemaValidator validates rendered resources against the OpenAPI schemas
+// of their kinds.
+type SchemaValidator interface {
+	// Validate returns the unknown fields and the invalid values of the
+	// resource. Resources of kinds without a schema are valid.
+	Validate(ctx context.Context, obj *unstructured.Unstructured) error
+}
+
+// ValidateRenderedSchemas validates each of the rendered resources against
+// the schema of its kind.
+func ValidateRenderedSchemas(ctx context.Context, processedTemplate [][]byte, validator SchemaValidator) error {
+	for _, v := range processedTemplate {
+		objs, err := decodeResources(v)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal resourceTemplate: %w", err)
+		}
+
+		for _, obj := range objs {
+			if err := validator.Validate(ctx, obj); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// kindSchema is the schema of a version of a custom resource.
+type kindSchema struct {
+	structural *structuralschema.Structural
+	validate   func(obj map[string]interface{}) field.ErrorList
+}
+
+func newKindSchemas(crd *apiextensionsv1.CustomResourceDefinition) (map[string]*kindSchema, error) {
+	schemas := map[string]*kindSchema{}
+	for _, version := range crd.Spec.Versions {
+		if version.Schema == nil || version.Schema.OpenAPIV3Schema == nil {
+			continue
+		}
+
+		internal := &apiextensions.JSONSchemaProps{}
+		if err := apiextensionsv1.Convert_v1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(version.Schema.OpenAPIV3Schema, internal, nil); err != nil {
+			return nil, fmt.Errorf("failed to convert schema of %s/%s: %w", crd.Name, version.Name, err)
+		}
+
+		structural, err := structuralschema.NewStructural(internal)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schema of %s/%s: %w", crd.Name, version.Name, err)
+		}
+
+		validator, _, err := apiservervalidation.NewSchemaValidator(&apiextensions.CustomResourceValidation{OpenAPIV3Schema: internal})
+		if err != nil {
+			return nil, fmt.Errorf("invalid schema of %s/%s: %w", crd.Name, version.Name, err)
+		}
+
+		schemas[version.Name] = &kindSchema{
+			structural: structural,
+			validate: func(obj map[string]interface{}) field.ErrorList {
+				return apiservervalidation.ValidateCustomResource(nil, obj, validator)
+			},
+		}
+	}
+
+	return schemas, nil
+}
+
+// validateObject returns the unknown fields and the invalid values of the resource.
+func (s *kindSchema) validateObject(obj *unstructured.Unstructured) error {
+	var errs []error
+
+	// pruning drops the unknown fields, so it works on a copy
+	content := runtime.DeepCopyJSON(obj.UnstructuredContent())
+	unknown := pruning.PruneWithOptions(content, s.structural, true, structuralschema.UnknownFieldPathOptions{TrackUnknownFieldPaths: true})
+	for _, path := range unknown {
+		errs = append(errs, fmt.Errorf("unknown field %q", path))
+	}
+
+	for _, err := range s.validate(obj.UnstructuredContent()) {
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid %s %q: %w", obj.GetKind(), obj.GetName(), utilerrors.NewAggregate(errs))
+	}
+	return nil
+}
+
+// NewCRDSchemaValidator validates resources against the schemas of the
+// CRDs.
+func NewCRDSchemaValidator(crds []apiextensionsv1.CustomResourceDefinition) (SchemaValidator, error) {
+	v := &crdSchemaValidator{schemas: map[schema.GroupVersionKind]*kindSchema{}}
+	for i := range crds {
+		crd := &crds[i]
+		versions, err := newKindSchemas(crd)
+		if err != nil {
+			return nil, err
+		}
+		for version, s := range versions {
+			v.schemas[schema.GroupVersionKind{Group: crd.Spec.Group, Version: version, Kind: crd.Spec.Names.Kind}] = s
+		}
+	}
+
+	return v, nil
+}
+
+type crdSchemaValidator struct {
+	schemas map[schema.GroupVersionKind]*kindSchema
+}
+
+func (v *crdSchemaValidator) Validate(ctx context.Context, obj *unstructured.Unstructured) error {
+	s, ok := v.schemas[obj.GroupVersionKind()]
+	if !ok {
+		return nil
+	}
+	return s.validateObject(obj)
+}
+
+// LoadCRDSchemaValidator validates resources against the schemas of the
+// CRDs in the YAML or JSON files of a directory and its subdirectories.
+// Other resources in the files are ignored.
+func LoadCRDSchemaValidator(dir string) (SchemaValidator, error) {
+	var crds []apiextensionsv1.CustomResourceDefinition
+	err := filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".yaml", ".yml", ".json":
+		default:
+			return nil
+		}
+
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read schema file: %w", err)
+		}
+
+		objs, err := decodeResources(b)
+		if err != nil {
+			return fmt.Errorf("failed to parse schema file %s: %w", path, err)
+		}
+
+		for _, obj := range objs {
+			if obj.GroupVersionKind() != apiextensionsv1.SchemeGroupVersion.WithKind("CustomResourceDefinition") {
+				continue
+			}
+
+			var crd apiextensionsv1.CustomResourceDefinition
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), &crd); err != nil {
+				return fmt.Errorf("failed to parse CRD %s in %s: %w", obj.GetName(), path, err)
+			}
+			crds = append(crds, crd)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load schemas from %s: %w", dir, err)
+	}
+
+	return NewCRDSchemaValidator(crds)
+}
+
+// NewClusterSchemaValidator validates resources against the schemas of the
+// CRDs of a cluster, which are read as resources of their kinds are
+// validated. Kinds that are not custom resources of the cluster are not
+// validated.
+func NewClusterSchemaValidator(c client.Reader, mapper meta.RESTMapper) SchemaValidator {
+	return &clusterSchemaValidator{
+		client:  c,
+		mapper:  mapper,
+		schemas: map[string]clusterCRDSchemas{},
+	}
+}
+
+type clusterSchemaValidator struct {
+	client client.Reader
+	mapper meta.RESTMapper
+
+	mu sync.Mutex
+	// schemas are cached by CRD name, until the CRD changes
+	schemas map[string]clusterCRDSchemas
+}
+
+type clusterCRDSchemas struct {
+	resourceVersion string
+	versions        map[string]*kindSchema
+}
+
+func (v *clusterSchemaValidator) Validate(ctx context.Context, obj *unstructured.Unstructured) error {
+	gvk := obj.GroupVersionKind()
+	if gvk.Group == "" {
+		// built-in kinds of the core group have no CRD
+		return nil
+	}
+
+	mapping, err := v.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			// kinds which are not installed in the cluster, like the ones of leaf clusters
+			return nil
+		}
+		return fmt.Errorf("failed to find resource of %s: %w", gvk, err)
+	}
+
+	name := mapping.Resource.Resource + "." + mapping.Resource.Group
+	var crd apiextensionsv1.CustomResourceDefinition
+	if err := v.client.Get(ctx, types.NamespacedName{Name: name}, &crd); err != nil {
+		if apierrors.IsNotFound(err) {
+			// built-in kinds of the other groups have no CRD either
+			return nil
+		}
+		return fmt.Errorf("failed to get CRD %s: %w", name, err)
+	}
+
+	versions, err := v.crdSchemas(&crd)
+	if err != nil {
+		return err
+	}
+
+	s, ok := versions[gvk.Version]
+	if !ok {
+		return nil
+	}
+	return s.validateObject(obj)
+}
+
+func (v *clusterSchemaValidator) crdSchemas(crd *apiextensionsv1.CustomResourceDefinition) (map[string]*kindSchema, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if cached, ok := v.schemas[crd.Name]; ok && cached.resourceVersion == crd.ResourceVersion {
+		return cached.versions, nil
+	}
+
+	versions, err := newKindSchemas(crd)
+	if err != nil {
+		return nil, err
+	}
+	v.schemas[crd.Name] = clusterCRDSchemas{resourceVersion: crd.ResourceVersion, versions: versions}
+
+	return versions, nil
+}
+
+// decodeResources decodes the YAML or JSON documents of a manifest. Documents
+// which are not objects are skipped.
+func decodeResources(b []byte) ([]*unstructured.Unstructured, error) {
+	var objs []*unstructured.Unstructured
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(b)))
+	for {
+		doc, err := reader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+
+		j, err := yaml.YAMLToJSON(doc)
+		if err != nil {
+			return nil, err
+		}
+		if j = bytes.TrimSpace(j); len(j) == 0 || string(j) == "null" {
+			continue
+		}
+
+		// numbers are decoded as int64 where possible, as the schemas require
+		var content interface{}
+		if err := utiljson.Unmarshal(j, &content); err != nil {
+			return nil, err
+		}
+		if m, ok := content.(map[string]interface{}); ok {
+			objs = append(objs, &unstructured.Unstructured{Object: m})
+		}
+	}
+
+	return objs, nil
+}
@@ -0,0 +1,130 @@
+package templates
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testWidget = `
+apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: test-widget
+  namespace: default
+spec:
+`
+
+func TestValidateRenderedSchemas(t *testing.T) {
+	validator, err := LoadCRDSchemaValidator("testdata/schemas")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		resource string
+		wantErr  []string
+	}{
+		{
+			name:     "valid resource",
+			resource: testWidget + "  replicas: 3\n  size: small\n",
+		},
+		{
+			name:     "unknown field",
+			resource: testWidget + "  replicas: 3\n  colour: blue\n",
+			wantErr:  []string{`invalid Widget "test-widget"`, `unknown field "spec.colour"`},
+		},
+		{
+			name:     "type error",
+			resource: testWidget + "  replicas: three\n",
+			wantErr:  []string{`invalid Widget "test-widget"`, "spec.replicas", "must be of type integer"},
+		},
+		{
+			name:     "invalid value",
+			resource: testWidget + "  size: medium\n",
+			wantErr:  []string{"spec.size", "Unsupported value"},
+		},
+		{
+			name:     "kind without schema",
+			resource: "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: test\nunknown: field\n",
+		},
+		{
+			name:     "version without schema",
+			resource: "apiVersion: example.com/v2\nkind: Widget\nmetadata:\n  name: test\nspec:\n  colour: blue\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRenderedSchemas(context.Background(), [][]byte{[]byte(tt.resource)}, validator)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			for _, want := range tt.wantErr {
+				assert.ErrorContains(t, err, want)
+			}
+		})
+	}
+}
+
+func TestLoadCRDSchemaValidator_missing_dir(t *testing.T) {
+	_, err := LoadCRDSchemaValidator("testdata/missing")
+	assert.ErrorContains(t, err, "failed to load schemas from testdata/missing")
+}
+
+func TestClusterSchemaValidator(t *testing.T) {
+	b, err := os.ReadFile("testdata/schemas/widgets.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	docs, err := decodeResources(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(docs[0].UnstructuredContent(), crd); err != nil {
+		t.Fatal(err)
+	}
+
+	scheme := runtime.NewScheme()
+	if err := apiextensionsv1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(crd).Build()
+
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Widget"}, meta.RESTScopeNamespace)
+	// a kind with a mapping but without a CRD, like the built-in kinds
+	mapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+
+	validator := NewClusterSchemaValidator(c, mapper)
+
+	validate := func(resource string) error {
+		return ValidateRenderedSchemas(context.Background(), [][]byte{[]byte(resource)}, validator)
+	}
+
+	assert.NoError(t, validate(testWidget+"  replicas: 3\n"))
+	assert.ErrorContains(t, validate(testWidget+"  colour: blue\n"), `unknown field "spec.colour"`)
+	assert.NoError(t, validate("apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: test\nunknown: field\n"))
+	assert.NoError(t, validate("apiVersion: leaf.example.com/v1\nkind: Gadget\nmetadata:\n  name: test\nunknown: field\n"))
+}
+
+func TestDecodeResources(t *testing.T) {
+	objs, err := decodeResources([]byte("---\napiVersion: example.com/v1\nkind: Widget\nspec:\n  replicas: 3\n---\n\n---\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Len(t, objs, 1)
+	// numbers are decoded as integers, which the schemas require
+	assert.IsType(t, int64(0), objs[0].Object["spec"].(map[string]interface{})["replicas"])
+}
@@ -40,6 +40,8 @@ type Config struct {
 	TemplateFile    string   `mapstructure:"template-file"`
 	HelmRepoName    string   `mapstructure:"helm-repo-name"`
 	Profiles        []string `mapstructure:"profiles"`
+	SchemaDir       string   `mapstructure:"schema-dir"`
+	TemplatesDir    string   `mapstructure:"templates-dir"`
 }
 
 var config Config
@@ -77,6 +79,8 @@ func init() {
 	flags.String("template-file", "", "template file to use")
 	flags.StringArray("profiles", []string{}, "Set profiles values files on the command line (--profile 'name=foo-profile,version=0.0.1,namespace=foo-system' --profile 'name=bar-profile,namespace=bar-system,values=bar-values.yaml')")
 	flags.String("helm-repo-name", "weaveworks-charts", "name of the helm repo in the helm local cache")
+	flags.String("schema-dir", "", "directory of CRD files to validate the rendered resources against")
+	flags.String("templates-dir", "", "directory of the templates referenced by the template, defaults to the directory of the template file")
 	flags.StringVar(&configPath, "config", "", "config file to use")
 }
 
@@ -130,6 +134,18 @@ func templatesCmdRunE() func(*cobra.Command, []string) error {
 			return fmt.Errorf("failed to parse template file %s: %w", templateFile, err)
 		}
 
+		if templates.HasTemplateReferences(parsedTemplate) {
+			templatesDir := config.TemplatesDir
+			if templatesDir == "" {
+				templatesDir = filepath.Dir(templateFile)
+			}
+
+			parsedTemplate, err = composeTemplate(parsedTemplate, templatesDir)
+			if err != nil {
+				return fmt.Errorf("failed to compose template %s: %w", templateFile, err)
+			}
+		}
+
 		params := make(map[string]string)
 
 		// parse parameter values
@@ -154,8 +170,20 @@ func templatesCmdRunE() func(*cobra.Command, []string) error {
 			})
 		}
 
-		files, err := generateFilesLocally(parsedTemplate, params, config.HelmRepoName, capiProfileValues, cli.New(), log)
+		var schemaValidator templates.SchemaValidator
+		if config.SchemaDir != "" {
+			schemaValidator, err = templates.LoadCRDSchemaValidator(config.SchemaDir)
+			if err != nil {
+				return fmt.Errorf("failed to load schemas: %w", err)
+			}
+		}
+
+		files, err := generateFilesLocally(parsedTemplate, params, config.HelmRepoName, capiProfileValues, schemaValidator, cli.New(), log)
 		if err != nil {
+			var paramErrs templates.ParamErrors
+			if errors.As(err, &paramErrs) {
+				return invalidParamsError(paramErrs)
+			}
 			return fmt.Errorf("failed to generate files locally: %w", err)
 		}
 
@@ -204,6 +232,15 @@ func templatesCmdRunE() func(*cobra.Command, []string) error {
 	}
 }
 
+// invalidParamsError lists the parameters whose values are missing or invalid, one per line.
+func invalidParamsError(errs templates.ParamErrors) error {
+	lines := make([]string, len(errs))
+	for i := range errs {
+		lines[i] = "  - " + errs[i].Error()
+	}
+	return fmt.Errorf("invalid parameter values:\n%s", strings.Join(lines, "\n"))
+}
+
 // parse parses a template file and returns a GitOpsTemplate object
 func parseTemplate(filename string) (*gapiv1.GitOpsTemplate, error) {
 	gitOpsTemplate := gapiv1.GitOpsTemplate{}
@@ -229,6 +266,22 @@ func parseTemplate(filename string) (*gapiv1.GitOpsTemplate, error) {
 	return &gitOpsTemplate, nil
 }
 
+// composeTemplate composes a template with the templates it references,
+// from the template files of a directory.
+func composeTemplate(tmpl *gapiv1.GitOpsTemplate, dir string) (*gapiv1.GitOpsTemplate, error) {
+	resolver, err := templates.LoadTemplateResolver(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	composed, err := templates.ComposeTemplate(context.Background(), tmpl, resolver)
+	if err != nil {
+		return nil, err
+	}
+
+	return composed.(*gapiv1.GitOpsTemplate), nil
+}
+
 // export writes the rendered template to the specified output
 func export(template string, out io.Writer) error {
 	_, err := fmt.Fprintf(out, "%s", template)
@@ -239,7 +292,7 @@ func export(template string, out io.Writer) error {
 	return nil
 }
 
-func generateFilesLocally(tmpl *gapiv1.GitOpsTemplate, params map[string]string, helmRepoName string, profiles []*capiv1_proto.ProfileValues, settings *cli.EnvSettings, log logr.Logger) ([]git.CommitFile, error) {
+func generateFilesLocally(tmpl *gapiv1.GitOpsTemplate, params map[string]string, helmRepoName string, profiles []*capiv1_proto.ProfileValues, schemaValidator templates.SchemaValidator, settings *cli.EnvSettings, log logr.Logger) ([]git.CommitFile, error) {
 	templateHasRequiredProfiles, err := templates.TemplateHasRequiredProfiles(tmpl)
 	if err != nil {
 		return nil, fmt.Errorf("failed to check if template has required profiles: %w", err)
@@ -278,6 +331,7 @@ func generateFilesLocally(tmpl *gapiv1.GitOpsTemplate, params map[string]string,
 			TemplateName:    tmpl.Name,
 			HelmRepository:  helmRepo,
 			Profiles:        profiles,
+			SchemaValidator: schemaValidator,
 		},
 		nil, // FIXME: no create message request, generated resources won't be "editable" in the UI
 	)
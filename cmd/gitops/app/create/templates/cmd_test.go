@@ -147,7 +147,7 @@ func TestGenerateFilesLocally(t *testing.T) {
 	assert.NoError(t, err)
 
 	// don't have to specify any helm settings if no profiles are around
-	files, err := generateFilesLocally(tmpl, defaultParams, "test-repo", nil, nil, logr.Discard())
+	files, err := generateFilesLocally(tmpl, defaultParams, "test-repo", nil, nil, nil, logr.Discard())
 	assert.NoError(t, err)
 
 	expectedFiles := []string{
@@ -164,6 +164,32 @@ func TestGenerateFilesLocally(t *testing.T) {
 	}
 }
 
+func TestGenerateFilesLocallyWithBaseTemplate(t *testing.T) {
+	tmpl, err := parseTemplate("testdata/template-with-base.yaml")
+	assert.NoError(t, err)
+
+	tmpl, err = composeTemplate(tmpl, "testdata")
+	assert.NoError(t, err)
+
+	files, err := generateFilesLocally(tmpl, defaultParams, "test-repo", nil, nil, nil, logr.Discard())
+	assert.NoError(t, err)
+
+	expectedFiles := []string{
+		"clusters/out.yaml",
+		"clusters/out.yaml-configmap.yaml",
+	}
+
+	actualFilenames := []string{}
+	for _, file := range files {
+		actualFilenames = append(actualFilenames, file.Path)
+	}
+
+	if diff := cmp.Diff(expectedFiles, actualFilenames); diff != "" {
+		t.Fatalf("result didn't match expected:\n%s", diff)
+	}
+	assert.Contains(t, *files[1].Content, "kind: ConfigMap")
+}
+
 func TestGenerateFilesLocallyWithCharts(t *testing.T) {
 	tmpl, err := parseTemplate("testdata/template-with-charts.yaml")
 	assert.NoError(t, err)
@@ -176,7 +202,7 @@ func TestGenerateFilesLocallyWithCharts(t *testing.T) {
 		},
 	}
 
-	files, err := generateFilesLocally(tmpl, defaultParams, "test-repo", profiles, testSettings, logr.Discard())
+	files, err := generateFilesLocally(tmpl, defaultParams, "test-repo", profiles, nil, testSettings, logr.Discard())
 	assert.NoError(t, err)
 
 	expectedFiles := []string{
@@ -2,6 +2,7 @@ package update
 
 import (
 	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/app/update/profiles"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/app/update/templates"
 	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/pkg/adapters"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
 
@@ -15,10 +16,14 @@ func Command(opts *config.Options, client *adapters.HTTPClient) *cobra.Command {
 		Example: `
 	# Update a profile that is installed on a cluster
 	gitops update profile --name=podinfo --cluster=prod --config-repo=ssh://git@github.com/owner/config-repo.git  --version=1.0.0
+
+	# Upgrade the resources created from a template to its current version
+	gitops update template cluster-template --url=https://github.com/org/repo
 		`,
 	}
 
 	cmd.AddCommand(profiles.UpdateCommand(opts, client))
+	cmd.AddCommand(templates.UpdateCommand(opts, client))
 
 	return cmd
 }
@@ -0,0 +1,123 @@
+package templates
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/internal"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/pkg/adapters"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/pkg/templates"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/cmderrors"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
+)
+
+type templateUpdateFlags struct {
+	RepositoryURL     string
+	BaseBranch        string
+	HeadBranch        string
+	Title             string
+	Description       string
+	CommitMessage     string
+	TemplateNamespace string
+	TemplateKind      string
+	FromDir           string
+}
+
+var flags templateUpdateFlags
+
+// UpdateCommand provides support for upgrading the resources created from a template.
+func UpdateCommand(opts *config.Options, client *adapters.HTTPClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "template",
+		Short: "Upgrade the resources created from a template to its current version",
+		Long: `Create a pull request that renders the resources created from a template
+again, with the current version of the template and the parameter values the
+resources were created with. Files the template no longer renders are deleted.`,
+		Example: `
+	# Upgrade the resources created from a template in the management cluster
+	gitops update template cluster-template --url=https://github.com/org/repo
+
+	# Upgrade the resources created from a template in a clone of the repository
+	gitops update template cluster-template --url=https://github.com/org/repo --from-dir=./repo
+		`,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		PreRunE:       updateTemplateCmdPreRunE(&opts.Endpoint),
+		RunE:          updateTemplateCmdRunE(opts, client),
+		Args:          cobra.ExactArgs(1),
+	}
+
+	cmd.Flags().StringVar(&flags.RepositoryURL, "url", "", "The repository to open a pull request against")
+	cmd.Flags().StringVar(&flags.TemplateNamespace, "template-namespace", "default", "Specify the namespace of the template")
+	cmd.Flags().StringVar(&flags.TemplateKind, "template-kind", "", "Specify the kind of the template. Supported templates: CAPITemplate, GitOpsTemplate")
+	cmd.Flags().StringVar(&flags.FromDir, "from-dir", "", "Read the resources created from the template from the files of a directory, instead of the management cluster")
+	internal.AddPRFlags(cmd, &flags.HeadBranch, &flags.BaseBranch, &flags.Description, &flags.CommitMessage, &flags.Title)
+
+	return cmd
+}
+
+func updateTemplateCmdPreRunE(endpoint *string) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if *endpoint == "" {
+			return cmderrors.ErrNoWGEEndpoint
+		}
+
+		return nil
+	}
+}
+
+func updateTemplateCmdRunE(opts *config.Options, client *adapters.HTTPClient) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		err := client.ConfigureClientWithOptions(opts, os.Stdout)
+		if err != nil {
+			return err
+		}
+
+		if flags.RepositoryURL == "" {
+			return cmderrors.ErrNoURL
+		}
+
+		url, err := gitproviders.NewRepoURL(flags.RepositoryURL)
+		if err != nil {
+			return fmt.Errorf("cannot parse url: %w", err)
+		}
+
+		token, err := internal.GetToken(url, os.LookupEnv)
+		if err != nil {
+			return err
+		}
+
+		templateKind := templates.TemplateKind(flags.TemplateKind)
+		err = templateKind.Set(flags.TemplateKind)
+		if err != nil {
+			return err
+		}
+
+		var resources []templates.TemplatedResources
+		if flags.FromDir != "" {
+			resources, err = templates.ReadTemplatedResources(flags.FromDir)
+			if err != nil {
+				return err
+			}
+			if len(resources) == 0 {
+				return fmt.Errorf("no resources created from templates found in %s", flags.FromDir)
+			}
+		}
+
+		return templates.UpgradeFromTemplate(templates.UpgradeFromTemplateParams{
+			GitProviderToken:  token,
+			TemplateName:      args[0],
+			TemplateNamespace: flags.TemplateNamespace,
+			TemplateKind:      templateKind.String(),
+			Resources:         resources,
+			RepositoryURL:     flags.RepositoryURL,
+			HeadBranch:        flags.HeadBranch,
+			BaseBranch:        flags.BaseBranch,
+			Title:             flags.Title,
+			Description:       flags.Description,
+			CommitMessage:     flags.CommitMessage,
+		}, client, os.Stdout)
+	}
+}
@@ -0,0 +1,140 @@
+package templates_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/app/root"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/pkg/adapters"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/cmderrors"
+)
+
+func TestEndpointNotSet(t *testing.T) {
+	client := adapters.NewHTTPClient()
+	cmd := root.Command(client)
+	cmd.SetArgs([]string{
+		"update", "template",
+		"cluster-template",
+	})
+
+	err := cmd.Execute()
+	assert.EqualError(t, err, "the Weave GitOps Enterprise HTTP API endpoint flag (--endpoint) has not been set")
+}
+
+func TestPayload(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "test-token")
+
+	client := adapters.NewHTTPClient()
+
+	httpmock.ActivateNonDefault(client.GetBaseClient())
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder(
+		http.MethodPost,
+		"http://localhost:8000/v1/namespaces/test-ns/templates/cluster-template/upgrade-pull-request",
+		func(r *http.Request) (*http.Response, error) {
+			h, ok := r.Header["Git-Provider-Token"]
+			assert.True(t, ok)
+			assert.Contains(t, h, "test-token")
+
+			var m map[string]interface{}
+			err := json.NewDecoder(r.Body).Decode(&m)
+			assert.NoError(t, err)
+
+			assert.Equal(t, "https://github.com/org/repo", m["repositoryUrl"])
+			assert.Equal(t, "GitOpsTemplate", m["templateKind"])
+			assert.Equal(t, "upgrade-branch", m["headBranch"])
+			assert.NotContains(t, m, "resources")
+
+			return httpmock.NewJsonResponse(http.StatusOK, httpmock.File("../../../pkg/adapters/testdata/pull_request_created.json"))
+		},
+	)
+
+	cmd := root.Command(client)
+	cmd.SetArgs([]string{
+		"update", "template",
+		"cluster-template",
+		"--url=https://github.com/org/repo",
+		"--template-namespace=test-ns",
+		"--template-kind=GitOpsTemplate",
+		"--branch=upgrade-branch",
+		"--endpoint", "http://localhost:8000",
+	})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+}
+
+func TestPayload_FromDir(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "test-token")
+
+	client := adapters.NewHTTPClient()
+
+	httpmock.ActivateNonDefault(client.GetBaseClient())
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder(
+		http.MethodPost,
+		"http://localhost:8000/v1/namespaces/default/templates/cluster-template/upgrade-pull-request",
+		func(r *http.Request) (*http.Response, error) {
+			var m struct {
+				Resources []struct {
+					CreateRequest string   `json:"createRequest"`
+					CreatedFiles  []string `json:"createdFiles"`
+				} `json:"resources"`
+			}
+			err := json.NewDecoder(r.Body).Decode(&m)
+			assert.NoError(t, err)
+
+			// the resources of a pull request are read once
+			assert.Len(t, m.Resources, 1)
+			assert.Contains(t, m.Resources[0].CreateRequest, `"name":"cluster-template"`)
+			assert.Equal(t, []string{"clusters/default/foo.yaml", "clusters/default/foo-machines.yaml"}, m.Resources[0].CreatedFiles)
+
+			return httpmock.NewJsonResponse(http.StatusOK, httpmock.File("../../../pkg/adapters/testdata/pull_request_created.json"))
+		},
+	)
+
+	cmd := root.Command(client)
+	cmd.SetArgs([]string{
+		"update", "template",
+		"cluster-template",
+		"--url=https://github.com/org/repo",
+		"--from-dir=testdata/repo",
+		"--endpoint", "http://localhost:8000",
+	})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+}
+
+func TestFromDir_NoResources(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "test-token")
+
+	client := adapters.NewHTTPClient()
+	cmd := root.Command(client)
+	cmd.SetArgs([]string{
+		"update", "template",
+		"cluster-template",
+		"--url=https://github.com/org/repo",
+		"--from-dir=testdata/repo/clusters/missing",
+		"--endpoint", "http://localhost:8000",
+	})
+
+	err := cmd.Execute()
+	assert.ErrorContains(t, err, "failed to read resources from testdata/repo/clusters/missing")
+}
+
+func TestGitProviderToken_NoURL(t *testing.T) {
+	client := adapters.NewHTTPClient()
+	cmd := root.Command(client)
+	cmd.SetArgs([]string{
+		"update", "template",
+		"cluster-template",
+		"--endpoint", "http://localhost:8000",
+	})
+
+	err := cmd.Execute()
+	assert.ErrorIs(t, err, cmderrors.ErrNoURL)
+}
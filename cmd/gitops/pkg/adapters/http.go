@@ -474,6 +474,69 @@ func (c *HTTPClient) CreatePullRequestFromTemplate(params templates.CreatePullRe
 	return result.WebURL, nil
 }
 
+// UpgradeFromTemplate creates a pull request that renders the resources
+// created from a template again with its current version.
+func (c *HTTPClient) UpgradeFromTemplate(params templates.UpgradeFromTemplateParams) (string, error) {
+	// POST request payload
+	type UpgradeFromTemplateRequest struct {
+		TemplateKind  string                         `json:"templateKind"`
+		Resources     []templates.TemplatedResources `json:"resources,omitempty"`
+		RepositoryURL string                         `json:"repositoryUrl"`
+		HeadBranch    string                         `json:"headBranch"`
+		BaseBranch    string                         `json:"baseBranch"`
+		Title         string                         `json:"title"`
+		Description   string                         `json:"description"`
+		CommitMessage string                         `json:"commitMessage"`
+	}
+
+	// POST response payload
+	type UpgradeFromTemplateResponse struct {
+		WebURL string `json:"webUrl"`
+	}
+
+	var (
+		result     UpgradeFromTemplateResponse
+		serviceErr *ServiceError
+	)
+
+	namespace := params.TemplateNamespace
+	if namespace == "" {
+		namespace = "default"
+	}
+	endpoint := fmt.Sprintf("v1/namespaces/%s/templates/%s/upgrade-pull-request", namespace, params.TemplateName)
+
+	res, err := c.client.R().
+		SetHeader("Accept", "application/json").
+		SetHeader(gitProviderTokenHeaderName, params.GitProviderToken).
+		SetBody(UpgradeFromTemplateRequest{
+			TemplateKind:  params.TemplateKind,
+			Resources:     params.Resources,
+			RepositoryURL: params.RepositoryURL,
+			HeadBranch:    params.HeadBranch,
+			BaseBranch:    params.BaseBranch,
+			Title:         params.Title,
+			Description:   params.Description,
+			CommitMessage: params.CommitMessage,
+		}).
+		SetResult(&result).
+		SetError(&serviceErr).
+		Post(endpoint)
+
+	if serviceErr != nil {
+		return "", fmt.Errorf("unable to POST template upgrade and create pull request to %q: %s", res.Request.URL, serviceErr.Message)
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("unable to POST template upgrade and create pull request to %q: %w", res.Request.URL, err)
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		return "", fmt.Errorf("response status for POST %q was %d", res.Request.URL, res.StatusCode())
+	}
+
+	return result.WebURL, nil
+}
+
 // RetrieveCredentials returns a list of all CAPI credentials.
 func (c *HTTPClient) RetrieveCredentials() ([]templates.Credentials, error) {
 	endpoint := "v1/credentials"
@@ -0,0 +1,115 @@
+package templates
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+
+	cstemplates "github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/templates"
+)
+
+// ReadTemplatedResources reads the annotations of the resources created from
+// templates in the YAML or JSON files of a directory and its subdirectories,
+// e.g. of a clone of the repository they were created in.
+func ReadTemplatedResources(dir string) ([]TemplatedResources, error) {
+	var resources []TemplatedResources
+	byCreateRequest := map[string]int{}
+
+	err := filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".yaml", ".yml", ".json":
+		default:
+			return nil
+		}
+
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read file: %w", err)
+		}
+
+		annotations, err := readAnnotations(b)
+		if err != nil {
+			return fmt.Errorf("failed to parse file %s: %w", path, err)
+		}
+
+		for _, a := range annotations {
+			createRequest := a[cstemplates.CreateRequestAnnotation]
+			if createRequest == "" {
+				continue
+			}
+
+			i, ok := byCreateRequest[createRequest]
+			if !ok {
+				i = len(resources)
+				byCreateRequest[createRequest] = i
+				resources = append(resources, TemplatedResources{CreateRequest: createRequest})
+			}
+
+			if createdFiles := a[cstemplates.CreatedFilesAnnotation]; createdFiles != "" {
+				var created struct {
+					Files []string `json:"files"`
+				}
+				if err := json.Unmarshal([]byte(createdFiles), &created); err != nil {
+					return fmt.Errorf("invalid %s annotation in %s: %w", cstemplates.CreatedFilesAnnotation, path, err)
+				}
+				resources[i].CreatedFiles = created.Files
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read resources from %s: %w", dir, err)
+	}
+
+	return resources, nil
+}
+
+// readAnnotations returns the annotations of each of the resources in a
+// manifest. Documents which are not resources are skipped.
+func readAnnotations(b []byte) ([]map[string]string, error) {
+	var annotations []map[string]string
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(b)))
+	for {
+		doc, err := reader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+
+		var obj struct {
+			Metadata struct {
+				Annotations map[string]string `json:"annotations"`
+			} `json:"metadata"`
+		}
+		if err := yaml.Unmarshal(doc, &obj); err != nil {
+			// documents like lists or scalars have no annotations
+			continue
+		}
+		if len(obj.Metadata.Annotations) > 0 {
+			annotations = append(annotations, obj.Metadata.Annotations)
+		}
+	}
+
+	return annotations, nil
+}
@@ -103,6 +103,50 @@ func CreatePullRequestFromTemplate(params CreatePullRequestFromTemplateParams, r
 	return nil
 }
 
+type UpgradeFromTemplateParams struct {
+	GitProviderToken  string
+	TemplateName      string
+	TemplateNamespace string
+	TemplateKind      string
+	// Resources are the resources created from the template. When empty,
+	// the resources in the management cluster are upgraded.
+	Resources     []TemplatedResources
+	RepositoryURL string
+	HeadBranch    string
+	BaseBranch    string
+	Title         string
+	Description   string
+	CommitMessage string
+}
+
+// TemplatedResources are the annotations of the resources created from a
+// template by a pull request.
+type TemplatedResources struct {
+	CreateRequest string   `json:"createRequest"`
+	CreatedFiles  []string `json:"createdFiles,omitempty"`
+}
+
+// TemplateUpgrader defines the interface that adapters
+// need to implement in order to create a pull request that
+// upgrades the resources created from a template.
+// Implementers should return the web URI of the pull request.
+type TemplateUpgrader interface {
+	UpgradeFromTemplate(params UpgradeFromTemplateParams) (string, error)
+}
+
+// UpgradeFromTemplate uses a TemplateUpgrader adapter to create
+// a pull request that upgrades the resources created from a template.
+func UpgradeFromTemplate(params UpgradeFromTemplateParams, r TemplateUpgrader, w io.Writer) error {
+	res, err := r.UpgradeFromTemplate(params)
+	if err != nil {
+		return fmt.Errorf("unable to create pull request: %w", err)
+	}
+
+	fmt.Fprintf(w, "Created pull request: %s\n", res)
+
+	return nil
+}
+
 // TemplatesRetriever defines the interface that adapters
 // need to implement in order to return an array of templates.
 type TemplatesRetriever interface {
@@ -24,8 +24,28 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	Query_DoQuery_FullMethodName               = "/query.v1.Query/DoQuery"
+	Query_GetObjectHistory_FullMethodName      = "/query.v1.Query/GetObjectHistory"
+	Query_GetObjectRelations_FullMethodName    = "/query.v1.Query/GetObjectRelations"
+	Query_CompareObjects_FullMethodName        = "/query.v1.Query/CompareObjects"
+	Query_DoAggregate_FullMethodName           = "/query.v1.Query/DoAggregate"
+	Query_WatchQuery_FullMethodName            = "/query.v1.Query/WatchQuery"
+	Query_ListSavedQueries_FullMethodName      = "/query.v1.Query/ListSavedQueries"
+	Query_GetSavedQuery_FullMethodName         = "/query.v1.Query/GetSavedQuery"
+	Query_CreateSavedQuery_FullMethodName      = "/query.v1.Query/CreateSavedQuery"
+	Query_UpdateSavedQuery_FullMethodName      = "/query.v1.Query/UpdateSavedQuery"
+	Query_DeleteSavedQuery_FullMethodName      = "/query.v1.Query/DeleteSavedQuery"
+	Query_ExecuteSavedQuery_FullMethodName     = "/query.v1.Query/ExecuteSavedQuery"
 	Query_ListFacets_FullMethodName            = "/query.v1.Query/ListFacets"
 	Query_DebugGetAccessRules_FullMethodName   = "/query.v1.Query/DebugGetAccessRules"
+	Query_ExplainAccess_FullMethodName         = "/query.v1.Query/ExplainAccess"
+	Query_RebuildIndex_FullMethodName          = "/query.v1.Query/RebuildIndex"
+	Query_CheckIndex_FullMethodName            = "/query.v1.Query/CheckIndex"
+	Query_ListCollectorStatus_FullMethodName   = "/query.v1.Query/ListCollectorStatus"
+	Query_ListAlertRules_FullMethodName        = "/query.v1.Query/ListAlertRules"
+	Query_GetAlertRule_FullMethodName          = "/query.v1.Query/GetAlertRule"
+	Query_CreateAlertRule_FullMethodName       = "/query.v1.Query/CreateAlertRule"
+	Query_UpdateAlertRule_FullMethodName       = "/query.v1.Query/UpdateAlertRule"
+	Query_DeleteAlertRule_FullMethodName       = "/query.v1.Query/DeleteAlertRule"
 	Query_ListEnabledComponents_FullMethodName = "/query.v1.Query/ListEnabledComponents"
 )
 
@@ -37,11 +57,86 @@ type QueryClient interface {
 	// Query for resources across clusters
 	DoQuery(ctx context.Context, in *DoQueryRequest, opts ...grpc.CallOption) (*DoQueryResponse, error)
 	//
+	// Get the status history of a resource
+	GetObjectHistory(ctx context.Context, in *GetObjectHistoryRequest, opts ...grpc.CallOption) (*GetObjectHistoryResponse, error)
+	//
+	// Get the resources that a resource depends on, like its source, and
+	// the resources that depend on it, like the workloads it applies
+	GetObjectRelations(ctx context.Context, in *GetObjectRelationsRequest, opts ...grpc.CallOption) (*GetObjectRelationsResponse, error)
+	//
+	// Compare the spec, status and labels of a resource across clusters,
+	// relative to the resource of a baseline cluster
+	CompareObjects(ctx context.Context, in *CompareObjectsRequest, opts ...grpc.CallOption) (*CompareObjectsResponse, error)
+	//
+	// Count the resources matching a query across clusters, grouped by
+	// cluster, namespace, kind, status, tenant or labels.<key>
+	DoAggregate(ctx context.Context, in *DoAggregateRequest, opts ...grpc.CallOption) (*DoAggregateResponse, error)
+	//
+	// Watch the results of a query. The first response is a snapshot of the
+	// objects matching the query, followed by the objects added, updated or
+	// deleted from the results. Over HTTP, responses are server-sent events.
+	WatchQuery(ctx context.Context, in *WatchQueryRequest, opts ...grpc.CallOption) (Query_WatchQueryClient, error)
+	//
+	// List the saved queries visible to the user: their own, the ones shared
+	// with their groups, and the global ones
+	ListSavedQueries(ctx context.Context, in *ListSavedQueriesRequest, opts ...grpc.CallOption) (*ListSavedQueriesResponse, error)
+	//
+	// Get a saved query
+	GetSavedQuery(ctx context.Context, in *GetSavedQueryRequest, opts ...grpc.CallOption) (*GetSavedQueryResponse, error)
+	//
+	// Save a query owned by the user. Requires a postgres store
+	CreateSavedQuery(ctx context.Context, in *CreateSavedQueryRequest, opts ...grpc.CallOption) (*CreateSavedQueryResponse, error)
+	//
+	// Update a saved query owned by the user
+	UpdateSavedQuery(ctx context.Context, in *UpdateSavedQueryRequest, opts ...grpc.CallOption) (*UpdateSavedQueryResponse, error)
+	//
+	// Delete a saved query owned by the user
+	DeleteSavedQuery(ctx context.Context, in *DeleteSavedQueryRequest, opts ...grpc.CallOption) (*DeleteSavedQueryResponse, error)
+	//
+	// Run a saved query
+	ExecuteSavedQuery(ctx context.Context, in *ExecuteSavedQueryRequest, opts ...grpc.CallOption) (*ExecuteSavedQueryResponse, error)
+	//
 	// List facets available for querying
 	ListFacets(ctx context.Context, in *ListFacetsRequest, opts ...grpc.CallOption) (*ListFacetsResponse, error)
 	//
 	// Get debug access rules
 	DebugGetAccessRules(ctx context.Context, in *DebugGetAccessRulesRequest, opts ...grpc.CallOption) (*DebugGetAccessRulesResponse, error)
+	//
+	// Explain why a user can or cannot see a resource: the role, role binding
+	// and rule that let them, or the closest ones when none does. Only users
+	// that can impersonate others in the management cluster can call it
+	ExplainAccess(ctx context.Context, in *ExplainAccessRequest, opts ...grpc.CallOption) (*ExplainAccessResponse, error)
+	//
+	// Rebuild the index from the store, swapping it in when done. Only users
+	// that can impersonate others in the management cluster can call it
+	RebuildIndex(ctx context.Context, in *RebuildIndexRequest, opts ...grpc.CallOption) (*RebuildIndexResponse, error)
+	//
+	// Check that the index has the same resources as the store. Only users
+	// that can impersonate others in the management cluster can call it
+	CheckIndex(ctx context.Context, in *CheckIndexRequest, opts ...grpc.CallOption) (*CheckIndexResponse, error)
+	//
+	// List the status of the collection of each cluster by each collector:
+	// its state, last sync, error, number of collected resources and retries.
+	// With sharding, the replica serving the request only knows the state of the
+	// clusters it collects, and lists the other clusters with the replica that
+	// collects them. Only admins can list them
+	ListCollectorStatus(ctx context.Context, in *ListCollectorStatusRequest, opts ...grpc.CallOption) (*ListCollectorStatusResponse, error)
+	//
+	// List the alert rules with their state. Only admins can manage
+	// alert rules
+	ListAlertRules(ctx context.Context, in *ListAlertRulesRequest, opts ...grpc.CallOption) (*ListAlertRulesResponse, error)
+	//
+	// Get an alert rule with its state
+	GetAlertRule(ctx context.Context, in *GetAlertRuleRequest, opts ...grpc.CallOption) (*GetAlertRuleResponse, error)
+	//
+	// Create an alert rule, whose query runs as the user. Requires a postgres store
+	CreateAlertRule(ctx context.Context, in *CreateAlertRuleRequest, opts ...grpc.CallOption) (*CreateAlertRuleResponse, error)
+	//
+	// Update an alert rule, whose query then runs as the user
+	UpdateAlertRule(ctx context.Context, in *UpdateAlertRuleRequest, opts ...grpc.CallOption) (*UpdateAlertRuleResponse, error)
+	//
+	// Delete an alert rule
+	DeleteAlertRule(ctx context.Context, in *DeleteAlertRuleRequest, opts ...grpc.CallOption) (*DeleteAlertRuleResponse, error)
 	// FIXME
 	ListEnabledComponents(ctx context.Context, in *ListEnabledComponentsRequest, opts ...grpc.CallOption) (*ListEnabledComponentsResponse, error)
 }
@@ -63,6 +158,128 @@ func (c *queryClient) DoQuery(ctx context.Context, in *DoQueryRequest, opts ...g
 	return out, nil
 }
 
+func (c *queryClient) GetObjectHistory(ctx context.Context, in *GetObjectHistoryRequest, opts ...grpc.CallOption) (*GetObjectHistoryResponse, error) {
+	out := new(GetObjectHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_GetObjectHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetObjectRelations(ctx context.Context, in *GetObjectRelationsRequest, opts ...grpc.CallOption) (*GetObjectRelationsResponse, error) {
+	out := new(GetObjectRelationsResponse)
+	err := c.cc.Invoke(ctx, Query_GetObjectRelations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CompareObjects(ctx context.Context, in *CompareObjectsRequest, opts ...grpc.CallOption) (*CompareObjectsResponse, error) {
+	out := new(CompareObjectsResponse)
+	err := c.cc.Invoke(ctx, Query_CompareObjects_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DoAggregate(ctx context.Context, in *DoAggregateRequest, opts ...grpc.CallOption) (*DoAggregateResponse, error) {
+	out := new(DoAggregateResponse)
+	err := c.cc.Invoke(ctx, Query_DoAggregate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WatchQuery(ctx context.Context, in *WatchQueryRequest, opts ...grpc.CallOption) (Query_WatchQueryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[0], Query_WatchQuery_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryWatchQueryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_WatchQueryClient interface {
+	Recv() (*WatchQueryResponse, error)
+	grpc.ClientStream
+}
+
+type queryWatchQueryClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryWatchQueryClient) Recv() (*WatchQueryResponse, error) {
+	m := new(WatchQueryResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *queryClient) ListSavedQueries(ctx context.Context, in *ListSavedQueriesRequest, opts ...grpc.CallOption) (*ListSavedQueriesResponse, error) {
+	out := new(ListSavedQueriesResponse)
+	err := c.cc.Invoke(ctx, Query_ListSavedQueries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetSavedQuery(ctx context.Context, in *GetSavedQueryRequest, opts ...grpc.CallOption) (*GetSavedQueryResponse, error) {
+	out := new(GetSavedQueryResponse)
+	err := c.cc.Invoke(ctx, Query_GetSavedQuery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CreateSavedQuery(ctx context.Context, in *CreateSavedQueryRequest, opts ...grpc.CallOption) (*CreateSavedQueryResponse, error) {
+	out := new(CreateSavedQueryResponse)
+	err := c.cc.Invoke(ctx, Query_CreateSavedQuery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UpdateSavedQuery(ctx context.Context, in *UpdateSavedQueryRequest, opts ...grpc.CallOption) (*UpdateSavedQueryResponse, error) {
+	out := new(UpdateSavedQueryResponse)
+	err := c.cc.Invoke(ctx, Query_UpdateSavedQuery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeleteSavedQuery(ctx context.Context, in *DeleteSavedQueryRequest, opts ...grpc.CallOption) (*DeleteSavedQueryResponse, error) {
+	out := new(DeleteSavedQueryResponse)
+	err := c.cc.Invoke(ctx, Query_DeleteSavedQuery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExecuteSavedQuery(ctx context.Context, in *ExecuteSavedQueryRequest, opts ...grpc.CallOption) (*ExecuteSavedQueryResponse, error) {
+	out := new(ExecuteSavedQueryResponse)
+	err := c.cc.Invoke(ctx, Query_ExecuteSavedQuery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ListFacets(ctx context.Context, in *ListFacetsRequest, opts ...grpc.CallOption) (*ListFacetsResponse, error) {
 	out := new(ListFacetsResponse)
 	err := c.cc.Invoke(ctx, Query_ListFacets_FullMethodName, in, out, opts...)
@@ -81,6 +298,87 @@ func (c *queryClient) DebugGetAccessRules(ctx context.Context, in *DebugGetAcces
 	return out, nil
 }
 
+func (c *queryClient) ExplainAccess(ctx context.Context, in *ExplainAccessRequest, opts ...grpc.CallOption) (*ExplainAccessResponse, error) {
+	out := new(ExplainAccessResponse)
+	err := c.cc.Invoke(ctx, Query_ExplainAccess_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RebuildIndex(ctx context.Context, in *RebuildIndexRequest, opts ...grpc.CallOption) (*RebuildIndexResponse, error) {
+	out := new(RebuildIndexResponse)
+	err := c.cc.Invoke(ctx, Query_RebuildIndex_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CheckIndex(ctx context.Context, in *CheckIndexRequest, opts ...grpc.CallOption) (*CheckIndexResponse, error) {
+	out := new(CheckIndexResponse)
+	err := c.cc.Invoke(ctx, Query_CheckIndex_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListCollectorStatus(ctx context.Context, in *ListCollectorStatusRequest, opts ...grpc.CallOption) (*ListCollectorStatusResponse, error) {
+	out := new(ListCollectorStatusResponse)
+	err := c.cc.Invoke(ctx, Query_ListCollectorStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListAlertRules(ctx context.Context, in *ListAlertRulesRequest, opts ...grpc.CallOption) (*ListAlertRulesResponse, error) {
+	out := new(ListAlertRulesResponse)
+	err := c.cc.Invoke(ctx, Query_ListAlertRules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetAlertRule(ctx context.Context, in *GetAlertRuleRequest, opts ...grpc.CallOption) (*GetAlertRuleResponse, error) {
+	out := new(GetAlertRuleResponse)
+	err := c.cc.Invoke(ctx, Query_GetAlertRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CreateAlertRule(ctx context.Context, in *CreateAlertRuleRequest, opts ...grpc.CallOption) (*CreateAlertRuleResponse, error) {
+	out := new(CreateAlertRuleResponse)
+	err := c.cc.Invoke(ctx, Query_CreateAlertRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UpdateAlertRule(ctx context.Context, in *UpdateAlertRuleRequest, opts ...grpc.CallOption) (*UpdateAlertRuleResponse, error) {
+	out := new(UpdateAlertRuleResponse)
+	err := c.cc.Invoke(ctx, Query_UpdateAlertRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeleteAlertRule(ctx context.Context, in *DeleteAlertRuleRequest, opts ...grpc.CallOption) (*DeleteAlertRuleResponse, error) {
+	out := new(DeleteAlertRuleResponse)
+	err := c.cc.Invoke(ctx, Query_DeleteAlertRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ListEnabledComponents(ctx context.Context, in *ListEnabledComponentsRequest, opts ...grpc.CallOption) (*ListEnabledComponentsResponse, error) {
 	out := new(ListEnabledComponentsResponse)
 	err := c.cc.Invoke(ctx, Query_ListEnabledComponents_FullMethodName, in, out, opts...)
@@ -98,11 +396,86 @@ type QueryServer interface {
 	// Query for resources across clusters
 	DoQuery(context.Context, *DoQueryRequest) (*DoQueryResponse, error)
 	//
+	// Get the status history of a resource
+	GetObjectHistory(context.Context, *GetObjectHistoryRequest) (*GetObjectHistoryResponse, error)
+	//
+	// Get the resources that a resource depends on, like its source, and
+	// the resources that depend on it, like the workloads it applies
+	GetObjectRelations(context.Context, *GetObjectRelationsRequest) (*GetObjectRelationsResponse, error)
+	//
+	// Compare the spec, status and labels of a resource across clusters,
+	// relative to the resource of a baseline cluster
+	CompareObjects(context.Context, *CompareObjectsRequest) (*CompareObjectsResponse, error)
+	//
+	// Count the resources matching a query across clusters, grouped by
+	// cluster, namespace, kind, status, tenant or labels.<key>
+	DoAggregate(context.Context, *DoAggregateRequest) (*DoAggregateResponse, error)
+	//
+	// Watch the results of a query. The first response is a snapshot of the
+	// objects matching the query, followed by the objects added, updated or
+	// deleted from the results. Over HTTP, responses are server-sent events.
+	WatchQuery(*WatchQueryRequest, Query_WatchQueryServer) error
+	//
+	// List the saved queries visible to the user: their own, the ones shared
+	// with their groups, and the global ones
+	ListSavedQueries(context.Context, *ListSavedQueriesRequest) (*ListSavedQueriesResponse, error)
+	//
+	// Get a saved query
+	GetSavedQuery(context.Context, *GetSavedQueryRequest) (*GetSavedQueryResponse, error)
+	//
+	// Save a query owned by the user. Requires a postgres store
+	CreateSavedQuery(context.Context, *CreateSavedQueryRequest) (*CreateSavedQueryResponse, error)
+	//
+	// Update a saved query owned by the user
+	UpdateSavedQuery(context.Context, *UpdateSavedQueryRequest) (*UpdateSavedQueryResponse, error)
+	//
+	// Delete a saved query owned by the user
+	DeleteSavedQuery(context.Context, *DeleteSavedQueryRequest) (*DeleteSavedQueryResponse, error)
+	//
+	// Run a saved query
+	ExecuteSavedQuery(context.Context, *ExecuteSavedQueryRequest) (*ExecuteSavedQueryResponse, error)
+	//
 	// List facets available for querying
 	ListFacets(context.Context, *ListFacetsRequest) (*ListFacetsResponse, error)
 	//
 	// Get debug access rules
 	DebugGetAccessRules(context.Context, *DebugGetAccessRulesRequest) (*DebugGetAccessRulesResponse, error)
+	//
+	// Explain why a user can or cannot see a resource: the role, role binding
+	// and rule that let them, or the closest ones when none does. Only users
+	// that can impersonate others in the management cluster can call it
+	ExplainAccess(context.Context, *ExplainAccessRequest) (*ExplainAccessResponse, error)
+	//
+	// Rebuild the index from the store, swapping it in when done. Only users
+	// that can impersonate others in the management cluster can call it
+	RebuildIndex(context.Context, *RebuildIndexRequest) (*RebuildIndexResponse, error)
+	//
+	// Check that the index has the same resources as the store. Only users
+	// that can impersonate others in the management cluster can call it
+	CheckIndex(context.Context, *CheckIndexRequest) (*CheckIndexResponse, error)
+	//
+	// List the status of the collection of each cluster by each collector:
+	// its state, last sync, error, number of collected resources and retries.
+	// With sharding, the replica serving the request only knows the state of the
+	// clusters it collects, and lists the other clusters with the replica that
+	// collects them. Only admins can list them
+	ListCollectorStatus(context.Context, *ListCollectorStatusRequest) (*ListCollectorStatusResponse, error)
+	//
+	// List the alert rules with their state. Only admins can manage
+	// alert rules
+	ListAlertRules(context.Context, *ListAlertRulesRequest) (*ListAlertRulesResponse, error)
+	//
+	// Get an alert rule with its state
+	GetAlertRule(context.Context, *GetAlertRuleRequest) (*GetAlertRuleResponse, error)
+	//
+	// Create an alert rule, whose query runs as the user. Requires a postgres store
+	CreateAlertRule(context.Context, *CreateAlertRuleRequest) (*CreateAlertRuleResponse, error)
+	//
+	// Update an alert rule, whose query then runs as the user
+	UpdateAlertRule(context.Context, *UpdateAlertRuleRequest) (*UpdateAlertRuleResponse, error)
+	//
+	// Delete an alert rule
+	DeleteAlertRule(context.Context, *DeleteAlertRuleRequest) (*DeleteAlertRuleResponse, error)
 	// FIXME
 	ListEnabledComponents(context.Context, *ListEnabledComponentsRequest) (*ListEnabledComponentsResponse, error)
 	mustEmbedUnimplementedQueryServer()
@@ -115,12 +488,72 @@ type UnimplementedQueryServer struct {
 func (UnimplementedQueryServer) DoQuery(context.Context, *DoQueryRequest) (*DoQueryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DoQuery not implemented")
 }
+func (UnimplementedQueryServer) GetObjectHistory(context.Context, *GetObjectHistoryRequest) (*GetObjectHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetObjectHistory not implemented")
+}
+func (UnimplementedQueryServer) GetObjectRelations(context.Context, *GetObjectRelationsRequest) (*GetObjectRelationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetObjectRelations not implemented")
+}
+func (UnimplementedQueryServer) CompareObjects(context.Context, *CompareObjectsRequest) (*CompareObjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareObjects not implemented")
+}
+func (UnimplementedQueryServer) DoAggregate(context.Context, *DoAggregateRequest) (*DoAggregateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DoAggregate not implemented")
+}
+func (UnimplementedQueryServer) WatchQuery(*WatchQueryRequest, Query_WatchQueryServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchQuery not implemented")
+}
+func (UnimplementedQueryServer) ListSavedQueries(context.Context, *ListSavedQueriesRequest) (*ListSavedQueriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSavedQueries not implemented")
+}
+func (UnimplementedQueryServer) GetSavedQuery(context.Context, *GetSavedQueryRequest) (*GetSavedQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSavedQuery not implemented")
+}
+func (UnimplementedQueryServer) CreateSavedQuery(context.Context, *CreateSavedQueryRequest) (*CreateSavedQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSavedQuery not implemented")
+}
+func (UnimplementedQueryServer) UpdateSavedQuery(context.Context, *UpdateSavedQueryRequest) (*UpdateSavedQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSavedQuery not implemented")
+}
+func (UnimplementedQueryServer) DeleteSavedQuery(context.Context, *DeleteSavedQueryRequest) (*DeleteSavedQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSavedQuery not implemented")
+}
+func (UnimplementedQueryServer) ExecuteSavedQuery(context.Context, *ExecuteSavedQueryRequest) (*ExecuteSavedQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteSavedQuery not implemented")
+}
 func (UnimplementedQueryServer) ListFacets(context.Context, *ListFacetsRequest) (*ListFacetsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListFacets not implemented")
 }
 func (UnimplementedQueryServer) DebugGetAccessRules(context.Context, *DebugGetAccessRulesRequest) (*DebugGetAccessRulesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DebugGetAccessRules not implemented")
 }
+func (UnimplementedQueryServer) ExplainAccess(context.Context, *ExplainAccessRequest) (*ExplainAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainAccess not implemented")
+}
+func (UnimplementedQueryServer) RebuildIndex(context.Context, *RebuildIndexRequest) (*RebuildIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebuildIndex not implemented")
+}
+func (UnimplementedQueryServer) CheckIndex(context.Context, *CheckIndexRequest) (*CheckIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckIndex not implemented")
+}
+func (UnimplementedQueryServer) ListCollectorStatus(context.Context, *ListCollectorStatusRequest) (*ListCollectorStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollectorStatus not implemented")
+}
+func (UnimplementedQueryServer) ListAlertRules(context.Context, *ListAlertRulesRequest) (*ListAlertRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAlertRules not implemented")
+}
+func (UnimplementedQueryServer) GetAlertRule(context.Context, *GetAlertRuleRequest) (*GetAlertRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAlertRule not implemented")
+}
+func (UnimplementedQueryServer) CreateAlertRule(context.Context, *CreateAlertRuleRequest) (*CreateAlertRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAlertRule not implemented")
+}
+func (UnimplementedQueryServer) UpdateAlertRule(context.Context, *UpdateAlertRuleRequest) (*UpdateAlertRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAlertRule not implemented")
+}
+func (UnimplementedQueryServer) DeleteAlertRule(context.Context, *DeleteAlertRuleRequest) (*DeleteAlertRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAlertRule not implemented")
+}
 func (UnimplementedQueryServer) ListEnabledComponents(context.Context, *ListEnabledComponentsRequest) (*ListEnabledComponentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListEnabledComponents not implemented")
 }
@@ -155,6 +588,207 @@ func _Query_DoQuery_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetObjectHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetObjectHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetObjectHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetObjectHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetObjectHistory(ctx, req.(*GetObjectHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetObjectRelations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetObjectRelationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetObjectRelations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetObjectRelations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetObjectRelations(ctx, req.(*GetObjectRelationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CompareObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareObjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CompareObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CompareObjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CompareObjects(ctx, req.(*CompareObjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DoAggregate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoAggregateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DoAggregate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DoAggregate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DoAggregate(ctx, req.(*DoAggregateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WatchQuery_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchQueryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).WatchQuery(m, &queryWatchQueryServer{stream})
+}
+
+type Query_WatchQueryServer interface {
+	Send(*WatchQueryResponse) error
+	grpc.ServerStream
+}
+
+type queryWatchQueryServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryWatchQueryServer) Send(m *WatchQueryResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Query_ListSavedQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSavedQueriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListSavedQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListSavedQueries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListSavedQueries(ctx, req.(*ListSavedQueriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetSavedQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSavedQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetSavedQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetSavedQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetSavedQuery(ctx, req.(*GetSavedQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CreateSavedQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSavedQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CreateSavedQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CreateSavedQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CreateSavedQuery(ctx, req.(*CreateSavedQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UpdateSavedQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSavedQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpdateSavedQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UpdateSavedQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpdateSavedQuery(ctx, req.(*UpdateSavedQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeleteSavedQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSavedQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeleteSavedQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DeleteSavedQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeleteSavedQuery(ctx, req.(*DeleteSavedQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExecuteSavedQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteSavedQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExecuteSavedQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ExecuteSavedQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExecuteSavedQuery(ctx, req.(*ExecuteSavedQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ListFacets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListFacetsRequest)
 	if err := dec(in); err != nil {
@@ -191,6 +825,168 @@ func _Query_DebugGetAccessRules_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ExplainAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExplainAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ExplainAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExplainAccess(ctx, req.(*ExplainAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RebuildIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebuildIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RebuildIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RebuildIndex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RebuildIndex(ctx, req.(*RebuildIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CheckIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CheckIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CheckIndex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckIndex(ctx, req.(*CheckIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListCollectorStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectorStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListCollectorStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListCollectorStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListCollectorStatus(ctx, req.(*ListCollectorStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListAlertRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlertRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListAlertRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListAlertRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListAlertRules(ctx, req.(*ListAlertRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAlertRule(ctx, req.(*GetAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CreateAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CreateAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CreateAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CreateAlertRule(ctx, req.(*CreateAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UpdateAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpdateAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UpdateAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpdateAlertRule(ctx, req.(*UpdateAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeleteAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeleteAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DeleteAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeleteAlertRule(ctx, req.(*DeleteAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ListEnabledComponents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListEnabledComponentsRequest)
 	if err := dec(in); err != nil {
@@ -220,6 +1016,46 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DoQuery",
 			Handler:    _Query_DoQuery_Handler,
 		},
+		{
+			MethodName: "GetObjectHistory",
+			Handler:    _Query_GetObjectHistory_Handler,
+		},
+		{
+			MethodName: "GetObjectRelations",
+			Handler:    _Query_GetObjectRelations_Handler,
+		},
+		{
+			MethodName: "CompareObjects",
+			Handler:    _Query_CompareObjects_Handler,
+		},
+		{
+			MethodName: "DoAggregate",
+			Handler:    _Query_DoAggregate_Handler,
+		},
+		{
+			MethodName: "ListSavedQueries",
+			Handler:    _Query_ListSavedQueries_Handler,
+		},
+		{
+			MethodName: "GetSavedQuery",
+			Handler:    _Query_GetSavedQuery_Handler,
+		},
+		{
+			MethodName: "CreateSavedQuery",
+			Handler:    _Query_CreateSavedQuery_Handler,
+		},
+		{
+			MethodName: "UpdateSavedQuery",
+			Handler:    _Query_UpdateSavedQuery_Handler,
+		},
+		{
+			MethodName: "DeleteSavedQuery",
+			Handler:    _Query_DeleteSavedQuery_Handler,
+		},
+		{
+			MethodName: "ExecuteSavedQuery",
+			Handler:    _Query_ExecuteSavedQuery_Handler,
+		},
 		{
 			MethodName: "ListFacets",
 			Handler:    _Query_ListFacets_Handler,
@@ -228,11 +1064,53 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DebugGetAccessRules",
 			Handler:    _Query_DebugGetAccessRules_Handler,
 		},
+		{
+			MethodName: "ExplainAccess",
+			Handler:    _Query_ExplainAccess_Handler,
+		},
+		{
+			MethodName: "RebuildIndex",
+			Handler:    _Query_RebuildIndex_Handler,
+		},
+		{
+			MethodName: "CheckIndex",
+			Handler:    _Query_CheckIndex_Handler,
+		},
+		{
+			MethodName: "ListCollectorStatus",
+			Handler:    _Query_ListCollectorStatus_Handler,
+		},
+		{
+			MethodName: "ListAlertRules",
+			Handler:    _Query_ListAlertRules_Handler,
+		},
+		{
+			MethodName: "GetAlertRule",
+			Handler:    _Query_GetAlertRule_Handler,
+		},
+		{
+			MethodName: "CreateAlertRule",
+			Handler:    _Query_CreateAlertRule_Handler,
+		},
+		{
+			MethodName: "UpdateAlertRule",
+			Handler:    _Query_UpdateAlertRule_Handler,
+		},
+		{
+			MethodName: "DeleteAlertRule",
+			Handler:    _Query_DeleteAlertRule_Handler,
+		},
 		{
 			MethodName: "ListEnabledComponents",
 			Handler:    _Query_ListEnabledComponents_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchQuery",
+			Handler:       _Query_WatchQuery_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "api/query/query.proto",
 }
@@ -5,6 +5,7 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
 	"github.com/go-logr/logr"
@@ -565,3 +566,309 @@ func TestRunQuery_AccessRules(t *testing.T) {
 		})
 	}
 }
+
+func TestExplainAccess(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	dir, err := os.MkdirTemp("", "test")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	s, err := store.NewStore(store.StorageBackendSQLite, dir, logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	podinfo := models.Object{
+		Cluster:    "cluster-a",
+		Namespace:  "ns-a",
+		APIGroup:   helmv2.GroupVersion.Group,
+		APIVersion: helmv2.GroupVersion.Version,
+		Kind:       helmv2.HelmReleaseKind,
+		Name:       "podinfo",
+		Category:   configuration.CategoryAutomation,
+	}
+	g.Expect(s.StoreObjects(context.Background(), []models.Object{podinfo})).To(Succeed())
+
+	g.Expect(s.StoreRoles(context.Background(), []models.Role{
+		{
+			Name:    "impersonator",
+			Cluster: "management",
+			Kind:    "ClusterRole",
+			PolicyRules: []models.PolicyRule{{
+				APIGroups: models.JoinRuleData([]string{""}),
+				Resources: "users,groups",
+				Verbs:     "impersonate",
+			}},
+		},
+		{
+			Name:    "helm-reader",
+			Cluster: "cluster-a",
+			Kind:    "ClusterRole",
+			PolicyRules: []models.PolicyRule{{
+				APIGroups: helmv2.GroupVersion.Group,
+				Resources: "helmreleases",
+				Verbs:     "get,list,watch",
+			}},
+		},
+	})).To(Succeed())
+	g.Expect(s.StoreRoleBindings(context.Background(), []models.RoleBinding{
+		{
+			Name:        "admins",
+			Cluster:     "management",
+			Kind:        "ClusterRoleBinding",
+			RoleRefName: "impersonator",
+			RoleRefKind: "ClusterRole",
+			Subjects:    []models.Subject{{Kind: "Group", Name: "admins"}},
+		},
+		{
+			Name:        "helm-readers",
+			Cluster:     "cluster-a",
+			Namespace:   "ns-a",
+			Kind:        "RoleBinding",
+			RoleRefName: "helm-reader",
+			RoleRefKind: "ClusterRole",
+			Subjects:    []models.Subject{{Kind: "Group", Name: "group-a"}},
+		},
+	})).To(Succeed())
+
+	kindToResourceMap, err := testutils.CreateDefaultResourceKindMap()
+	g.Expect(err).NotTo(HaveOccurred())
+	authz := rbac.NewAuthorizer(kindToResourceMap)
+
+	indexer, err := store.NewIndexer(s, dir, logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	qs, err := NewQueryService(QueryServiceOpts{
+		Log:               logr.Discard(),
+		StoreReader:       s,
+		IndexReader:       indexer,
+		Authorizer:        authz,
+		AccessExplainer:   authz,
+		ManagementCluster: "management",
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	admin := auth.WithPrincipal(context.Background(), auth.NewUserPrincipal(auth.ID("admin"), auth.Groups([]string{"admins"})))
+	user := auth.NewUserPrincipal(auth.ID("some-user"), auth.Groups([]string{"group-a"}))
+
+	t.Run("explains the access to an object by id", func(t *testing.T) {
+		explanation, err := qs.ExplainAccess(admin, user, podinfo.GetID(), models.ObjectNode{})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(explanation.Allowed).To(BeTrue())
+		g.Expect(explanation.Grants).To(HaveLen(1))
+		g.Expect(explanation.Grants[0].RoleBinding.Name).To(Equal("helm-readers"))
+		g.Expect(explanation.NearMisses).To(BeEmpty())
+	})
+
+	t.Run("explains the access to an object by reference", func(t *testing.T) {
+		node := models.NewObjectNode(podinfo)
+		node.Namespace = "ns-b"
+
+		explanation, err := qs.ExplainAccess(admin, user, "", node)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(explanation.Allowed).To(BeFalse())
+		g.Expect(explanation.NearMisses).To(HaveLen(1))
+		g.Expect(explanation.NearMisses[0].Mismatches).To(Equal([]string{`RoleBinding ns-a/helm-readers is not in namespace "ns-b"`}))
+	})
+
+	t.Run("does not find missing objects", func(t *testing.T) {
+		_, err := qs.ExplainAccess(admin, user, "cluster-a/ns-a/missing", models.ObjectNode{})
+		g.Expect(err).To(MatchError(ErrObjectNotFound))
+	})
+
+	t.Run("only principals that can impersonate explain access", func(t *testing.T) {
+		_, err := qs.ExplainAccess(auth.WithPrincipal(context.Background(), user), user, podinfo.GetID(), models.ObjectNode{})
+		g.Expect(err).To(MatchError(ErrAdminRequired))
+	})
+}
+
+func TestRebuildIndex(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	dir, err := os.MkdirTemp("", "test")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	s, err := store.NewStore(store.StorageBackendSQLite, dir, logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(s.StoreObjects(context.Background(), []models.Object{{
+		Cluster:    "management",
+		Namespace:  "flux-system",
+		APIGroup:   helmv2.GroupVersion.Group,
+		APIVersion: helmv2.GroupVersion.Version,
+		Kind:       helmv2.HelmReleaseKind,
+		Name:       "podinfo",
+		Category:   configuration.CategoryAutomation,
+	}})).To(Succeed())
+	g.Expect(s.StoreRoles(context.Background(), []models.Role{{
+		Name:    "impersonator",
+		Cluster: "management",
+		Kind:    "ClusterRole",
+		PolicyRules: []models.PolicyRule{{
+			APIGroups: models.JoinRuleData([]string{""}),
+			Resources: "users,groups",
+			Verbs:     "impersonate",
+		}},
+	}})).To(Succeed())
+	g.Expect(s.StoreRoleBindings(context.Background(), []models.RoleBinding{{
+		Name:        "admins",
+		Cluster:     "management",
+		Kind:        "ClusterRoleBinding",
+		RoleRefName: "impersonator",
+		RoleRefKind: "ClusterRole",
+		Subjects:    []models.Subject{{Kind: "Group", Name: "admins"}},
+	}})).To(Succeed())
+
+	indexer, err := store.NewIndexer(s, dir, logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	authz := rbac.NewAuthorizer(map[string]string{})
+
+	qs, err := NewQueryService(QueryServiceOpts{
+		Log:               logr.Discard(),
+		StoreReader:       s,
+		IndexReader:       indexer,
+		Authorizer:        authz,
+		AccessExplainer:   authz,
+		ManagementCluster: "management",
+		IndexMaintainer:   indexer,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	admin := auth.WithPrincipal(context.Background(), auth.NewUserPrincipal(auth.ID("admin"), auth.Groups([]string{"admins"})))
+	user := auth.WithPrincipal(context.Background(), auth.NewUserPrincipal(auth.ID("some-user"), auth.Groups([]string{"group-a"})))
+
+	t.Run("admins check and rebuild the index", func(t *testing.T) {
+		consistency, err := qs.CheckIndex(admin)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(consistency.MissingFromIndex).To(HaveLen(1))
+
+		count, err := qs.RebuildIndex(admin)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(count).To(Equal(1))
+
+		consistency, err = qs.CheckIndex(admin)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(consistency.Consistent()).To(BeTrue())
+	})
+
+	t.Run("other principals cannot", func(t *testing.T) {
+		_, err := qs.CheckIndex(user)
+		g.Expect(err).To(MatchError(ErrAdminRequired))
+
+		_, err = qs.RebuildIndex(user)
+		g.Expect(err).To(MatchError(ErrAdminRequired))
+	})
+}
+
+func TestAlertRules(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	dir, err := os.MkdirTemp("", "test")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	s, err := store.NewStore(store.StorageBackendSQLite, dir, logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(s.StoreRoles(context.Background(), []models.Role{{
+		Name:    "impersonator",
+		Cluster: "management",
+		Kind:    "ClusterRole",
+		PolicyRules: []models.PolicyRule{{
+			APIGroups: models.JoinRuleData([]string{""}),
+			Resources: "users,groups",
+			Verbs:     "impersonate",
+		}},
+	}})).To(Succeed())
+	g.Expect(s.StoreRoleBindings(context.Background(), []models.RoleBinding{{
+		Name:        "admins",
+		Cluster:     "management",
+		Kind:        "ClusterRoleBinding",
+		RoleRefName: "impersonator",
+		RoleRefKind: "ClusterRole",
+		Subjects:    []models.Subject{{Kind: "Group", Name: "admins"}},
+	}})).To(Succeed())
+
+	indexer, err := store.NewIndexer(s, dir, logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	authz := rbac.NewAuthorizer(map[string]string{})
+
+	qs, err := NewQueryService(QueryServiceOpts{
+		Log:               logr.Discard(),
+		StoreReader:       s,
+		StoreWriter:       s,
+		IndexReader:       indexer,
+		Authorizer:        authz,
+		AccessExplainer:   authz,
+		ManagementCluster: "management",
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	admin := auth.WithPrincipal(context.Background(), auth.NewUserPrincipal(auth.ID("admin"), auth.Groups([]string{"admins"})))
+	user := auth.WithPrincipal(context.Background(), auth.NewUserPrincipal(auth.ID("some-user"), auth.Groups([]string{"group-a"})))
+
+	rule := models.AlertRule{
+		Name:          "Failing releases",
+		Filters:       []string{"kind:HelmRelease", "status:Failed"},
+		For:           10 * time.Minute,
+		WebhookURL:    "http://alertmanager:9093/api/v2/alerts",
+		WebhookFormat: models.AlertWebhookAlertmanager,
+	}
+
+	t.Run("admins manage alert rules that run as them", func(t *testing.T) {
+		created, err := qs.CreateAlertRule(admin, rule)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(created.ID).NotTo(BeEmpty())
+		g.Expect(created.Owner).To(Equal("admin"))
+		g.Expect(created.OwnerGroups).To(Equal([]string{"admins"}))
+
+		created.For = time.Minute
+		updated, err := qs.UpdateAlertRule(admin, created)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(updated.For).To(Equal(time.Minute))
+
+		rules, err := qs.ListAlertRules(admin)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(rules).To(HaveLen(1))
+
+		g.Expect(qs.DeleteAlertRule(admin, created.ID)).To(Succeed())
+		_, err = qs.GetAlertRule(admin, created.ID)
+		g.Expect(err).To(MatchError(ErrAlertRuleNotFound))
+	})
+
+	t.Run("invalid alert rules are rejected", func(t *testing.T) {
+		invalid := rule
+		invalid.WebhookFormat = "slack"
+		_, err := qs.CreateAlertRule(admin, invalid)
+		g.Expect(err).To(MatchError(ErrInvalidAlertRule))
+	})
+
+	t.Run("other principals cannot", func(t *testing.T) {
+		_, err := qs.ListAlertRules(user)
+		g.Expect(err).To(MatchError(ErrAdminRequired))
+
+		_, err = qs.CreateAlertRule(user, rule)
+		g.Expect(err).To(MatchError(ErrAdminRequired))
+	})
+
+	t.Run("alert rules are not saved when read-only", func(t *testing.T) {
+		readOnly, err := NewQueryService(QueryServiceOpts{
+			Log:                logr.Discard(),
+			StoreReader:        s,
+			StoreWriter:        s,
+			IndexReader:        indexer,
+			Authorizer:         authz,
+			AccessExplainer:    authz,
+			ManagementCluster:  "management",
+			AlertRulesReadOnly: true,
+		})
+		g.Expect(err).NotTo(HaveOccurred())
+
+		_, err = readOnly.CreateAlertRule(admin, rule)
+		g.Expect(err).To(MatchError(ErrAlertRulesReadOnly))
+
+		created, err := qs.CreateAlertRule(admin, rule)
+		g.Expect(err).NotTo(HaveOccurred())
+		_, err = readOnly.UpdateAlertRule(admin, created)
+		g.Expect(err).To(MatchError(ErrAlertRulesReadOnly))
+	})
+}
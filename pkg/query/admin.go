@@ -0,0 +1,46 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+)
+
+// ErrAdminRequired is returned when a principal that cannot impersonate others calls an admin operation.
+var ErrAdminRequired = errors.New("admin operations require impersonating users and groups in the management cluster")
+
+// requireAdmin returns the principal and the access rules of the store when the principal can impersonate
+// users and groups in the management cluster, as only admins should be able to.
+func (q *qs) requireAdmin(ctx context.Context) (*auth.UserPrincipal, []models.Role, []models.RoleBinding, error) {
+	principal := auth.Principal(ctx)
+	if principal == nil {
+		return nil, nil, nil, fmt.Errorf("principal not found")
+	}
+
+	if q.explainer == nil {
+		return nil, nil, nil, fmt.Errorf("admins cannot be identified")
+	}
+
+	roles, err := q.r.GetRoles(ctx)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error fetching access rules from the store: %w", err)
+	}
+	bindings, err := q.r.GetRoleBindings(ctx)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error fetching access rules from the store: %w", err)
+	}
+
+	if !q.explainer.CanImpersonate(roles, bindings, principal, q.managementCluster) {
+		return nil, nil, nil, ErrAdminRequired
+	}
+
+	return principal, roles, bindings, nil
+}
+
+func (q *qs) RequireAdmin(ctx context.Context) error {
+	_, _, _, err := q.requireAdmin(ctx)
+	return err
+}
@@ -0,0 +1,328 @@
+package alerting
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+	store "github.com/weaveworks/weave-gitops-enterprise/pkg/query/store"
+	"github.com/weaveworks/weave-gitops/core/logger"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+)
+
+const (
+	defaultInterval       = time.Minute
+	defaultMinInterval    = 10 * time.Second
+	defaultResendInterval = time.Minute
+	defaultOwnerGroupsTTL = 24 * time.Hour
+)
+
+// RuleStore stores the alert rules and their state.
+type RuleStore interface {
+	GetAlertRules(ctx context.Context) ([]models.AlertRule, error)
+	StoreAlertRuleState(ctx context.Context, state models.AlertRuleState) error
+}
+
+// Querier runs the query of an alert rule as the principal in the context.
+type Querier interface {
+	ExportQuery(ctx context.Context, q store.Query, opts store.QueryOption, send func(models.Object) error) error
+	// RequireAdmin returns an error unless the principal in the context is an admin, with the access rules of the store.
+	RequireAdmin(ctx context.Context) error
+}
+
+type EvaluatorOpts struct {
+	Log      logr.Logger
+	Store    RuleStore
+	Querier  Querier
+	Notifier Notifier
+	// Watcher wakes the evaluator up when objects are written. Rules are only evaluated
+	// on the interval without it.
+	Watcher store.Watcher
+	// Interval is how often the rules are evaluated, to fire for objects that have matched for long enough.
+	Interval time.Duration
+	// MinInterval is the least time between two evaluations, so the writes to the store that follow
+	// each other are evaluated at once.
+	MinInterval time.Duration
+	// ResendInterval is how often rules in the alertmanager format post the alerts that are still
+	// firing, so Alertmanager does not resolve them.
+	ResendInterval time.Duration
+	// OwnerGroupsTTL is how long the groups saved with a rule are used to run its query. The groups
+	// of the owner are only known when they save the rule, so after it the rule runs with the
+	// bindings of the owner alone until they save it again.
+	OwnerGroupsTTL time.Duration
+	// Leader returns whether this replica evaluates the rules, when replicas share the store.
+	// Every rule is evaluated by a single replica so webhooks are notified once.
+	Leader func() bool
+}
+
+func (o EvaluatorOpts) Validate() error {
+	if o.Store == nil {
+		return fmt.Errorf("rule store is required")
+	}
+	if o.Querier == nil {
+		return fmt.Errorf("querier is required")
+	}
+	if o.Notifier == nil {
+		return fmt.Errorf("notifier is required")
+	}
+	return nil
+}
+
+// Evaluator runs the query of every alert rule and notifies their webhook when the objects
+// they fire for change.
+type Evaluator struct {
+	log            logr.Logger
+	debug          logr.Logger
+	store          RuleStore
+	querier        Querier
+	notifier       Notifier
+	watcher        store.Watcher
+	interval       time.Duration
+	minInterval    time.Duration
+	resendInterval time.Duration
+	ownerGroupsTTL time.Duration
+	leader         func() bool
+}
+
+func NewEvaluator(opts EvaluatorOpts) (*Evaluator, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid evaluator options: %w", err)
+	}
+	if opts.Interval == 0 {
+		opts.Interval = defaultInterval
+	}
+	if opts.MinInterval == 0 {
+		opts.MinInterval = defaultMinInterval
+	}
+	if opts.ResendInterval == 0 {
+		opts.ResendInterval = defaultResendInterval
+	}
+	if opts.OwnerGroupsTTL == 0 {
+		opts.OwnerGroupsTTL = defaultOwnerGroupsTTL
+	}
+
+	return &Evaluator{
+		log:            opts.Log.WithName("alert-evaluator"),
+		debug:          opts.Log.WithName("alert-evaluator").V(logger.LogLevelDebug),
+		store:          opts.Store,
+		querier:        opts.Querier,
+		notifier:       opts.Notifier,
+		watcher:        opts.Watcher,
+		interval:       opts.Interval,
+		minInterval:    opts.MinInterval,
+		resendInterval: opts.ResendInterval,
+		ownerGroupsTTL: opts.OwnerGroupsTTL,
+		leader:         opts.Leader,
+	}, nil
+}
+
+// Start evaluates the rules on the interval, and when objects are written, until the context is done.
+// Writes are evaluated at most once per minimum interval.
+func (e *Evaluator) Start(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	var watch *store.Watch
+	var changed <-chan struct{}
+	if e.watcher != nil {
+		watch = e.watcher.Watch()
+		defer watch.Stop()
+		changed = watch.Changed()
+	}
+
+	var last time.Time
+	// delayed is set while the evaluation of a write waits for the minimum interval
+	var delayed <-chan time.Time
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-changed:
+			// every rule is evaluated again, whatever the objects that changed
+			watch.Take()
+			if delayed != nil {
+				continue
+			}
+			if wait := e.minInterval - time.Since(last); wait > 0 {
+				delayed = time.After(wait)
+				continue
+			}
+		case <-delayed:
+		}
+		// the evaluation covers the writes waiting for it
+		delayed = nil
+		last = time.Now()
+
+		if e.leader != nil && !e.leader() {
+			continue
+		}
+
+		if err := e.Evaluate(ctx, time.Now().UTC()); err != nil {
+			e.log.Error(err, "failed to evaluate alert rules")
+		}
+	}
+}
+
+// Evaluate evaluates every alert rule at the given time. Rules that fail to evaluate or
+// to notify keep their error in their state, and are retried on the next evaluation.
+func (e *Evaluator) Evaluate(ctx context.Context, now time.Time) error {
+	rules, err := e.store.GetAlertRules(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get alert rules: %w", err)
+	}
+
+	for _, rule := range rules {
+		state := e.evaluate(ctx, rule, now)
+		if err := e.store.StoreAlertRuleState(ctx, state); err != nil {
+			e.log.Error(err, "failed to store alert rule state", "rule", rule.ID)
+		}
+	}
+
+	return nil
+}
+
+func (e *Evaluator) evaluate(ctx context.Context, rule models.AlertRule, now time.Time) models.AlertRuleState {
+	previous := rule.State
+	state := models.AlertRuleState{
+		RuleID:          rule.ID,
+		Matching:        map[string]time.Time{},
+		Firing:          previous.Firing,
+		FiringSince:     previous.FiringSince,
+		ResolvedAt:      previous.ResolvedAt,
+		LastEvaluatedAt: now,
+		LastNotifiedAt:  previous.LastNotifiedAt,
+	}
+
+	// The query runs as the admin that saved the rule, so it only fires for objects they can see with
+	// the current access rules. Rules whose owner is no longer an admin are not evaluated.
+	// The groups saved with the rule cannot be checked again, as the owner may have left them since,
+	// so they expire and the rule then runs with the bindings of the owner alone.
+	principal := &auth.UserPrincipal{ID: rule.Owner}
+	groupsExpired := now.Sub(rule.UpdatedAt) >= e.ownerGroupsTTL
+	if !groupsExpired {
+		principal.Groups = rule.OwnerGroups
+	}
+	ownerCtx := auth.WithPrincipal(ctx, principal)
+	if err := e.querier.RequireAdmin(ownerCtx); err != nil {
+		state.Matching = previous.Matching
+		state.State = previous.State
+		state.LastError = fmt.Sprintf("owner cannot run the rule: %v", err)
+		if groupsExpired && len(rule.OwnerGroups) > 0 {
+			state.LastError += fmt.Sprintf(" (the groups of the owner expire %s after the rule is saved, save it again to renew them)", e.ownerGroupsTTL)
+		}
+		return state
+	}
+
+	firing := []models.AlertObject{}
+	err := e.querier.ExportQuery(ownerCtx, rule, nil, func(obj models.Object) error {
+		id := obj.GetID()
+		since, ok := previous.Matching[id]
+		if !ok {
+			since = now
+		}
+		state.Matching[id] = since
+
+		if now.Sub(since) >= rule.For {
+			firing = append(firing, models.NewAlertObject(obj, since))
+		}
+		return nil
+	})
+	if err != nil {
+		// objects keep matching until the rule can be evaluated again
+		state.Matching = previous.Matching
+		state.State = previous.State
+		state.LastError = fmt.Sprintf("failed to run query: %v", err)
+		return state
+	}
+
+	sort.Slice(firing, func(i, j int) bool {
+		return firing[i].ID < firing[j].ID
+	})
+
+	switch {
+	case len(firing) > 0:
+		state.State = models.AlertFiring
+		if previous.State != models.AlertFiring {
+			state.FiringSince = now
+		}
+	case len(state.Matching) > 0:
+		state.State = models.AlertPending
+	default:
+		state.State = models.AlertInactive
+	}
+	if len(firing) == 0 && previous.State == models.AlertFiring {
+		state.FiringSince = time.Time{}
+		state.ResolvedAt = now
+	}
+
+	// Firing holds the objects notified last, so failed notifications are sent again
+	resolved := resolvedObjects(previous.Firing, firing)
+	resend := rule.WebhookFormat == models.AlertWebhookAlertmanager && len(firing) > 0 && now.Sub(previous.LastNotifiedAt) >= e.resendInterval
+	if len(resolved) == 0 && sameObjects(previous.Firing, firing) && !resend {
+		state.Firing = firing
+		return state
+	}
+
+	status := models.AlertFiring
+	if len(firing) == 0 {
+		status = models.AlertResolved
+	}
+	err = e.notifier.Notify(ctx, Notification{
+		Rule:      rule,
+		Status:    status,
+		Firing:    firing,
+		Resolved:  resolved,
+		Timestamp: now,
+	})
+	if err != nil {
+		// the objects notified last are kept, so the changes are notified again on the next evaluation
+		state.LastError = fmt.Sprintf("failed to notify: %v", err)
+		return state
+	}
+
+	e.debug.Info("alert rule notified", "rule", rule.ID, "status", status, "firing", len(firing), "resolved", len(resolved))
+	state.Firing = firing
+	state.LastNotifiedAt = now
+
+	return state
+}
+
+// resolvedObjects returns the objects that were firing and are no longer.
+func resolvedObjects(previous, firing []models.AlertObject) []models.AlertObject {
+	ids := map[string]bool{}
+	for _, obj := range firing {
+		ids[obj.ID] = true
+	}
+
+	resolved := []models.AlertObject{}
+	for _, obj := range previous {
+		if !ids[obj.ID] {
+			resolved = append(resolved, obj)
+		}
+	}
+
+	return resolved
+}
+
+func sameObjects(a, b []models.AlertObject) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	ids := map[string]bool{}
+	for _, obj := range a {
+		ids[obj.ID] = true
+	}
+	for _, obj := range b {
+		if !ids[obj.ID] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,287 @@
+package alerting
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+	store "github.com/weaveworks/weave-gitops-enterprise/pkg/query/store"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/store/storefakes"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+)
+
+func TestEvaluator(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	webhook := &fakeWebhook{}
+	server := httptest.NewServer(webhook)
+	defer server.Close()
+
+	rules := &fakeRuleStore{rules: []models.AlertRule{{
+		ID:            "failing-releases",
+		Name:          "Failing releases",
+		Filters:       []string{"kind:HelmRelease", "status:Failed"},
+		For:           10 * time.Minute,
+		WebhookURL:    server.URL,
+		WebhookFormat: models.AlertWebhookJSON,
+		Owner:         "admin",
+		OwnerGroups:   []string{"admins"},
+		UpdatedAt:     time.Date(2023, 9, 1, 10, 0, 0, 0, time.UTC),
+	}}}
+	querier := &fakeQuerier{}
+
+	evaluator, err := NewEvaluator(EvaluatorOpts{
+		Log:      testr.New(t),
+		Store:    rules,
+		Querier:  querier,
+		Notifier: NewWebhookNotifier(),
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	podinfo := models.Object{Cluster: "prod", Namespace: "default", Kind: "HelmRelease", Name: "podinfo", Status: "Failed", Message: "install retries exhausted"}
+	start := time.Date(2023, 9, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("objects matching for less than the rule requires are pending", func(t *testing.T) {
+		querier.objects = []models.Object{podinfo}
+
+		g.Expect(evaluator.Evaluate(ctx, start)).To(Succeed())
+		g.Expect(rules.state().State).To(Equal(models.AlertPending))
+		g.Expect(querier.principal.ID).To(Equal("admin"))
+		g.Expect(webhook.received()).To(BeEmpty())
+	})
+
+	t.Run("objects matching for long enough fire", func(t *testing.T) {
+		g.Expect(evaluator.Evaluate(ctx, start.Add(10*time.Minute))).To(Succeed())
+		g.Expect(rules.state().State).To(Equal(models.AlertFiring))
+		g.Expect(rules.state().FiringSince).To(Equal(start.Add(10 * time.Minute)))
+
+		notifications := webhook.received()
+		g.Expect(notifications).To(HaveLen(1))
+		g.Expect(notifications[0].Status).To(Equal(models.AlertFiring))
+		g.Expect(notifications[0].Rule.For).To(Equal("10m0s"))
+		g.Expect(notifications[0].Firing).To(HaveLen(1))
+		g.Expect(notifications[0].Firing[0].Name).To(Equal("podinfo"))
+		g.Expect(notifications[0].Firing[0].Since).To(BeTemporally("==", start))
+	})
+
+	t.Run("firing objects are not notified again", func(t *testing.T) {
+		g.Expect(evaluator.Evaluate(ctx, start.Add(11*time.Minute))).To(Succeed())
+		g.Expect(webhook.received()).To(HaveLen(1))
+	})
+
+	t.Run("failed notifications are retried", func(t *testing.T) {
+		querier.objects = nil
+		webhook.setStatus(http.StatusInternalServerError)
+
+		g.Expect(evaluator.Evaluate(ctx, start.Add(12*time.Minute))).To(Succeed())
+		g.Expect(rules.state().State).To(Equal(models.AlertInactive))
+		g.Expect(rules.state().LastError).To(ContainSubstring("status 500"))
+		g.Expect(rules.state().Firing).To(HaveLen(1))
+
+		webhook.setStatus(http.StatusOK)
+		g.Expect(evaluator.Evaluate(ctx, start.Add(13*time.Minute))).To(Succeed())
+		g.Expect(rules.state().LastError).To(BeEmpty())
+		g.Expect(rules.state().Firing).To(BeEmpty())
+	})
+
+	t.Run("objects that stop matching are resolved", func(t *testing.T) {
+		notifications := webhook.received()
+		g.Expect(notifications).To(HaveLen(2))
+		g.Expect(notifications[1].Status).To(Equal(models.AlertResolved))
+		g.Expect(notifications[1].Firing).To(BeEmpty())
+		g.Expect(notifications[1].Resolved).To(HaveLen(1))
+		g.Expect(rules.state().ResolvedAt).To(Equal(start.Add(12 * time.Minute)))
+	})
+
+	t.Run("rules of owners that are no longer admins are not evaluated", func(t *testing.T) {
+		querier.objects = []models.Object{podinfo}
+		querier.adminErr = errors.New("admin operations require impersonating users and groups")
+		defer func() { querier.adminErr = nil }()
+
+		g.Expect(evaluator.Evaluate(ctx, start.Add(30*time.Minute))).To(Succeed())
+		g.Expect(rules.state().State).To(Equal(models.AlertInactive))
+		g.Expect(rules.state().Matching).To(BeEmpty())
+		g.Expect(rules.state().LastError).To(ContainSubstring("owner cannot run the rule"))
+		g.Expect(webhook.received()).To(HaveLen(2))
+	})
+}
+
+func TestEvaluator_MinInterval(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	broadcaster := store.NewBroadcaster()
+	s := store.NewWatchableStore(&storefakes.FakeStore{}, broadcaster)
+	rules := &fakeRuleStore{}
+
+	evaluator, err := NewEvaluator(EvaluatorOpts{
+		Log:         testr.New(t),
+		Store:       rules,
+		Querier:     &fakeQuerier{},
+		Notifier:    NewWebhookNotifier(),
+		Watcher:     broadcaster,
+		Interval:    time.Hour,
+		MinInterval: 500 * time.Millisecond,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	go evaluator.Start(ctx)
+
+	write := func() {
+		g.Expect(s.StoreRoles(ctx, []models.Role{{Cluster: "prod", Name: "reader"}})).To(Succeed())
+	}
+
+	// the first write is evaluated straight away
+	g.Eventually(func() bool {
+		write()
+		return rules.evaluations() == 1
+	}, "1s", "10ms").Should(BeTrue())
+
+	// and the writes that follow it within the minimum interval are evaluated once, after it
+	for i := 0; i < 5; i++ {
+		write()
+		time.Sleep(10 * time.Millisecond)
+	}
+	g.Expect(rules.evaluations()).To(Equal(1))
+	g.Eventually(rules.evaluations, "1s", "10ms").Should(Equal(2))
+	g.Consistently(rules.evaluations, "600ms", "50ms").Should(Equal(2))
+}
+
+func TestWebhookNotifier_Alertmanager(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var alerts []alertmanagerAlert
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(r.Header.Get("Content-Type")).To(Equal("application/json"))
+		g.Expect(json.NewDecoder(r.Body).Decode(&alerts)).To(Succeed())
+	}))
+	defer server.Close()
+
+	since := time.Date(2023, 9, 1, 10, 0, 0, 0, time.UTC)
+	err := NewWebhookNotifier().Notify(context.Background(), Notification{
+		Rule: models.AlertRule{
+			ID:            "failing-releases",
+			Name:          "FailingReleases",
+			WebhookURL:    server.URL,
+			WebhookFormat: models.AlertWebhookAlertmanager,
+		},
+		Status:    models.AlertFiring,
+		Firing:    []models.AlertObject{{Cluster: "prod", Namespace: "default", Kind: "HelmRelease", Name: "podinfo", Status: "Failed", Since: since}},
+		Resolved:  []models.AlertObject{{Cluster: "prod", Namespace: "default", Kind: "HelmRelease", Name: "nginx", Status: "Success", Since: since}},
+		Timestamp: since.Add(time.Hour),
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(alerts).To(HaveLen(2))
+	g.Expect(alerts[0].Labels).To(Equal(map[string]string{
+		"alertname": "FailingReleases",
+		"rule_id":   "failing-releases",
+		"cluster":   "prod",
+		"namespace": "default",
+		"kind":      "HelmRelease",
+		"name":      "podinfo",
+	}))
+	g.Expect(alerts[0].StartsAt).To(Equal("2023-09-01T10:00:00Z"))
+	g.Expect(alerts[0].EndsAt).To(BeEmpty())
+	g.Expect(alerts[1].Labels["name"]).To(Equal("nginx"))
+	g.Expect(alerts[1].EndsAt).To(Equal("2023-09-01T11:00:00Z"))
+}
+
+// fakeWebhook records the notifications posted in the json format.
+type fakeWebhook struct {
+	mu            sync.Mutex
+	status        int
+	notifications []jsonNotification
+}
+
+func (f *fakeWebhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.status != 0 && f.status != http.StatusOK {
+		w.WriteHeader(f.status)
+		return
+	}
+
+	n := jsonNotification{}
+	if err := json.NewDecoder(r.Body).Decode(&n); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	f.notifications = append(f.notifications, n)
+}
+
+func (f *fakeWebhook) setStatus(status int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.status = status
+}
+
+func (f *fakeWebhook) received() []jsonNotification {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]jsonNotification{}, f.notifications...)
+}
+
+type fakeQuerier struct {
+	objects   []models.Object
+	principal *auth.UserPrincipal
+	adminErr  error
+}
+
+func (f *fakeQuerier) RequireAdmin(ctx context.Context) error {
+	return f.adminErr
+}
+
+func (f *fakeQuerier) ExportQuery(ctx context.Context, q store.Query, opts store.QueryOption, send func(models.Object) error) error {
+	f.principal = auth.Principal(ctx)
+	for _, obj := range f.objects {
+		if err := send(obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fakeRuleStore keeps the state of its rules like the store does.
+type fakeRuleStore struct {
+	rules []models.AlertRule
+	mu    sync.Mutex
+	reads int
+}
+
+func (f *fakeRuleStore) GetAlertRules(ctx context.Context) ([]models.AlertRule, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.reads++
+	return f.rules, nil
+}
+
+// evaluations returns how many times the rules were evaluated.
+func (f *fakeRuleStore) evaluations() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.reads
+}
+
+func (f *fakeRuleStore) StoreAlertRuleState(ctx context.Context, state models.AlertRuleState) error {
+	for i := range f.rules {
+		if f.rules[i].ID == state.RuleID {
+			f.rules[i].State = state
+		}
+	}
+	return nil
+}
+
+func (f *fakeRuleStore) state() models.AlertRuleState {
+	return f.rules[0].State
+}
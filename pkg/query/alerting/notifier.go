@@ -0,0 +1,159 @@
+package alerting
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+)
+
+const defaultWebhookTimeout = 10 * time.Second
+
+// Notification tells the webhook of an alert rule about the objects it fires for, and the ones
+// it stopped firing for.
+type Notification struct {
+	Rule models.AlertRule
+	// Status is firing while the rule fires for some object, and resolved once it fires for none.
+	Status    models.AlertState
+	Firing    []models.AlertObject
+	Resolved  []models.AlertObject
+	Timestamp time.Time
+}
+
+// Notifier sends notifications to the webhooks of the alert rules.
+type Notifier interface {
+	Notify(ctx context.Context, n Notification) error
+}
+
+// WebhookNotifier posts notifications to the webhook of their rule, in the format of the rule.
+type WebhookNotifier struct {
+	Client *http.Client
+}
+
+func NewWebhookNotifier() *WebhookNotifier {
+	return &WebhookNotifier{
+		Client: &http.Client{Timeout: defaultWebhookTimeout},
+	}
+}
+
+func (w *WebhookNotifier) Notify(ctx context.Context, n Notification) error {
+	var payload interface{}
+	switch n.Rule.WebhookFormat {
+	case models.AlertWebhookAlertmanager:
+		payload = alertmanagerPayload(n)
+	default:
+		payload = jsonPayload(n)
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode notification: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.Rule.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post to webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// alertmanagerAlert is an alert as posted to the Alertmanager API. Alerts without an end time
+// are resolved by Alertmanager when they are not posted again for a while, so firing alerts
+// must be posted regularly.
+type alertmanagerAlert struct {
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations"`
+	StartsAt    string            `json:"startsAt"`
+	EndsAt      string            `json:"endsAt,omitempty"`
+}
+
+func alertmanagerPayload(n Notification) []alertmanagerAlert {
+	alerts := []alertmanagerAlert{}
+
+	toAlert := func(obj models.AlertObject) alertmanagerAlert {
+		return alertmanagerAlert{
+			Labels: map[string]string{
+				"alertname": n.Rule.Name,
+				"rule_id":   n.Rule.ID,
+				"cluster":   obj.Cluster,
+				"namespace": obj.Namespace,
+				"kind":      obj.Kind,
+				"name":      obj.Name,
+			},
+			Annotations: map[string]string{
+				"status":  obj.Status,
+				"message": obj.Message,
+			},
+			StartsAt: obj.Since.UTC().Format(time.RFC3339),
+		}
+	}
+
+	for _, obj := range n.Firing {
+		alerts = append(alerts, toAlert(obj))
+	}
+	for _, obj := range n.Resolved {
+		alert := toAlert(obj)
+		alert.EndsAt = n.Timestamp.UTC().Format(time.RFC3339)
+		alerts = append(alerts, alert)
+	}
+
+	return alerts
+}
+
+type jsonRule struct {
+	ID      string   `json:"id"`
+	Name    string   `json:"name"`
+	Terms   string   `json:"terms"`
+	Filters []string `json:"filters"`
+	For     string   `json:"for"`
+}
+
+type jsonNotification struct {
+	Rule      jsonRule             `json:"rule"`
+	Status    models.AlertState    `json:"status"`
+	Firing    []models.AlertObject `json:"firing"`
+	Resolved  []models.AlertObject `json:"resolved"`
+	Timestamp time.Time            `json:"timestamp"`
+}
+
+func jsonPayload(n Notification) jsonNotification {
+	payload := jsonNotification{
+		Rule: jsonRule{
+			ID:      n.Rule.ID,
+			Name:    n.Rule.Name,
+			Terms:   n.Rule.Terms,
+			Filters: n.Rule.Filters,
+			For:     n.Rule.For.String(),
+		},
+		Status:    n.Status,
+		Firing:    n.Firing,
+		Resolved:  n.Resolved,
+		Timestamp: n.Timestamp.UTC(),
+	}
+	if payload.Firing == nil {
+		payload.Firing = []models.AlertObject{}
+	}
+	if payload.Resolved == nil {
+		payload.Resolved = []models.AlertObject{}
+	}
+
+	return payload
+}
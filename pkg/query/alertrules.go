@@ -0,0 +1,144 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+	store "github.com/weaveworks/weave-gitops-enterprise/pkg/query/store"
+)
+
+var (
+	// ErrAlertRuleNotFound is returned for alert rules that do not exist.
+	ErrAlertRuleNotFound = errors.New("alert rule not found")
+	// ErrInvalidAlertRule is returned when saving an alert rule without a name, a query or a valid webhook.
+	ErrInvalidAlertRule = errors.New("invalid alert rule")
+	// ErrAlertRulesReadOnly is returned when saving an alert rule while alert rules are read-only.
+	ErrAlertRulesReadOnly = errors.New("alert rules cannot be saved")
+)
+
+func (q *qs) ListAlertRules(ctx context.Context) ([]models.AlertRule, error) {
+	if _, _, _, err := q.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	rules, err := q.r.GetAlertRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching alert rules from the store: %w", err)
+	}
+
+	return rules, nil
+}
+
+func (q *qs) GetAlertRule(ctx context.Context, id string) (models.AlertRule, error) {
+	if _, _, _, err := q.requireAdmin(ctx); err != nil {
+		return models.AlertRule{}, err
+	}
+
+	return q.getAlertRule(ctx, id)
+}
+
+func (q *qs) CreateAlertRule(ctx context.Context, rule models.AlertRule) (models.AlertRule, error) {
+	principal, _, _, err := q.requireAdmin(ctx)
+	if err != nil {
+		return models.AlertRule{}, err
+	}
+
+	if q.alertRulesReadOnly || q.w == nil {
+		return models.AlertRule{}, ErrAlertRulesReadOnly
+	}
+
+	now := time.Now().UTC()
+	rule.ID = uuid.NewString()
+	// the query of the rule is run as the admin that created it
+	rule.Owner = principal.ID
+	rule.OwnerGroups = principal.Groups
+	rule.CreatedAt = now
+	rule.UpdatedAt = now
+	rule.State = models.AlertRuleState{}
+
+	if err := q.storeAlertRule(ctx, rule); err != nil {
+		return models.AlertRule{}, err
+	}
+
+	return rule, nil
+}
+
+func (q *qs) UpdateAlertRule(ctx context.Context, rule models.AlertRule) (models.AlertRule, error) {
+	principal, _, _, err := q.requireAdmin(ctx)
+	if err != nil {
+		return models.AlertRule{}, err
+	}
+
+	existing, err := q.getAlertRule(ctx, rule.ID)
+	if err != nil {
+		return models.AlertRule{}, err
+	}
+
+	if q.alertRulesReadOnly || q.w == nil {
+		return models.AlertRule{}, ErrAlertRulesReadOnly
+	}
+
+	existing.Name = rule.Name
+	existing.Terms = rule.Terms
+	existing.Filters = rule.Filters
+	existing.For = rule.For
+	existing.WebhookURL = rule.WebhookURL
+	existing.WebhookFormat = rule.WebhookFormat
+	existing.Owner = principal.ID
+	existing.OwnerGroups = principal.Groups
+	existing.UpdatedAt = time.Now().UTC()
+
+	if err := q.storeAlertRule(ctx, existing); err != nil {
+		return models.AlertRule{}, err
+	}
+
+	return existing, nil
+}
+
+func (q *qs) DeleteAlertRule(ctx context.Context, id string) error {
+	if _, _, _, err := q.requireAdmin(ctx); err != nil {
+		return err
+	}
+
+	if _, err := q.getAlertRule(ctx, id); err != nil {
+		return err
+	}
+
+	if q.w == nil {
+		return fmt.Errorf("alert rules cannot be deleted")
+	}
+
+	if err := q.w.DeleteAlertRule(ctx, id); err != nil {
+		return fmt.Errorf("error deleting alert rule: %w", err)
+	}
+
+	return nil
+}
+
+func (q *qs) getAlertRule(ctx context.Context, id string) (models.AlertRule, error) {
+	rule, err := q.r.GetAlertRule(ctx, id)
+	if err != nil {
+		if errors.Is(err, store.ErrAlertRuleNotFound) {
+			return models.AlertRule{}, ErrAlertRuleNotFound
+		}
+		return models.AlertRule{}, fmt.Errorf("error fetching alert rule from the store: %w", err)
+	}
+
+	return rule, nil
+}
+
+func (q *qs) storeAlertRule(ctx context.Context, rule models.AlertRule) error {
+	if err := rule.Validate(); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidAlertRule, err)
+	}
+
+	if err := q.w.StoreAlertRule(ctx, rule); err != nil {
+		return fmt.Errorf("error storing alert rule: %w", err)
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package cleaner
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -66,6 +67,9 @@ func (oc *objectCleaner) Start() error {
 				if err := oc.removeOldObjects(context.Background()); err != nil {
 					oc.log.Error(err, "could not remove old objects")
 				}
+				if err := oc.removeOldTransitions(context.Background()); err != nil {
+					oc.log.Error(err, "could not remove old object transitions")
+				}
 			case <-stop:
 				return
 			}
@@ -127,12 +131,12 @@ func (oc *objectCleaner) removeOldObjects(ctx context.Context) (retErr error) {
 
 	for _, obj := range all {
 		for i, k := range oc.config {
-			kind := fmt.Sprintf("%s/%s", k.Gvk.GroupVersion().String(), k.Gvk.Kind)
-			gvk := obj.GroupVersionKind()
-			if kind == gvk {
+			// compared field by field, as the core group is empty
+			if k.Gvk.Group == obj.APIGroup && k.Gvk.Version == obj.APIVersion && k.Gvk.Kind == obj.Kind {
 				objKind := oc.config[i]
+				obj.ClusterScoped = objKind.ClusterScoped
 
-				if models.IsExpired(objKind.RetentionPolicy, obj) {
+				if models.IsExpired(retentionPolicy(objKind, obj), obj) {
 					remove := []models.Object{obj}
 
 					if err := oc.store.DeleteObjects(ctx, remove); err != nil {
@@ -149,3 +153,36 @@ func (oc *objectCleaner) removeOldObjects(ctx context.Context) (retErr error) {
 	}
 	return nil
 }
+
+// retentionPolicy returns the retention of a stored object. The longest retention of its kind is used
+// when the object cannot be read back.
+func retentionPolicy(kind configuration.ObjectKind, obj models.Object) configuration.RetentionPolicy {
+	if kind.GetRetentionPolicyFunc == nil || kind.NewClientObjectFunc == nil {
+		return kind.RetentionPolicy
+	}
+
+	clientObj := kind.NewClientObjectFunc()
+	if err := json.Unmarshal(obj.ClientObjectJSON(), clientObj); err != nil {
+		return kind.RetentionPolicy
+	}
+
+	return kind.RetentionPolicyOf(clientObj)
+}
+
+// removeOldTransitions removes the status history of the objects recorded before their retention period.
+// Object kinds without a retention policy keep their whole history, and object kinds retaining their objects
+// for different times keep the history for the longest of them.
+func (oc *objectCleaner) removeOldTransitions(ctx context.Context) error {
+	for _, k := range oc.config {
+		if k.RetentionPolicy == configuration.NoRetentionPolicy {
+			continue
+		}
+
+		before := time.Now().Add(-time.Duration(k.RetentionPolicy))
+		if err := oc.store.DeleteObjectTransitions(ctx, k.Gvk, before); err != nil {
+			return fmt.Errorf("could not delete transitions of %s: %w", k.Gvk.Kind, err)
+		}
+	}
+
+	return nil
+}
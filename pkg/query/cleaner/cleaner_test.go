@@ -2,6 +2,7 @@ package cleaner
 
 import (
 	"context"
+	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -15,6 +16,8 @@ import (
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/configuration"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/store/storefakes"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestObjectCleaner(t *testing.T) {
@@ -77,6 +80,79 @@ func TestObjectCleaner(t *testing.T) {
 	g.Expect(s.DeleteObjectsCallCount()).To(Equal(1))
 }
 
+func TestObjectCleaner_RetentionPolicyOfObjects(t *testing.T) {
+	g := NewWithT(t)
+	s := storefakes.FakeStore{}
+
+	cfg := configuration.EventsObjectKind(configuration.EventFilter{}, configuration.RetentionPolicy(time.Hour))
+
+	event := func(name string, labels map[string]string, component string) models.Object {
+		e := &corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels},
+			Source:     corev1.EventSource{Component: component},
+		}
+		raw, err := json.Marshal(models.NewNormalizedObject(e, cfg))
+		g.Expect(err).NotTo(HaveOccurred())
+
+		return models.Object{
+			Cluster:    "cluster1",
+			Namespace:  "default",
+			Kind:       cfg.Gvk.Kind,
+			Name:       name,
+			APIGroup:   cfg.Gvk.Group,
+			APIVersion: cfg.Gvk.Version,
+			// Deleted 2 hours ago, after the retention of events but before the one of audit events.
+			KubernetesDeletedAt: time.Now().Add(-2 * time.Hour),
+			Unstructured:        raw,
+		}
+	}
+
+	objs := []models.Object{
+		event("backoff", nil, "kubelet"),
+		event("audit", map[string]string{"pac.weave.works/type": "Audit"}, "policy-agent"),
+	}
+
+	iter := storefakes.FakeIterator{}
+	iter.AllReturns(objs, nil)
+	s.GetAllObjectsReturns(&iter, nil)
+
+	oc := objectCleaner{
+		log:    logr.Discard(),
+		store:  &s,
+		idx:    &storefakes.FakeIndexWriter{},
+		config: []configuration.ObjectKind{cfg},
+	}
+
+	g.Expect(oc.removeOldObjects(context.Background())).To(Succeed())
+
+	g.Expect(s.DeleteObjectsCallCount()).To(Equal(1))
+	_, result := s.DeleteObjectsArgsForCall(0)
+	g.Expect(result).To(Equal([]models.Object{objs[0]}))
+}
+
+func TestObjectCleaner_Transitions(t *testing.T) {
+	g := NewWithT(t)
+	s := storefakes.FakeStore{}
+
+	withRetention := configuration.BucketObjectKind
+	withRetention.RetentionPolicy = configuration.RetentionPolicy(time.Hour)
+
+	oc := objectCleaner{
+		log:    logr.Discard(),
+		store:  &s,
+		idx:    &storefakes.FakeIndexWriter{},
+		config: []configuration.ObjectKind{withRetention, configuration.KustomizationObjectKind},
+	}
+
+	g.Expect(oc.removeOldTransitions(context.Background())).To(Succeed())
+
+	// only kinds with a retention policy forget their history
+	g.Expect(s.DeleteObjectTransitionsCallCount()).To(Equal(1))
+	_, gvk, before := s.DeleteObjectTransitionsArgsForCall(0)
+	g.Expect(gvk).To(Equal(withRetention.Gvk))
+	g.Expect(before).To(BeTemporally("~", time.Now().Add(-time.Hour), time.Minute))
+}
+
 func TestObjectCleanerMetrics(t *testing.T) {
 	g := NewWithT(t)
 	s := storefakes.FakeStore{}
@@ -21,6 +21,16 @@ type Subscriber interface {
 	GetClusters() []cluster.Cluster
 }
 
+//counterfeiter:generate . ShardedSubscriber
+
+// ShardedSubscriber spreads the clusters over the replicas collecting them. Its subscriptions
+// remove the clusters handed over to another replica, whose records are kept for that replica.
+type ShardedSubscriber interface {
+	Subscriber
+	// Owns returns whether the cluster is collected by this replica.
+	Owns(clusterName string) bool
+}
+
 type ClustersManagerAsSubscriber struct {
 	clustersmngr.ClustersManager
 }
@@ -3,6 +3,7 @@ package collector
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
 	"k8s.io/client-go/rest"
@@ -16,6 +17,8 @@ const (
 	ClusterWatchingStopped  = "stopped"
 	ClusterWatchingFailed   = "failed"
 	ClusterWatchingErrored  = "error"
+	// ClusterWatchingBackoff is the status of a watcher that failed and waits to be started again.
+	ClusterWatchingBackoff = "backoff"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
@@ -24,6 +27,25 @@ const (
 type ClusterWatcher interface {
 	// Status return the watcher status for the cluster identified as clusterName.
 	Status(clusterName string) (string, error)
+	// ClusterStatuses returns the status of the watchers of the clusters, sorted by cluster name.
+	ClusterStatuses(ctx context.Context) ([]ClusterStatus, error)
+}
+
+// ClusterStatus is the status of the watcher of a cluster, and of the objects it collected.
+type ClusterStatus struct {
+	Collector   string
+	ClusterName string
+	Status      string
+	// LastSyncedAt is when the watcher last synced the objects of the cluster. It is zero if it never has.
+	LastSyncedAt time.Time
+	// Error is why the watcher last failed, until it syncs again.
+	Error string
+	// ObjectCount is the number of objects collected from the cluster.
+	ObjectCount int
+	// Retries is the number of times in a row the watcher failed.
+	Retries int
+	// NextRetryAt is when a failed watcher is started again.
+	NextRetryAt time.Time
 }
 
 // Starter is the expected return value of NewWatcherFunc.
@@ -34,6 +56,9 @@ type Starter interface {
 // Function to create a watcher for a set of kinds. Operations target an store.
 type NewWatcherFunc = func(clusterName string, config *rest.Config) (Starter, error)
 
+// CountObjectsFunc returns the number of collected objects of each cluster.
+type CountObjectsFunc = func(ctx context.Context) (map[string]int, error)
+
 // StopWatcherFunc represents a hook to call when a watcher is
 // stopped. This is used, for instance, to delete the records of a
 // cluster that goes away.
@@ -59,7 +84,9 @@ type CollectorOpts struct {
 	Clusters        clusters.Subscriber
 	NewWatcherFunc  NewWatcherFunc
 	StopWatcherFunc StopWatcherFunc
-	ServiceAccount  ImpersonateServiceAccount // this gives the service account to impersonate when watching each cluster
+	// CountObjectsFunc counts the collected objects for the cluster statuses. Counts are zero without it.
+	CountObjectsFunc CountObjectsFunc
+	ServiceAccount   ImpersonateServiceAccount // this gives the service account to impersonate when watching each cluster
 }
 
 func (o *CollectorOpts) Validate() error {
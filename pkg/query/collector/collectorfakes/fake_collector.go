@@ -9,6 +9,19 @@ import (
 )
 
 type FakeCollector struct {
+	ClusterStatusesStub        func(context.Context) ([]collector.ClusterStatus, error)
+	clusterStatusesMutex       sync.RWMutex
+	clusterStatusesArgsForCall []struct {
+		arg1 context.Context
+	}
+	clusterStatusesReturns struct {
+		result1 []collector.ClusterStatus
+		result2 error
+	}
+	clusterStatusesReturnsOnCall map[int]struct {
+		result1 []collector.ClusterStatus
+		result2 error
+	}
 	StartStub        func(context.Context) error
 	startMutex       sync.RWMutex
 	startArgsForCall []struct {
@@ -37,6 +50,70 @@ type FakeCollector struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeCollector) ClusterStatuses(arg1 context.Context) ([]collector.ClusterStatus, error) {
+	fake.clusterStatusesMutex.Lock()
+	ret, specificReturn := fake.clusterStatusesReturnsOnCall[len(fake.clusterStatusesArgsForCall)]
+	fake.clusterStatusesArgsForCall = append(fake.clusterStatusesArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ClusterStatusesStub
+	fakeReturns := fake.clusterStatusesReturns
+	fake.recordInvocation("ClusterStatuses", []interface{}{arg1})
+	fake.clusterStatusesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCollector) ClusterStatusesCallCount() int {
+	fake.clusterStatusesMutex.RLock()
+	defer fake.clusterStatusesMutex.RUnlock()
+	return len(fake.clusterStatusesArgsForCall)
+}
+
+func (fake *FakeCollector) ClusterStatusesCalls(stub func(context.Context) ([]collector.ClusterStatus, error)) {
+	fake.clusterStatusesMutex.Lock()
+	defer fake.clusterStatusesMutex.Unlock()
+	fake.ClusterStatusesStub = stub
+}
+
+func (fake *FakeCollector) ClusterStatusesArgsForCall(i int) context.Context {
+	fake.clusterStatusesMutex.RLock()
+	defer fake.clusterStatusesMutex.RUnlock()
+	argsForCall := fake.clusterStatusesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCollector) ClusterStatusesReturns(result1 []collector.ClusterStatus, result2 error) {
+	fake.clusterStatusesMutex.Lock()
+	defer fake.clusterStatusesMutex.Unlock()
+	fake.ClusterStatusesStub = nil
+	fake.clusterStatusesReturns = struct {
+		result1 []collector.ClusterStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCollector) ClusterStatusesReturnsOnCall(i int, result1 []collector.ClusterStatus, result2 error) {
+	fake.clusterStatusesMutex.Lock()
+	defer fake.clusterStatusesMutex.Unlock()
+	fake.ClusterStatusesStub = nil
+	if fake.clusterStatusesReturnsOnCall == nil {
+		fake.clusterStatusesReturnsOnCall = make(map[int]struct {
+			result1 []collector.ClusterStatus
+			result2 error
+		})
+	}
+	fake.clusterStatusesReturnsOnCall[i] = struct {
+		result1 []collector.ClusterStatus
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCollector) Start(arg1 context.Context) error {
 	fake.startMutex.Lock()
 	ret, specificReturn := fake.startReturnsOnCall[len(fake.startArgsForCall)]
@@ -165,6 +242,8 @@ func (fake *FakeCollector) StatusReturnsOnCall(i int, result1 string, result2 er
 func (fake *FakeCollector) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.clusterStatusesMutex.RLock()
+	defer fake.clusterStatusesMutex.RUnlock()
 	fake.startMutex.RLock()
 	defer fake.startMutex.RUnlock()
 	fake.statusMutex.RLock()
@@ -9,6 +9,7 @@ import (
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
 	"github.com/weaveworks/weave-gitops/core/logger"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -29,6 +30,9 @@ var kindsWithoutFinalizers = map[string]bool{
 	"RoleBinding":               true,
 	"Role":                      true,
 	"AutomatedClusterDiscovery": true,
+	"Pipeline":                  true,
+	"Policy":                    true,
+	"PolicyConfig":              true,
 }
 
 func NewReconciler(clusterName string, objectKind configuration.ObjectKind, client client.Client, process ProcessFunc, log logr.Logger) (Reconciler, error) {
@@ -81,7 +85,12 @@ func (r *GenericReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		// Thus, if a `NotFound` error is returned for an object whose Kind is included
 		// in the list of known `Kinds` of objects, which do not have finalizers by default,
 		// we can infer that the object was deleted.
+		// User-defined kinds are collected as unstructured objects and we cannot know whether they have finalizers,
+		// so they are treated the same way: deleting an object that was already removed is a no-op.
 		_, ok := kindsWithoutFinalizers[r.objectKind.Gvk.Kind]
+		if _, isUnstructured := clientObject.(*unstructured.Unstructured); isUnstructured {
+			ok = true
+		}
 
 		if !errors.IsNotFound(err) || !ok {
 			return ctrl.Result{}, client.IgnoreNotFound(err)
@@ -95,7 +104,7 @@ func (r *GenericReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 			clusterName:     r.clusterName,
 			object:          clientObject,
 			transactionType: models.TransactionTypeDelete,
-			retentionPolicy: r.objectKind.RetentionPolicy,
+			retentionPolicy: r.objectKind.RetentionPolicyOf(clientObject),
 			config:          r.objectKind,
 		}
 
@@ -122,7 +131,7 @@ func (r *GenericReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		clusterName:     r.clusterName,
 		object:          clientObject,
 		transactionType: txType,
-		retentionPolicy: r.objectKind.RetentionPolicy,
+		retentionPolicy: r.objectKind.RetentionPolicyOf(clientObject),
 		config:          r.objectKind,
 	}
 
@@ -0,0 +1,69 @@
+package sharding
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"sort"
+	"strconv"
+)
+
+// virtualNodes is the number of points of each member on the ring, so keys are spread evenly.
+const virtualNodes = 128
+
+// Ring spreads keys over members by consistent hashing: when a member joins or leaves,
+// only the keys it gets or had move to another member.
+type Ring struct {
+	members []string
+	points  []uint64
+	owners  map[uint64]string
+}
+
+// NewRing returns a ring with the given members.
+func NewRing(members []string) *Ring {
+	r := &Ring{
+		members: append([]string{}, members...),
+		owners:  map[uint64]string{},
+	}
+	sort.Strings(r.members)
+
+	for _, member := range r.members {
+		for i := 0; i < virtualNodes; i++ {
+			point := hash(member + "#" + strconv.Itoa(i))
+			r.points = append(r.points, point)
+			r.owners[point] = member
+		}
+	}
+	sort.Slice(r.points, func(i, j int) bool {
+		return r.points[i] < r.points[j]
+	})
+
+	return r
+}
+
+// Members returns the sorted members of the ring.
+func (r *Ring) Members() []string {
+	return r.members
+}
+
+// Owner returns the member that the key belongs to, or an empty string when the ring has no members.
+func (r *Ring) Owner(key string) string {
+	if len(r.points) == 0 {
+		return ""
+	}
+
+	h := hash(key)
+	i := sort.Search(len(r.points), func(i int) bool {
+		return r.points[i] >= h
+	})
+	if i == len(r.points) {
+		i = 0
+	}
+
+	return r.owners[r.points[i]]
+}
+
+// hash spreads similar keys, like the names of clusters, evenly over the ring.
+func hash(key string) uint64 {
+	sum := sha256.Sum256([]byte(key))
+	return binary.BigEndian.Uint64(sum[:8])
+}
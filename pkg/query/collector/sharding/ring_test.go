@@ -0,0 +1,47 @@
+package sharding
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestRing(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	keys := []string{}
+	for i := 0; i < 1000; i++ {
+		keys = append(keys, fmt.Sprintf("cluster-%d", i))
+	}
+
+	t.Run("has no owner without members", func(t *testing.T) {
+		g.Expect(NewRing(nil).Owner("cluster-0")).To(BeEmpty())
+	})
+
+	t.Run("spreads keys over members", func(t *testing.T) {
+		ring := NewRing([]string{"replica-c", "replica-a", "replica-b"})
+		g.Expect(ring.Members()).To(Equal([]string{"replica-a", "replica-b", "replica-c"}))
+
+		counts := map[string]int{}
+		for _, key := range keys {
+			counts[ring.Owner(key)]++
+		}
+
+		g.Expect(counts).To(HaveLen(3))
+		for _, count := range counts {
+			g.Expect(count).To(BeNumerically(">", 200))
+		}
+	})
+
+	t.Run("only moves the keys of a member that leaves", func(t *testing.T) {
+		before := NewRing([]string{"replica-a", "replica-b", "replica-c"})
+		after := NewRing([]string{"replica-a", "replica-c"})
+
+		for _, key := range keys {
+			if owner := before.Owner(key); owner != "replica-b" {
+				g.Expect(after.Owner(key)).To(Equal(owner))
+			}
+		}
+	})
+}
@@ -0,0 +1,417 @@
+package sharding
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	coordinationv1client "k8s.io/client-go/kubernetes/typed/coordination/v1"
+
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/collector/clusters"
+)
+
+const (
+	// ShardLabel labels the leases of the replicas collecting clusters.
+	ShardLabel = "explorer.weave.works/collector"
+
+	leasePrefix          = "explorer-collector-"
+	defaultLeaseDuration = 30 * time.Second
+	defaultRenewPeriod   = 10 * time.Second
+)
+
+type SharderOpts struct {
+	Log logr.Logger
+	// Clusters are the clusters to spread over the replicas.
+	Clusters clusters.Subscriber
+	// Leases manages the leases of the replicas in the given namespace.
+	Leases    coordinationv1client.LeasesGetter
+	Namespace string
+	// Identity is the name of the replica, like its pod name. Defaults to the hostname.
+	Identity string
+	// LeaseDuration is how long a replica that stopped renewing its lease keeps its clusters.
+	LeaseDuration time.Duration
+	RenewPeriod   time.Duration
+}
+
+func (o *SharderOpts) Validate() error {
+	if o.Clusters == nil {
+		return fmt.Errorf("invalid cluster subscriber")
+	}
+	if o.Leases == nil {
+		return fmt.Errorf("leases client must be supplied")
+	}
+	if o.Namespace == "" {
+		return fmt.Errorf("leases namespace cannot be empty")
+	}
+	if o.RenewPeriod >= o.LeaseDuration {
+		return fmt.Errorf("renew period must be shorter than the lease duration")
+	}
+	return nil
+}
+
+// Sharder spreads the clusters over the replicas that collect them, so each cluster is collected
+// by one replica. Replicas hold a lease while they are alive, and the clusters are spread over the
+// replicas holding one by consistent hashing: when a replica joins or dies, only its clusters move.
+type Sharder struct {
+	log           logr.Logger
+	clusters      clusters.Subscriber
+	leases        coordinationv1client.LeaseInterface
+	identity      string
+	leaseDuration time.Duration
+	renewPeriod   time.Duration
+	lastRenew     time.Time
+
+	mu            sync.RWMutex
+	ring          *Ring
+	subscriptions map[*subscription]struct{}
+}
+
+var _ clusters.ShardedSubscriber = &Sharder{}
+
+func NewSharder(opts SharderOpts) (*Sharder, error) {
+	if opts.Identity == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, fmt.Errorf("cannot get identity: %w", err)
+		}
+		opts.Identity = hostname
+	}
+	if opts.LeaseDuration == 0 {
+		opts.LeaseDuration = defaultLeaseDuration
+	}
+	if opts.RenewPeriod == 0 {
+		opts.RenewPeriod = defaultRenewPeriod
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid sharder options: %w", err)
+	}
+
+	return &Sharder{
+		log:           opts.Log.WithName("sharder"),
+		clusters:      opts.Clusters,
+		leases:        opts.Leases.Leases(opts.Namespace),
+		identity:      opts.Identity,
+		leaseDuration: opts.LeaseDuration,
+		renewPeriod:   opts.RenewPeriod,
+		ring:          NewRing(nil),
+		subscriptions: map[*subscription]struct{}{},
+	}, nil
+}
+
+// Start renews the lease of the replica and follows the other replicas until the context is done.
+// The lease is then released, so the other replicas take the clusters over without waiting for it to expire.
+func (s *Sharder) Start(ctx context.Context) error {
+	ticker := time.NewTicker(s.renewPeriod)
+	defer ticker.Stop()
+
+	for {
+		s.heartbeat(ctx)
+
+		select {
+		case <-ctx.Done():
+			s.release()
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Owns returns whether the cluster is collected by this replica. No cluster is owned until
+// the replica holds a lease.
+func (s *Sharder) Owns(clusterName string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.ring.Owner(clusterName) == s.identity
+}
+
+// Identity returns the name of this replica.
+func (s *Sharder) Identity() string {
+	return s.identity
+}
+
+// Owners returns the replica collecting each cluster, by cluster name. Clusters are not
+// collected by any replica until one holds a lease.
+func (s *Sharder) Owners() map[string]string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	owners := map[string]string{}
+	for _, c := range s.clusters.GetClusters() {
+		owners[c.GetName()] = s.ring.Owner(c.GetName())
+	}
+	return owners
+}
+
+// GetClusters returns the clusters collected by this replica.
+func (s *Sharder) GetClusters() []cluster.Cluster {
+	owned := []cluster.Cluster{}
+	for _, c := range s.clusters.GetClusters() {
+		if s.Owns(c.GetName()) {
+			owned = append(owned, c)
+		}
+	}
+	return owned
+}
+
+// Subscribe returns the updates of the clusters collected by this replica, including the clusters
+// it takes over from or hands over to other replicas.
+func (s *Sharder) Subscribe() clusters.Subscription {
+	sub := &subscription{
+		sharder:   s,
+		upstream:  s.clusters.Subscribe(),
+		updates:   make(chan clustersmngr.ClusterListUpdate),
+		resharded: make(chan struct{}, 1),
+		done:      make(chan struct{}),
+		owned:     map[string]bool{},
+	}
+	for _, c := range s.GetClusters() {
+		sub.owned[c.GetName()] = true
+	}
+
+	s.mu.Lock()
+	s.subscriptions[sub] = struct{}{}
+	s.mu.Unlock()
+
+	go sub.run()
+
+	return sub
+}
+
+func (s *Sharder) heartbeat(ctx context.Context) {
+	members, err := s.renew(ctx)
+	if err != nil {
+		s.log.Error(err, "cannot renew lease")
+
+		// The other replicas take the clusters over once the lease has expired.
+		if time.Since(s.lastRenew) < s.leaseDuration {
+			return
+		}
+		members = nil
+	} else {
+		s.lastRenew = time.Now()
+	}
+
+	s.setMembers(members)
+}
+
+// renew renews the lease of the replica and returns the replicas holding a lease.
+func (s *Sharder) renew(ctx context.Context) ([]string, error) {
+	now := metav1.NewMicroTime(time.Now())
+	duration := int32(s.leaseDuration.Seconds())
+
+	lease, err := s.leases.Get(ctx, leasePrefix+s.identity, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		lease = &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   leasePrefix + s.identity,
+				Labels: map[string]string{ShardLabel: "true"},
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       &s.identity,
+				LeaseDurationSeconds: &duration,
+				AcquireTime:          &now,
+				RenewTime:            &now,
+			},
+		}
+		if _, err := s.leases.Create(ctx, lease, metav1.CreateOptions{}); err != nil {
+			return nil, fmt.Errorf("failed to create lease: %w", err)
+		}
+	case err != nil:
+		return nil, fmt.Errorf("failed to get lease: %w", err)
+	default:
+		lease.Spec.HolderIdentity = &s.identity
+		lease.Spec.LeaseDurationSeconds = &duration
+		lease.Spec.RenewTime = &now
+		if _, err := s.leases.Update(ctx, lease, metav1.UpdateOptions{}); err != nil {
+			return nil, fmt.Errorf("failed to update lease: %w", err)
+		}
+	}
+
+	list, err := s.leases.List(ctx, metav1.ListOptions{LabelSelector: ShardLabel})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list leases: %w", err)
+	}
+
+	members := []string{}
+	for _, l := range list.Items {
+		if held(l, now.Time) {
+			members = append(members, *l.Spec.HolderIdentity)
+		}
+	}
+
+	return members, nil
+}
+
+// release deletes the lease of the replica.
+func (s *Sharder) release() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := s.leases.Delete(ctx, leasePrefix+s.identity, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		s.log.Error(err, "cannot release lease")
+	}
+}
+
+// setMembers spreads the clusters over the given replicas, and lets the subscriptions
+// know when the clusters of this replica may have changed.
+func (s *Sharder) setMembers(members []string) {
+	sort.Strings(members)
+
+	s.mu.Lock()
+	if equal(s.ring.Members(), members) {
+		s.mu.Unlock()
+		return
+	}
+	s.ring = NewRing(members)
+
+	subscriptions := []*subscription{}
+	for sub := range s.subscriptions {
+		subscriptions = append(subscriptions, sub)
+	}
+	s.mu.Unlock()
+
+	s.log.Info("collector replicas changed", "replicas", members)
+
+	for _, sub := range subscriptions {
+		sub.reshard()
+	}
+}
+
+func (s *Sharder) unsubscribe(sub *subscription) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.subscriptions, sub)
+}
+
+// held returns whether the lease is held by a replica at the given time.
+func held(lease coordinationv1.Lease, now time.Time) bool {
+	spec := lease.Spec
+	if spec.HolderIdentity == nil || spec.RenewTime == nil || spec.LeaseDurationSeconds == nil {
+		return false
+	}
+
+	expiry := spec.RenewTime.Add(time.Duration(*spec.LeaseDurationSeconds) * time.Second)
+	return now.Before(expiry)
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// subscription filters the updates of the clusters to the ones collected by the replica, and
+// adds or removes the clusters taken over from or handed over to other replicas.
+type subscription struct {
+	sharder   *Sharder
+	upstream  clusters.Subscription
+	updates   chan clustersmngr.ClusterListUpdate
+	resharded chan struct{}
+	done      chan struct{}
+	once      sync.Once
+	// owned are the names of the clusters collected by the replica. It is only used by run.
+	owned map[string]bool
+}
+
+func (sub *subscription) Updates() chan clustersmngr.ClusterListUpdate {
+	return sub.updates
+}
+
+func (sub *subscription) Unsubscribe() {
+	sub.once.Do(func() {
+		close(sub.done)
+		sub.upstream.Unsubscribe()
+		sub.sharder.unsubscribe(sub)
+	})
+}
+
+// reshard lets the subscription know that the clusters of the replica may have changed.
+func (sub *subscription) reshard() {
+	select {
+	case sub.resharded <- struct{}{}:
+	default:
+	}
+}
+
+func (sub *subscription) run() {
+	for {
+		select {
+		case <-sub.done:
+			return
+		case update, ok := <-sub.upstream.Updates():
+			if !ok {
+				return
+			}
+			sub.send(sub.filter(update))
+		case <-sub.resharded:
+			sub.send(sub.handOver())
+		}
+	}
+}
+
+// filter keeps the updates of the clusters collected by the replica.
+func (sub *subscription) filter(update clustersmngr.ClusterListUpdate) clustersmngr.ClusterListUpdate {
+	filtered := clustersmngr.ClusterListUpdate{}
+
+	for _, c := range update.Added {
+		if sub.sharder.Owns(c.GetName()) {
+			sub.owned[c.GetName()] = true
+			filtered.Added = append(filtered.Added, c)
+		}
+	}
+	for _, c := range update.Removed {
+		if sub.owned[c.GetName()] {
+			delete(sub.owned, c.GetName())
+			filtered.Removed = append(filtered.Removed, c)
+		}
+	}
+
+	return filtered
+}
+
+// handOver adds the clusters taken over from other replicas, and removes the ones handed over to them.
+func (sub *subscription) handOver() clustersmngr.ClusterListUpdate {
+	update := clustersmngr.ClusterListUpdate{}
+
+	for _, c := range sub.sharder.clusters.GetClusters() {
+		owns := sub.sharder.Owns(c.GetName())
+		switch {
+		case owns && !sub.owned[c.GetName()]:
+			sub.owned[c.GetName()] = true
+			update.Added = append(update.Added, c)
+		case !owns && sub.owned[c.GetName()]:
+			delete(sub.owned, c.GetName())
+			update.Removed = append(update.Removed, c)
+		}
+	}
+
+	return update
+}
+
+func (sub *subscription) send(update clustersmngr.ClusterListUpdate) {
+	if len(update.Added) == 0 && len(update.Removed) == 0 {
+		return
+	}
+
+	select {
+	case sub.updates <- update:
+	case <-sub.done:
+	}
+}
@@ -0,0 +1,133 @@
+package sharding
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster/clusterfakes"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/collector/clusters"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/collector/clusters/clustersfakes"
+)
+
+func TestSharder(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	all := []cluster.Cluster{}
+	for i := 0; i < 20; i++ {
+		c := &clusterfakes.FakeCluster{}
+		c.GetNameReturns(fmt.Sprintf("cluster-%d", i))
+		all = append(all, c)
+	}
+	subscriber := &clustersfakes.FakeSubscriber{}
+	subscriber.GetClustersReturns(all)
+	subscriber.SubscribeCalls(func() clusters.Subscription {
+		sub := &clustersfakes.FakeSubscription{}
+		sub.UpdatesReturns(make(chan clustersmngr.ClusterListUpdate))
+		return sub
+	})
+
+	leases := fakeclientset.NewSimpleClientset().CoordinationV1()
+	newSharder := func(identity string) *Sharder {
+		s, err := NewSharder(SharderOpts{
+			Log:       testr.New(t),
+			Clusters:  subscriber,
+			Leases:    leases,
+			Namespace: "flux-system",
+			Identity:  identity,
+		})
+		g.Expect(err).NotTo(HaveOccurred())
+		return s
+	}
+	names := func(clusters []cluster.Cluster) []string {
+		names := []string{}
+		for _, c := range clusters {
+			names = append(names, c.GetName())
+		}
+		return names
+	}
+
+	a, b := newSharder("replica-a"), newSharder("replica-b")
+
+	t.Run("no cluster is owned without a lease", func(t *testing.T) {
+		g.Expect(a.GetClusters()).To(BeEmpty())
+	})
+
+	a.heartbeat(ctx)
+	b.heartbeat(ctx)
+	a.heartbeat(ctx)
+
+	t.Run("clusters are spread over the replicas", func(t *testing.T) {
+		ownedByA, ownedByB := names(a.GetClusters()), names(b.GetClusters())
+		g.Expect(ownedByA).NotTo(BeEmpty())
+		g.Expect(ownedByB).NotTo(BeEmpty())
+		g.Expect(append(ownedByA, ownedByB...)).To(ConsistOf(names(all)))
+	})
+
+	t.Run("every replica tells the owner of every cluster", func(t *testing.T) {
+		owners := a.Owners()
+		g.Expect(owners).To(HaveLen(len(all)))
+		g.Expect(b.Owners()).To(Equal(owners))
+		for _, name := range names(b.GetClusters()) {
+			g.Expect(owners).To(HaveKeyWithValue(name, "replica-b"))
+		}
+	})
+
+	t.Run("expired leases are not replicas", func(t *testing.T) {
+		expired := metav1.NewMicroTime(time.Now().Add(-time.Minute))
+		identity, duration := "replica-c", int32(30)
+		_, err := leases.Leases("flux-system").Create(ctx, &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   leasePrefix + identity,
+				Labels: map[string]string{ShardLabel: "true"},
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       &identity,
+				LeaseDurationSeconds: &duration,
+				RenewTime:            &expired,
+			},
+		}, metav1.CreateOptions{})
+		g.Expect(err).NotTo(HaveOccurred())
+
+		a.heartbeat(ctx)
+		g.Expect(a.ring.Members()).To(Equal([]string{"replica-a", "replica-b"}))
+	})
+
+	t.Run("clusters of a replica that left are taken over", func(t *testing.T) {
+		sub := a.Subscribe()
+		defer sub.Unsubscribe()
+		ownedByB := names(b.GetClusters())
+
+		b.release()
+		a.heartbeat(ctx)
+
+		var update clustersmngr.ClusterListUpdate
+		g.Eventually(sub.Updates(), "2s").Should(Receive(&update))
+		g.Expect(names(update.Added)).To(ConsistOf(ownedByB))
+		g.Expect(update.Removed).To(BeEmpty())
+		g.Expect(a.GetClusters()).To(HaveLen(len(all)))
+	})
+
+	t.Run("clusters are handed over to a replica that joins", func(t *testing.T) {
+		sub := a.Subscribe()
+		defer sub.Unsubscribe()
+
+		b.heartbeat(ctx)
+		a.heartbeat(ctx)
+
+		var update clustersmngr.ClusterListUpdate
+		g.Eventually(sub.Updates(), "2s").Should(Receive(&update))
+		g.Expect(update.Added).To(BeEmpty())
+		g.Expect(names(update.Removed)).To(ConsistOf(names(b.GetClusters())))
+	})
+}
@@ -3,6 +3,7 @@ package collector
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -13,6 +14,13 @@ import (
 	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+)
+
+const (
+	// retryBaseDelay and retryMaxDelay bound the exponential backoff of the failed watchers.
+	retryBaseDelay = 500 * time.Millisecond
+	retryMaxDelay  = 5 * time.Minute
 )
 
 // Start the collector by creating watchers on existing gitops clusters and managing its lifecycle. Managing
@@ -21,9 +29,9 @@ import (
 func (c *watchingCollector) Start(ctx context.Context) error {
 	c.sub = c.subscriber.Subscribe()
 
-	ratelimiter := workqueue.DefaultControllerRateLimiter() // TODO make a bespoke one, this retries too fast
-	// TODO: check the queue methods work with Cluster, since we get new values each time.
-	c.queue = workqueue.NewNamedRateLimitingQueue(ratelimiter, "collector-"+c.name)
+	// The queue only delays the retries. Failures are tracked by cluster name by the backoff, rather than by
+	// cluster by the queue, since we get new values each time.
+	c.queue = workqueue.NewNamedDelayingQueue("collector-" + c.name)
 
 	for _, cluster := range c.subscriber.GetClusters() {
 		c.queue.Add(cluster)
@@ -40,14 +48,17 @@ func (c *watchingCollector) Start(ctx context.Context) error {
 			}
 			cluster, ok := obj.(cluster.Cluster)
 			if !ok { // not a cluster; skip it
-				c.queue.Forget(obj) // tell the rate limiter not to track it
-				c.queue.Done(obj)   // dequeue it
+				c.queue.Done(obj) // dequeue it
 				continue
 			}
 			c.queue.Done(obj)
+			if !c.owns(cluster.GetName()) { // collected by another replica
+				c.backoff.Forget(cluster.GetName())
+				continue
+			}
 			if err := c.watch(cluster); err != nil {
 				c.log.Error(err, "cannot watch cluster", "cluster", cluster.GetName())
-				c.queue.AddRateLimited(cluster)
+				c.retry(cluster, err)
 				continue
 			}
 		}
@@ -66,11 +77,15 @@ outer:
 			// Do unwatches straight away; there's no reason to go
 			// through the queue on these.
 			for _, cluster := range updates.Removed {
-				// remove from the queue (Done) and rate limiter
+				// remove from the queue (Done) and the backoff
 				// (Forget). If it comes back around, we'll start
 				// afresh.
 				c.queue.Done(cluster)
-				c.queue.Forget(cluster)
+				c.backoff.Forget(cluster.GetName())
+				if !c.owns(cluster.GetName()) {
+					c.handOver(cluster.GetName())
+					continue
+				}
 				err := c.unwatch(cluster.GetName())
 				if err != nil {
 					c.log.Error(err, "cannot unwatch cluster", "cluster", cluster.GetName())
@@ -95,6 +110,10 @@ type child struct {
 	collector        string
 	status           string
 	lastStatusChange time.Time
+	lastSyncedAt     time.Time
+	err              string
+	retries          int
+	nextRetryAt      time.Time
 }
 
 // setStatus sets watcher status and records it as a metric.
@@ -121,11 +140,18 @@ type watchingCollector struct {
 	clusterWatchersMu sync.Mutex
 	newWatcherFunc    NewWatcherFunc
 	stopWatcherFunc   StopWatcherFunc
-	queue             workqueue.RateLimitingInterface
+	countObjectsFunc  CountObjectsFunc
+	queue             workqueue.DelayingInterface
+	backoff           workqueue.RateLimiter
 	log               logr.Logger
 	sa                ImpersonateServiceAccount
 }
 
+// cacheSyncer is implemented by the watchers that cache the objects of their cluster, like controller-runtime managers.
+type cacheSyncer interface {
+	GetCache() cache.Cache
+}
+
 // Collector factory method. It creates a collection with clusterName watching strategy by default.
 func newWatchingCollector(opts CollectorOpts) (*watchingCollector, error) {
 	if opts.StopWatcherFunc == nil {
@@ -134,13 +160,15 @@ func newWatchingCollector(opts CollectorOpts) (*watchingCollector, error) {
 		}
 	}
 	return &watchingCollector{
-		name:            opts.Name,
-		subscriber:      opts.Clusters,
-		clusterWatchers: make(map[string]*child),
-		newWatcherFunc:  opts.NewWatcherFunc,
-		stopWatcherFunc: opts.StopWatcherFunc,
-		log:             opts.Log,
-		sa:              opts.ServiceAccount,
+		name:             opts.Name,
+		subscriber:       opts.Clusters,
+		clusterWatchers:  make(map[string]*child),
+		newWatcherFunc:   opts.NewWatcherFunc,
+		stopWatcherFunc:  opts.StopWatcherFunc,
+		countObjectsFunc: opts.CountObjectsFunc,
+		backoff:          workqueue.NewItemExponentialFailureRateLimiter(retryBaseDelay, retryMaxDelay),
+		log:              opts.Log,
+		sa:               opts.ServiceAccount,
 	}, nil
 }
 
@@ -154,13 +182,13 @@ func (w *watchingCollector) watch(cluster cluster.Cluster) (retErr error) {
 	c := &child{
 		collector: w.name,
 	}
-	c.setStatus(ClusterWatchingStarting)
 	childctx, cancel := context.WithCancel(context.Background())
 	c.cancel = cancel
 	defer func() {
 		if retErr != nil {
 			w.clusterWatchersMu.Lock()
 			c.setStatus(ClusterWatchingFailed)
+			c.err = retErr.Error()
 			cancel := c.cancel
 			w.clusterWatchersMu.Unlock()
 			cancel()
@@ -168,6 +196,14 @@ func (w *watchingCollector) watch(cluster cluster.Cluster) (retErr error) {
 	}()
 
 	w.clusterWatchersMu.Lock()
+	// a watcher started again keeps the history of the previous one
+	if previous := w.clusterWatchers[clusterName]; previous != nil {
+		if previous.status != ClusterWatchingStopped {
+			previous.setStatus(ClusterWatchingStopped)
+		}
+		c.lastSyncedAt, c.err, c.retries = previous.lastSyncedAt, previous.err, previous.retries
+	}
+	c.setStatus(ClusterWatchingStarting)
 	w.clusterWatchers[clusterName] = c
 	w.clusterWatchersMu.Unlock()
 
@@ -194,14 +230,16 @@ func (w *watchingCollector) watch(cluster cluster.Cluster) (retErr error) {
 		w.clusterWatchersMu.Lock()
 		c.setStatus(ClusterWatchingStarted)
 		w.clusterWatchersMu.Unlock()
+		go w.waitForSync(childctx, clusterName, c, watcher)
 		err := watcher.Start(childctx)
 		if err != nil {
 			w.log.Error(err, "watcher for cluster failed", "cluster", cluster.GetName())
 			w.clusterWatchersMu.Lock()
 			c.setStatus(ClusterWatchingErrored)
+			c.err = err.Error()
 			w.clusterWatchersMu.Unlock()
 			// try again
-			w.queue.AddRateLimited(cluster)
+			w.retry(cluster, err)
 			return
 		}
 		// TODO remove from map?
@@ -216,7 +254,62 @@ func (w *watchingCollector) watch(cluster cluster.Cluster) (retErr error) {
 	return nil
 }
 
+// waitForSync records when the watcher has synced the objects of the cluster, and resets the backoff
+// of the cluster. Watchers that do not cache objects are synced once started.
+func (w *watchingCollector) waitForSync(ctx context.Context, clusterName string, c *child, watcher Starter) {
+	if syncer, ok := watcher.(cacheSyncer); ok {
+		if !syncer.GetCache().WaitForCacheSync(ctx) {
+			return
+		}
+	}
+
+	w.backoff.Forget(clusterName)
+
+	w.clusterWatchersMu.Lock()
+	defer w.clusterWatchersMu.Unlock()
+	c.lastSyncedAt = time.Now()
+	c.err = ""
+	c.retries = 0
+	c.nextRetryAt = time.Time{}
+}
+
+// retry watches the cluster again after a delay, growing exponentially with the failures in a row.
+func (w *watchingCollector) retry(cluster cluster.Cluster, err error) {
+	delay := w.backoff.When(cluster.GetName())
+
+	w.clusterWatchersMu.Lock()
+	if c := w.clusterWatchers[cluster.GetName()]; c != nil {
+		c.setStatus(ClusterWatchingBackoff)
+		c.err = err.Error()
+		c.retries = w.backoff.NumRequeues(cluster.GetName())
+		c.nextRetryAt = time.Now().Add(delay)
+	}
+	w.clusterWatchersMu.Unlock()
+
+	w.queue.AddAfter(cluster, delay)
+}
+
 func (w *watchingCollector) unwatch(clusterName string) error {
+	if err := w.stopWatching(clusterName); err != nil {
+		return err
+	}
+	if err := w.stopWatcherFunc(clusterName); err != nil {
+		return fmt.Errorf("stop watcher hook failed: %w", err)
+	}
+	return nil
+}
+
+// handOver stops watching a cluster collected by another replica from now on. Unlike unwatch,
+// the stop watcher hook is not called, so the records of the cluster are kept for the other replica.
+func (w *watchingCollector) handOver(clusterName string) {
+	if err := w.stopWatching(clusterName); err != nil {
+		w.log.Error(err, "cannot hand cluster over", "cluster", clusterName)
+		return
+	}
+	w.log.Info("handed cluster over", "cluster", clusterName)
+}
+
+func (w *watchingCollector) stopWatching(clusterName string) error {
 	if clusterName == "" {
 		return fmt.Errorf("cluster name is empty")
 	}
@@ -231,12 +324,16 @@ func (w *watchingCollector) unwatch(clusterName string) error {
 	if clusterWatcher.cancel != nil {
 		clusterWatcher.cancel()
 	}
-	if err := w.stopWatcherFunc(clusterName); err != nil {
-		return fmt.Errorf("stop watcher hook failed: %w", err)
-	}
 	return nil
 }
 
+// owns returns whether the cluster is collected by this collector rather than by another replica,
+// when the clusters are sharded.
+func (w *watchingCollector) owns(clusterName string) bool {
+	sharded, ok := w.subscriber.(clusters.ShardedSubscriber)
+	return !ok || sharded.Owns(clusterName)
+}
+
 // Status returns a cluster watcher status for the cluster named as clusterName.
 // It returns an error if empty, cluster does not exist or the status cannot be retrieved.
 func (w *watchingCollector) Status(clusterName string) (string, error) {
@@ -252,6 +349,43 @@ func (w *watchingCollector) Status(clusterName string) (string, error) {
 	return watcher.status, nil
 }
 
+// ClusterStatuses returns the status of the watchers of the clusters, with the number of objects collected from them.
+// With sharding, only the clusters collected by this replica are returned.
+func (w *watchingCollector) ClusterStatuses(ctx context.Context) ([]ClusterStatus, error) {
+	counts := map[string]int{}
+	if w.countObjectsFunc != nil {
+		var err error
+		if counts, err = w.countObjectsFunc(ctx); err != nil {
+			return nil, fmt.Errorf("cannot count collected objects: %w", err)
+		}
+	}
+
+	w.clusterWatchersMu.Lock()
+	statuses := []ClusterStatus{}
+	for clusterName, c := range w.clusterWatchers {
+		if c == nil {
+			continue
+		}
+		statuses = append(statuses, ClusterStatus{
+			Collector:    w.name,
+			ClusterName:  clusterName,
+			Status:       c.status,
+			LastSyncedAt: c.lastSyncedAt,
+			Error:        c.err,
+			ObjectCount:  counts[clusterName],
+			Retries:      c.retries,
+			NextRetryAt:  c.nextRetryAt,
+		})
+	}
+	w.clusterWatchersMu.Unlock()
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].ClusterName < statuses[j].ClusterName
+	})
+
+	return statuses, nil
+}
+
 // makeServiceAccountImpersonationConfig when creating a reconciler for watcher we will need to impersonate
 // a user to dont use the default one to enhance security. This method creates a new rest.config from the input parameters
 // with impersonation configuration pointing to the service account
@@ -21,6 +21,7 @@ import (
 	"k8s.io/client-go/rest"
 
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/collector/clusters/clustersfakes"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
 	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster"
 	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster/clusterfakes"
 	l "github.com/weaveworks/weave-gitops/core/logger"
@@ -462,6 +463,132 @@ func Test_WatcherRetry(t *testing.T) {
 	checkStarted()
 }
 
+func Test_HandOver(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	clustersManager := &clustersfakes.FakeShardedSubscriber{}
+	sub := &clustersfakes.FakeSubscription{}
+	updates := make(chan clustersmngr.ClusterListUpdate)
+	sub.UpdatesReturns(updates)
+	clustersManager.SubscribeReturns(sub)
+
+	c := makeValidFakeCluster("test-cluster")
+	clustersManager.GetClustersReturns([]cluster.Cluster{c})
+	var owned atomic.Bool
+	owned.Store(true)
+	clustersManager.OwnsCalls(func(string) bool { return owned.Load() })
+
+	var stopped atomic.Int32
+	collector, err := newWatchingCollector(CollectorOpts{
+		Clusters:       clustersManager,
+		Log:            logr.Discard(),
+		NewWatcherFunc: newFakeWatcher,
+		StopWatcherFunc: func(string) error {
+			stopped.Add(1)
+			return nil
+		},
+		ServiceAccount: ImpersonateServiceAccount{
+			Namespace: "flux-system",
+			Name:      "collector",
+		},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+	go func() {
+		g.Expect(collector.Start(ctx)).To(Succeed())
+	}()
+
+	g.Eventually(func() error {
+		_, err := collector.Status("test-cluster")
+		return err
+	}, "2s", "0.2s").Should(Succeed())
+
+	// handed over to another replica: the cluster is not watched anymore, and its records are kept
+	owned.Store(false)
+	updates <- clustersmngr.ClusterListUpdate{Removed: []cluster.Cluster{c}}
+	g.Eventually(func() error {
+		_, err := collector.Status("test-cluster")
+		return err
+	}, "2s", "0.2s").Should(HaveOccurred())
+	g.Expect(stopped.Load()).To(BeZero())
+
+	// taken back and then removed: the records are deleted
+	owned.Store(true)
+	updates <- clustersmngr.ClusterListUpdate{Added: []cluster.Cluster{c}}
+	g.Eventually(func() error {
+		_, err := collector.Status("test-cluster")
+		return err
+	}, "2s", "0.2s").Should(Succeed())
+	updates <- clustersmngr.ClusterListUpdate{Removed: []cluster.Cluster{c}}
+	g.Eventually(func() int32 { return stopped.Load() }, "2s", "0.2s").Should(BeEquivalentTo(1))
+}
+
+func TestClusterStatuses(t *testing.T) {
+	g := NewGomegaWithT(t)
+	clustersManager := &clustersfakes.FakeSubscriber{}
+	clustersManager.SubscribeReturns(&clustersfakes.FakeSubscription{})
+	clustersManager.GetClustersReturns([]cluster.Cluster{makeValidFakeCluster("test-cluster")})
+
+	// the watcher fails until it is fixed
+	var fixed atomic.Bool
+	newWatcher := func(clusterName string, config *rest.Config) (Starter, error) {
+		if !fixed.Load() {
+			return nil, fmt.Errorf("cluster unreachable")
+		}
+		return &fakeWatcher{log: logr.Discard()}, nil
+	}
+
+	collector, err := newWatchingCollector(CollectorOpts{
+		Name:           "objects",
+		Clusters:       clustersManager,
+		Log:            logr.Discard(),
+		NewWatcherFunc: newWatcher,
+		CountObjectsFunc: func(ctx context.Context) (map[string]int, error) {
+			return map[string]int{"test-cluster": 3}, nil
+		},
+		ServiceAccount: ImpersonateServiceAccount{
+			Namespace: "flux-system",
+			Name:      "collector",
+		},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+	go func() {
+		g.Expect(collector.Start(ctx)).To(Succeed())
+	}()
+
+	statuses := func() []ClusterStatus {
+		statuses, err := collector.ClusterStatuses(ctx)
+		g.Expect(err).NotTo(HaveOccurred())
+		return statuses
+	}
+
+	// failing clusters are retried with backoff
+	g.Eventually(statuses, "2s", "0.1s").Should(ConsistOf(And(
+		HaveField("Collector", "objects"),
+		HaveField("ClusterName", "test-cluster"),
+		HaveField("Status", ClusterWatchingBackoff),
+		HaveField("Error", ContainSubstring("cluster unreachable")),
+		HaveField("Retries", BeNumerically(">=", 2)),
+		HaveField("NextRetryAt", Not(BeZero())),
+		HaveField("LastSyncedAt", BeZero()),
+	)))
+
+	// and synced once they can be watched
+	fixed.Store(true)
+	g.Eventually(statuses, "5s", "0.1s").Should(ConsistOf(And(
+		HaveField("Status", ClusterWatchingStarted),
+		HaveField("Error", BeEmpty()),
+		HaveField("Retries", BeZero()),
+		HaveField("LastSyncedAt", Not(BeZero())),
+		HaveField("ObjectCount", 3),
+	)))
+}
+
 type erroringWatcher struct {
 	exitWithError context.Context
 	startErr      error
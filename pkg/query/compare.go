@@ -0,0 +1,247 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+)
+
+// ErrBaselineNotFound is returned when the baseline cluster has none of the compared objects, or the principal cannot see it.
+var ErrBaselineNotFound = errors.New("baseline object not found")
+
+// CompareRequest selects the objects to compare, by their kind, namespace and name in every cluster, or by their ids.
+type CompareRequest struct {
+	Kind      string
+	Namespace string
+	Name      string
+	IDs       []string
+	// BaselineCluster is the cluster whose object the others are compared to. It is the first cluster when empty.
+	BaselineCluster string
+}
+
+// DiffChange tells how a field differs from the baseline.
+type DiffChange string
+
+const (
+	// DiffAdded fields are set in the object but not in the baseline.
+	DiffAdded DiffChange = "added"
+	// DiffRemoved fields are set in the baseline but not in the object.
+	DiffRemoved DiffChange = "removed"
+	// DiffChanged fields have different values in the object and the baseline.
+	DiffChanged DiffChange = "changed"
+)
+
+// FieldDifference is a field whose value differs from the baseline. Lists are compared as a whole.
+type FieldDifference struct {
+	// Path is the dotted path of the field within the spec or status, or the label key.
+	Path     string
+	Change   DiffChange
+	Baseline interface{}
+	Value    interface{}
+}
+
+// ObjectComparison holds the differences between an object and the baseline.
+type ObjectComparison struct {
+	Object models.Object
+	Spec   []FieldDifference
+	Status []FieldDifference
+	Labels []FieldDifference
+}
+
+// Comparison compares the objects of different clusters with the object of the baseline cluster.
+type Comparison struct {
+	Baseline models.Object
+	Objects  []ObjectComparison
+}
+
+func (q *qs) CompareObjects(ctx context.Context, req CompareRequest) (Comparison, error) {
+	principal := auth.Principal(ctx)
+	if principal == nil {
+		return Comparison{}, fmt.Errorf("principal not found")
+	}
+
+	objects, err := q.comparedObjects(ctx, req)
+	if err != nil {
+		return Comparison{}, err
+	}
+
+	allow, err := q.objectAuthorizer(ctx, principal)
+	if err != nil {
+		return Comparison{}, err
+	}
+
+	// objects of clusters the principal cannot see are left out, so their existence is not disclosed
+	visible := []models.Object{}
+	for _, obj := range objects {
+		ok, err := allow(obj)
+		if err != nil {
+			return Comparison{}, fmt.Errorf("error checking access: %w", err)
+		}
+		if ok {
+			visible = append(visible, obj)
+		}
+	}
+
+	if len(visible) == 0 {
+		return Comparison{}, ErrObjectNotFound
+	}
+
+	sort.SliceStable(visible, func(i, j int) bool {
+		return visible[i].Cluster < visible[j].Cluster
+	})
+
+	baseline := -1
+	for i, obj := range visible {
+		if req.BaselineCluster == "" || obj.Cluster == req.BaselineCluster {
+			baseline = i
+			break
+		}
+	}
+	if baseline < 0 {
+		return Comparison{}, ErrBaselineNotFound
+	}
+
+	base, err := newComparedObject(visible[baseline])
+	if err != nil {
+		return Comparison{}, err
+	}
+
+	comparison := Comparison{
+		Baseline: visible[baseline],
+		Objects:  []ObjectComparison{},
+	}
+	for i, obj := range visible {
+		if i == baseline {
+			continue
+		}
+
+		other, err := newComparedObject(obj)
+		if err != nil {
+			return Comparison{}, err
+		}
+
+		comparison.Objects = append(comparison.Objects, ObjectComparison{
+			Object: obj,
+			Spec:   diffFields(base.spec, other.spec),
+			Status: diffFields(base.status, other.status),
+			Labels: diffFields(base.labels, other.labels),
+		})
+	}
+
+	return comparison, nil
+}
+
+func (q *qs) comparedObjects(ctx context.Context, req CompareRequest) ([]models.Object, error) {
+	if len(req.IDs) > 0 {
+		iter, err := q.r.GetObjects(ctx, req.IDs, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching objects from the store: %w", err)
+		}
+		defer iter.Close()
+
+		objects, err := iter.All()
+		if err != nil {
+			return nil, fmt.Errorf("error fetching objects from the store: %w", err)
+		}
+		return objects, nil
+	}
+
+	if req.Kind == "" || req.Name == "" {
+		return nil, fmt.Errorf("ids or kind and name are required")
+	}
+
+	objects, err := q.r.GetObjectsByName(ctx, req.Kind, req.Namespace, req.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching objects from the store: %w", err)
+	}
+
+	return objects, nil
+}
+
+// comparedObject holds the flattened fields of an object that are compared.
+type comparedObject struct {
+	spec   map[string]interface{}
+	status map[string]interface{}
+	labels map[string]interface{}
+}
+
+func newComparedObject(obj models.Object) (comparedObject, error) {
+	compared := comparedObject{
+		spec:   map[string]interface{}{},
+		status: map[string]interface{}{},
+		labels: map[string]interface{}{},
+	}
+
+	if len(obj.Unstructured) == 0 {
+		return compared, nil
+	}
+
+	u := map[string]interface{}{}
+	if err := json.Unmarshal(obj.ClientObjectJSON(), &u); err != nil {
+		return comparedObject{}, fmt.Errorf("error reading object %s: %w", obj.GetID(), err)
+	}
+
+	flattenFields("", u["spec"], compared.spec)
+	flattenFields("", u["status"], compared.status)
+
+	if metadata, ok := u["metadata"].(map[string]interface{}); ok {
+		if labels, ok := metadata["labels"].(map[string]interface{}); ok {
+			// label keys have dots, so they are not flattened
+			compared.labels = labels
+		}
+	}
+
+	return compared, nil
+}
+
+// flattenFields adds the fields of nested maps under their dotted path. Lists and empty maps are kept as values.
+func flattenFields(prefix string, value interface{}, fields map[string]interface{}) {
+	m, ok := value.(map[string]interface{})
+	if !ok || len(m) == 0 {
+		if value != nil && prefix != "" {
+			fields[prefix] = value
+		}
+		return
+	}
+
+	for k, v := range m {
+		path := k
+		if prefix != "" {
+			path = prefix + "." + k
+		}
+		flattenFields(path, v, fields)
+	}
+}
+
+// diffFields returns the fields that differ between the baseline and the other object, sorted by path.
+func diffFields(baseline, other map[string]interface{}) []FieldDifference {
+	diffs := []FieldDifference{}
+
+	for path, b := range baseline {
+		o, ok := other[path]
+		switch {
+		case !ok:
+			diffs = append(diffs, FieldDifference{Path: path, Change: DiffRemoved, Baseline: b})
+		case !reflect.DeepEqual(b, o):
+			diffs = append(diffs, FieldDifference{Path: path, Change: DiffChanged, Baseline: b, Value: o})
+		}
+	}
+
+	for path, o := range other {
+		if _, ok := baseline[path]; !ok {
+			diffs = append(diffs, FieldDifference{Path: path, Change: DiffAdded, Value: o})
+		}
+	}
+
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Path < diffs[j].Path
+	})
+
+	return diffs
+}
@@ -0,0 +1,183 @@
+package configuration
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultEventsRetentionPolicy is how long events are kept when the Events category is enabled.
+// Events are many and only relevant while they are recent, so they are kept for less time than audit events,
+// which keep the retention of PolicyAgentAuditEventObjectKind.
+const DefaultEventsRetentionPolicy = RetentionPolicy(6 * time.Hour)
+
+const (
+	// EventReasonLabel is the label holding the reason of an event, like BackOff or UpgradeFailed
+	EventReasonLabel = "events.weave.works/reason"
+	// EventInvolvedKindLabel is the label holding the kind of the object an event is about
+	EventInvolvedKindLabel = "events.weave.works/involved-kind"
+	// EventInvolvedObjectLabel is the label holding the namespaced name of the object an event is about
+	EventInvolvedObjectLabel = "events.weave.works/involved-object"
+)
+
+var eventHumanReadableLabelKeys = map[string]string{
+	EventReasonLabel:         "reason",
+	EventInvolvedKindLabel:   "involved-kind",
+	EventInvolvedObjectLabel: "involved-object",
+}
+
+// EventFilter selects the Kubernetes events collected by the Events category, in addition to the
+// policy agent audit events. Empty fields match any event.
+type EventFilter struct {
+	// Reasons are the reasons of the events to collect, like BackOff or UpgradeFailed.
+	Reasons []string
+	// InvolvedKinds are the kinds of the objects whose events are collected, like HelmRelease.
+	InvolvedKinds []string
+	// WarningsOnly only collects events of type Warning.
+	WarningsOnly bool
+}
+
+// Matches returns whether the event is collected by the filter.
+func (f EventFilter) Matches(e *corev1.Event) bool {
+	if f.WarningsOnly && e.Type != corev1.EventTypeWarning {
+		return false
+	}
+
+	if len(f.Reasons) > 0 && !contains(f.Reasons, e.Reason) {
+		return false
+	}
+
+	if len(f.InvolvedKinds) > 0 && !contains(f.InvolvedKinds, e.InvolvedObject.Kind) {
+		return false
+	}
+
+	return true
+}
+
+// EventsObjectKind returns the objectKind of the Events category. It collects the events matching the filter
+// along with the policy agent audit events, and links them to the object they are about so they show up
+// with it. It replaces PolicyAgentAuditEventObjectKind, as a GroupVersionKind is collected by a single objectKind.
+// The retention only applies to the events of the filter, audit events are kept as long as without the Events
+// category. The default retention is used when retention is not set.
+func EventsObjectKind(filter EventFilter, retention RetentionPolicy) ObjectKind {
+	if retention == NoRetentionPolicy {
+		retention = DefaultEventsRetentionPolicy
+	}
+
+	auditRetention := PolicyAgentAuditEventObjectKind.RetentionPolicy
+
+	kind := PolicyAgentAuditEventObjectKind
+	kind.RetentionPolicy = retention
+	if auditRetention > retention {
+		kind.RetentionPolicy = auditRetention
+	}
+	kind.GetRetentionPolicyFunc = func(obj client.Object) RetentionPolicy {
+		if e, ok := obj.(*corev1.Event); ok && isPolicyAgentAuditEvent(e) {
+			return auditRetention
+		}
+
+		return retention
+	}
+	kind.FilterFunc = func(obj client.Object) bool {
+		e, ok := obj.(*corev1.Event)
+		if !ok {
+			return false
+		}
+
+		return isPolicyAgentAuditEvent(e) || filter.Matches(e)
+	}
+	kind.GetLabelsFunc = func(obj client.Object) map[string]string {
+		e, ok := obj.(*corev1.Event)
+		if !ok {
+			return nil
+		}
+
+		if isPolicyAgentAuditEvent(e) {
+			return PolicyAgentAuditEventObjectKind.GetLabelsFunc(obj)
+		}
+
+		return map[string]string{
+			EventReasonLabel:         e.Reason,
+			EventInvolvedKindLabel:   e.InvolvedObject.Kind,
+			EventInvolvedObjectLabel: involvedObjectName(e),
+		}
+	}
+	kind.GetRelationsFunc = eventRelations
+	kind.Labels = append(append([]string{}, PolicyAgentAuditEventObjectKind.Labels...),
+		EventReasonLabel, EventInvolvedKindLabel, EventInvolvedObjectLabel)
+
+	kind.HumanReadableLabelKeys = map[string]string{}
+	for k, v := range policyHumanReadableLabelKeys {
+		kind.HumanReadableLabelKeys[k] = v
+	}
+	for k, v := range eventHumanReadableLabelKeys {
+		kind.HumanReadableLabelKeys[k] = v
+	}
+
+	return kind
+}
+
+// WithEventsObjectKind returns the objectKinds with the objectKind collecting events replaced by the one of the
+// Events category.
+func WithEventsObjectKind(kinds []ObjectKind, filter EventFilter, retention RetentionPolicy) []ObjectKind {
+	events := EventsObjectKind(filter, retention)
+
+	result := []ObjectKind{}
+	for _, k := range kinds {
+		if k.Gvk == events.Gvk {
+			continue
+		}
+		result = append(result, k)
+	}
+
+	return append(result, events)
+}
+
+// eventRelations references the object an event is about. The event depends on it, so the event
+// is downstream of the object and shows up among its related objects.
+func eventRelations(obj client.Object) []ObjectReference {
+	e, ok := obj.(*corev1.Event)
+	if !ok || e.InvolvedObject.Kind == "" || e.InvolvedObject.Name == "" {
+		return nil
+	}
+
+	group := ""
+	if gv, err := schema.ParseGroupVersion(e.InvolvedObject.APIVersion); err == nil {
+		group = gv.Group
+	}
+
+	return []ObjectReference{
+		{
+			Namespace: e.InvolvedObject.Namespace,
+			APIGroup:  group,
+			Kind:      e.InvolvedObject.Kind,
+			Name:      e.InvolvedObject.Name,
+			Direction: RelationUpstream,
+		},
+	}
+}
+
+func isPolicyAgentAuditEvent(e *corev1.Event) bool {
+	return e.Labels["pac.weave.works/type"] == "Audit" && e.Source.Component == "policy-agent"
+}
+
+func involvedObjectName(e *corev1.Event) string {
+	if e.InvolvedObject.Namespace == "" {
+		return e.InvolvedObject.Name
+	}
+
+	return fmt.Sprintf("%s/%s", e.InvolvedObject.Namespace, e.InvolvedObject.Name)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,113 @@
+package configuration
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestEventsObjectKind(t *testing.T) {
+	g := NewWithT(t)
+
+	kind := EventsObjectKind(EventFilter{
+		Reasons:       []string{"UpgradeFailed", "InstallFailed"},
+		InvolvedKinds: []string{"HelmRelease"},
+		WarningsOnly:  true,
+	}, NoRetentionPolicy)
+	g.Expect(kind.Validate()).To(Succeed())
+	// the kind keeps the transitions of the events as long as the longest retention, the one of audit events
+	g.Expect(kind.RetentionPolicy).To(Equal(PolicyAgentAuditEventObjectKind.RetentionPolicy))
+
+	upgradeFailed := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{Name: "podinfo.178f", Namespace: "apps"},
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion: "helm.toolkit.fluxcd.io/v2beta1",
+			Kind:       "HelmRelease",
+			Namespace:  "apps",
+			Name:       "podinfo",
+		},
+		Reason:  "UpgradeFailed",
+		Type:    corev1.EventTypeWarning,
+		Message: "Helm upgrade failed: timed out waiting for the condition",
+	}
+
+	t.Run("filters events", func(t *testing.T) {
+		audit := &corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"pac.weave.works/type": "Audit"}},
+			Source:     corev1.EventSource{Component: "policy-agent"},
+			Type:       corev1.EventTypeNormal,
+		}
+
+		normal := upgradeFailed.DeepCopy()
+		normal.Type = corev1.EventTypeNormal
+
+		otherReason := upgradeFailed.DeepCopy()
+		otherReason.Reason = "ReconciliationSucceeded"
+
+		otherKind := upgradeFailed.DeepCopy()
+		otherKind.InvolvedObject.Kind = "Kustomization"
+
+		g.Expect(kind.FilterFunc(upgradeFailed)).To(BeTrue())
+		g.Expect(kind.FilterFunc(audit)).To(BeTrue())
+		g.Expect(kind.FilterFunc(normal)).To(BeFalse())
+		g.Expect(kind.FilterFunc(otherReason)).To(BeFalse())
+		g.Expect(kind.FilterFunc(otherKind)).To(BeFalse())
+		g.Expect(EventsObjectKind(EventFilter{}, NoRetentionPolicy).FilterFunc(otherKind)).To(BeTrue())
+	})
+
+	t.Run("keeps audit events for their own retention", func(t *testing.T) {
+		audit := &corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"pac.weave.works/type": "Audit"}},
+			Source:     corev1.EventSource{Component: "policy-agent"},
+		}
+
+		g.Expect(kind.RetentionPolicyOf(upgradeFailed)).To(Equal(DefaultEventsRetentionPolicy))
+		g.Expect(kind.RetentionPolicyOf(audit)).To(Equal(PolicyAgentAuditEventObjectKind.RetentionPolicy))
+
+		longer := EventsObjectKind(EventFilter{}, RetentionPolicy(48*time.Hour))
+		g.Expect(longer.RetentionPolicy).To(Equal(RetentionPolicy(48 * time.Hour)))
+		g.Expect(longer.RetentionPolicyOf(audit)).To(Equal(PolicyAgentAuditEventObjectKind.RetentionPolicy))
+	})
+
+	t.Run("labels events", func(t *testing.T) {
+		g.Expect(kind.GetLabelsFunc(upgradeFailed)).To(Equal(map[string]string{
+			EventReasonLabel:         "UpgradeFailed",
+			EventInvolvedKindLabel:   "HelmRelease",
+			EventInvolvedObjectLabel: "apps/podinfo",
+		}))
+		g.Expect(kind.Labels).To(ContainElements(PolicySeverityLabel, EventReasonLabel, EventInvolvedObjectLabel))
+		g.Expect(kind.HumanReadableLabelKeys[EventReasonLabel]).To(Equal("reason"))
+	})
+
+	t.Run("links events to their involved object", func(t *testing.T) {
+		g.Expect(kind.GetRelationsFunc(upgradeFailed)).To(Equal([]ObjectReference{{
+			Namespace: "apps",
+			APIGroup:  "helm.toolkit.fluxcd.io",
+			Kind:      "HelmRelease",
+			Name:      "podinfo",
+			Direction: RelationUpstream,
+		}}))
+		g.Expect(kind.GetRelationsFunc(&corev1.Event{})).To(BeNil())
+	})
+}
+
+func TestWithEventsObjectKind(t *testing.T) {
+	g := NewWithT(t)
+
+	kinds := WithEventsObjectKind(SupportedObjectKinds, EventFilter{WarningsOnly: true}, RetentionPolicy(0))
+	g.Expect(kinds).To(HaveLen(len(SupportedObjectKinds)))
+
+	events := 0
+	for _, k := range kinds {
+		if k.Gvk == PolicyAgentAuditEventObjectKind.Gvk {
+			events++
+			g.Expect(k.GetRelationsFunc).NotTo(BeNil())
+		}
+	}
+	g.Expect(events).To(Equal(1))
+	// the supported kinds are left as they are
+	g.Expect(PolicyAgentAuditEventObjectKind.GetRelationsFunc).To(BeNil())
+}
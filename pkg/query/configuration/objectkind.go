@@ -2,6 +2,8 @@ package configuration
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	sourcev1 "github.com/fluxcd/source-controller/api/v1"
@@ -12,8 +14,11 @@ import (
 	sourcev1beta2 "github.com/fluxcd/source-controller/api/v1beta2"
 	clusterreflectorv1alpha1 "github.com/weaveworks/cluster-reflector-controller/api/v1alpha1"
 	gitopssets "github.com/weaveworks/gitopssets-controller/api/v1alpha1"
+	pipelinev1alpha1 "github.com/weaveworks/pipeline-controller/api/v1alpha1"
+	pacv2beta2 "github.com/weaveworks/policy-agent/api/v2beta2"
 	capiv1 "github.com/weaveworks/templates-controller/apis/capi/v1alpha2"
 	gapiv1 "github.com/weaveworks/templates-controller/apis/gitops/v1alpha2"
+	tfctrl "github.com/weaveworks/tf-controller/api/v1alpha1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -35,6 +40,9 @@ const (
 	CategoryTemplate         ObjectCategory = "template"
 	CategoryRBAC             ObjectCategory = "rbac"
 	CategoryClusterDiscovery ObjectCategory = "clusterdiscovery"
+	CategoryTerraform        ObjectCategory = "terraform"
+	CategoryPipeline         ObjectCategory = "pipeline"
+	CategoryPolicy           ObjectCategory = "policy"
 )
 
 // ObjectKind is the main structure for a object that explorer is able to manage. It includes all the configuration and
@@ -48,6 +56,9 @@ type ObjectKind struct {
 	AddToSchemeFunc func(*runtime.Scheme) error `json:"-"`
 	// RetentionPolicy is a function to define retention for objects of this objectkind. For example for event to be retained for 24 hours.
 	RetentionPolicy RetentionPolicy `json:"-"`
+	// GetRetentionPolicyFunc is a function to define the retention of an object, when the objects of this objectkind are
+	// retained for different times. RetentionPolicy is used when it is not set, and is the longest of them.
+	GetRetentionPolicyFunc func(obj client.Object) RetentionPolicy `json:"-"`
 	// FilterFunc is a function to filter objects of this objectkind. For example to only retain events from a particular source.
 	FilterFunc FilterFunc
 	// GetConditionsFunc is a function to get the conditions
@@ -60,8 +71,16 @@ type ObjectKind struct {
 	MessageFunc func(obj client.Object, objectKind ObjectKind) (string, error)
 	// Labels defines a list of labels that you are interested to collect and query for the object kind. For example, templates, defines templateType as label.
 	Labels []string
+	// GetLabelsFunc is a function to derive labels from other fields of the object, like its spec. They are added to the
+	// object labels before selecting the ones defined in Labels.
+	GetLabelsFunc func(obj client.Object) map[string]string
+	// GetRelationsFunc is a function to get the objects that the object depends on, like its source, or that depend
+	// on it, like the objects it applies. They are used to tell which objects are affected when one of them fails.
+	GetRelationsFunc func(obj client.Object) []ObjectReference
 	// Category defines the category of the objectkind. It allows to group objectkinds in the UI.
 	Category ObjectCategory
+	// ClusterScoped is true for kinds whose objects have no namespace, like policy-agent Policies.
+	ClusterScoped bool
 	// HumanReadableLabelKeys is a map of label keys to human readable names. It allows to customise the label names in the UI.
 	// Values should be dash case: template-type, some-value, etc.
 	HumanReadableLabelKeys map[string]string
@@ -82,6 +101,15 @@ func (ok ObjectKind) String() string {
 	return ok.Gvk.String()
 }
 
+// RetentionPolicyOf returns the retention of an object of the objectKind.
+func (o ObjectKind) RetentionPolicyOf(obj client.Object) RetentionPolicy {
+	if o.GetRetentionPolicyFunc == nil {
+		return o.RetentionPolicy
+	}
+
+	return o.GetRetentionPolicyFunc(obj)
+}
+
 func (o ObjectKind) Validate() error {
 	if o.Gvk.Kind == "" {
 		return fmt.Errorf("missing gvk")
@@ -129,9 +157,10 @@ var (
 			}
 			return hr.Spec.Suspend, nil
 		},
-		StatusFunc:  defaultStatusFunc,
-		MessageFunc: defaultMessageFunc,
-		Category:    CategoryAutomation,
+		GetRelationsFunc: helmReleaseRelations,
+		StatusFunc:       defaultStatusFunc,
+		MessageFunc:      defaultMessageFunc,
+		Category:         CategoryAutomation,
 	}
 
 	KustomizationObjectKind = ObjectKind{
@@ -154,9 +183,10 @@ var (
 			}
 			return ks.Spec.Suspend, nil
 		},
-		StatusFunc:  defaultStatusFunc,
-		MessageFunc: defaultMessageFunc,
-		Category:    CategoryAutomation,
+		GetRelationsFunc: kustomizationRelations,
+		StatusFunc:       defaultStatusFunc,
+		MessageFunc:      defaultMessageFunc,
+		Category:         CategoryAutomation,
 	}
 
 	HelmRepositoryObjectKind = ObjectKind{
@@ -204,9 +234,10 @@ var (
 			}
 			return chart.Spec.Suspend, nil
 		},
-		StatusFunc:  defaultStatusFunc,
-		MessageFunc: defaultMessageFunc,
-		Category:    CategorySource,
+		GetRelationsFunc: helmChartRelations,
+		StatusFunc:       defaultStatusFunc,
+		MessageFunc:      defaultMessageFunc,
+		Category:         CategorySource,
 	}
 
 	GitRepositoryObjectKind = ObjectKind{
@@ -332,7 +363,7 @@ var (
 				return false
 			}
 
-			return e.Labels["pac.weave.works/type"] == "Audit" && e.Source.Component == "policy-agent"
+			return isPolicyAgentAuditEvent(e)
 		},
 		RetentionPolicy: RetentionPolicy(24 * time.Hour),
 		StatusFunc: func(obj client.Object, _ ObjectKind) (ObjectStatus, error) {
@@ -355,7 +386,110 @@ var (
 
 			return e.Message, nil
 		},
-		Category: CategoryEvent,
+		GetLabelsFunc: func(obj client.Object) map[string]string {
+			e, ok := obj.(*corev1.Event)
+			if !ok {
+				return nil
+			}
+
+			annotations := e.GetAnnotations()
+
+			entity := e.InvolvedObject.Name
+			if e.InvolvedObject.Namespace != "" {
+				entity = fmt.Sprintf("%s/%s", e.InvolvedObject.Namespace, e.InvolvedObject.Name)
+			}
+
+			return map[string]string{
+				PolicySeverityLabel:   annotations["severity"],
+				PolicyCategoryLabel:   annotations["category"],
+				PolicyIDLabel:         annotations["policy_id"],
+				PolicyEntityKindLabel: e.InvolvedObject.Kind,
+				PolicyEntityLabel:     entity,
+			}
+		},
+		Labels: []string{
+			PolicySeverityLabel,
+			PolicyCategoryLabel,
+			PolicyIDLabel,
+			PolicyEntityKindLabel,
+			PolicyEntityLabel,
+		},
+		Category:               CategoryEvent,
+		HumanReadableLabelKeys: policyHumanReadableLabelKeys,
+	}
+
+	PolicyObjectKind = ObjectKind{
+		Gvk: pacv2beta2.GroupVersion.WithKind(pacv2beta2.PolicyKind),
+		NewClientObjectFunc: func() client.Object {
+			return &pacv2beta2.Policy{}
+		},
+		AddToSchemeFunc: pacv2beta2.AddToScheme,
+		StatusFunc:      noStatusFunc,
+		MessageFunc: func(obj client.Object, _ ObjectKind) (string, error) {
+			p, ok := obj.(*pacv2beta2.Policy)
+			if !ok {
+				return "", fmt.Errorf("object is not a Policy")
+			}
+
+			return p.Spec.Name, nil
+		},
+		GetLabelsFunc: func(obj client.Object) map[string]string {
+			p, ok := obj.(*pacv2beta2.Policy)
+			if !ok {
+				return nil
+			}
+
+			return map[string]string{
+				PolicySeverityLabel: p.Spec.Severity,
+				PolicyCategoryLabel: p.Spec.Category,
+				PolicyIDLabel:       p.Spec.ID,
+			}
+		},
+		Labels: []string{
+			PolicySeverityLabel,
+			PolicyCategoryLabel,
+			PolicyIDLabel,
+		},
+		Category:               CategoryPolicy,
+		ClusterScoped:          true,
+		HumanReadableLabelKeys: policyHumanReadableLabelKeys,
+	}
+
+	PolicyConfigObjectKind = ObjectKind{
+		Gvk: pacv2beta2.GroupVersion.WithKind(pacv2beta2.PolicyConfigKind),
+		NewClientObjectFunc: func() client.Object {
+			return &pacv2beta2.PolicyConfig{}
+		},
+		AddToSchemeFunc: pacv2beta2.AddToScheme,
+		StatusFunc: func(obj client.Object, _ ObjectKind) (ObjectStatus, error) {
+			pc, ok := obj.(*pacv2beta2.PolicyConfig)
+			if !ok {
+				return "", fmt.Errorf("object is not a PolicyConfig")
+			}
+
+			switch pc.Status.Status {
+			case "":
+				return NoStatus, nil
+			case "OK":
+				return Success, nil
+			default:
+				return Failed, nil
+			}
+		},
+		MessageFunc: func(obj client.Object, _ ObjectKind) (string, error) {
+			pc, ok := obj.(*pacv2beta2.PolicyConfig)
+			if !ok {
+				return "", fmt.Errorf("object is not a PolicyConfig")
+			}
+
+			if len(pc.Status.MissingPolicies) == 0 {
+				return "", nil
+			}
+
+			return fmt.Sprintf("Missing policies: %s", strings.Join(pc.Status.MissingPolicies, ", ")), nil
+		},
+		Category:      CategoryPolicy,
+		ClusterScoped: true,
 	}
 
 	GitOpsSetsObjectKind = ObjectKind{
@@ -378,9 +512,10 @@ var (
 			}
 			return gs.Spec.Suspend, nil
 		},
-		StatusFunc:  defaultStatusFunc,
-		MessageFunc: defaultMessageFunc,
-		Category:    CategoryGitopsSet,
+		GetRelationsFunc: gitOpsSetRelations,
+		StatusFunc:       defaultStatusFunc,
+		MessageFunc:      defaultMessageFunc,
+		Category:         CategoryGitopsSet,
 	}
 
 	GitopsTemplateObjectKind = ObjectKind{
@@ -449,8 +584,135 @@ var (
 		MessageFunc: defaultMessageFunc,
 		Category:    CategoryClusterDiscovery,
 	}
+
+	TerraformObjectKind = ObjectKind{
+		Gvk: tfctrl.GroupVersion.WithKind(tfctrl.TerraformKind),
+		NewClientObjectFunc: func() client.Object {
+			return &tfctrl.Terraform{}
+		},
+		AddToSchemeFunc: tfctrl.AddToScheme,
+		GetConditionsFunc: func(obj client.Object) ([]metav1.Condition, error) {
+			tf, ok := obj.(*tfctrl.Terraform)
+			if !ok {
+				return nil, fmt.Errorf("object is not a Terraform")
+			}
+			return tf.Status.Conditions, nil
+		},
+		GetSuspendedFunc: func(obj client.Object) (bool, error) {
+			tf, ok := obj.(*tfctrl.Terraform)
+			if !ok {
+				return false, fmt.Errorf("object is not a Terraform")
+			}
+			return tf.Spec.Suspend, nil
+		},
+		StatusFunc:  terraformStatusFunc,
+		MessageFunc: defaultMessageFunc,
+		Category:    CategoryTerraform,
+	}
+
+	PipelineObjectKind = ObjectKind{
+		Gvk: pipelinev1alpha1.GroupVersion.WithKind(pipelinev1alpha1.PipelineKind),
+		NewClientObjectFunc: func() client.Object {
+			return &pipelinev1alpha1.Pipeline{}
+		},
+		AddToSchemeFunc: pipelinev1alpha1.AddToScheme,
+		StatusFunc: func(obj client.Object, _ ObjectKind) (ObjectStatus, error) {
+			p, ok := obj.(*pipelinev1alpha1.Pipeline)
+			if !ok {
+				return "", fmt.Errorf("object is not a Pipeline")
+			}
+
+			if len(p.Status.Environments) == 0 {
+				return NoStatus, nil
+			}
+
+			if len(pipelineEnvironmentsWaitingApproval(p)) > 0 {
+				return PendingAction, nil
+			}
+
+			return Success, nil
+		},
+		MessageFunc: func(obj client.Object, _ ObjectKind) (string, error) {
+			p, ok := obj.(*pipelinev1alpha1.Pipeline)
+			if !ok {
+				return "", fmt.Errorf("object is not a Pipeline")
+			}
+
+			waiting := pipelineEnvironmentsWaitingApproval(p)
+			if len(waiting) == 0 {
+				return "", nil
+			}
+
+			return fmt.Sprintf("Waiting for approval: %s", strings.Join(waiting, ", ")), nil
+		},
+		GetLabelsFunc: func(obj client.Object) map[string]string {
+			p, ok := obj.(*pipelinev1alpha1.Pipeline)
+			if !ok {
+				return nil
+			}
+
+			return map[string]string{
+				PipelineAppRefKindLabel: p.Spec.AppRef.Kind,
+				PipelineAppRefNameLabel: p.Spec.AppRef.Name,
+			}
+		},
+		Labels: []string{
+			PipelineAppRefKindLabel,
+			PipelineAppRefNameLabel,
+		},
+		Category: CategoryPipeline,
+		HumanReadableLabelKeys: map[string]string{
+			PipelineAppRefKindLabel: "app-kind",
+			PipelineAppRefNameLabel: "app-name",
+		},
+	}
+)
+
+const (
+	// PipelineAppRefKindLabel is the label holding the kind of the application promoted by a Pipeline
+	PipelineAppRefKindLabel = "pipelines.weave.works/app-kind"
+	// PipelineAppRefNameLabel is the label holding the name of the application promoted by a Pipeline
+	PipelineAppRefNameLabel = "pipelines.weave.works/app-name"
+)
+
+const (
+	// PolicySeverityLabel is the label holding the severity of a policy, or of the policy violated by an audit event
+	PolicySeverityLabel = "pac.weave.works/severity"
+	// PolicyCategoryLabel is the label holding the category of a policy, or of the policy violated by an audit event
+	PolicyCategoryLabel = "pac.weave.works/category"
+	// PolicyIDLabel is the label holding the id of a policy, or of the policy violated by an audit event
+	PolicyIDLabel = "pac.weave.works/policy-id"
+	// PolicyEntityKindLabel is the label holding the kind of the entity violating a policy
+	PolicyEntityKindLabel = "pac.weave.works/entity-kind"
+	// PolicyEntityLabel is the label holding the namespaced name of the entity violating a policy
+	PolicyEntityLabel = "pac.weave.works/entity"
 )
 
+var policyHumanReadableLabelKeys = map[string]string{
+	PolicySeverityLabel:   "severity",
+	PolicyCategoryLabel:   "policy-category",
+	PolicyIDLabel:         "policy-id",
+	PolicyEntityKindLabel: "entity-kind",
+	PolicyEntityLabel:     "entity",
+}
+
+// pipelineEnvironmentsWaitingApproval returns the sorted environments of the pipeline with a promotion
+// waiting for a manual approval, along with the revision to promote.
+func pipelineEnvironmentsWaitingApproval(p *pipelinev1alpha1.Pipeline) []string {
+	waiting := []string{}
+
+	for name, env := range p.Status.Environments {
+		if env == nil || env.WaitingApproval.Revision == "" {
+			continue
+		}
+		waiting = append(waiting, fmt.Sprintf("%s (%s)", name, env.WaitingApproval.Revision))
+	}
+
+	sort.Strings(waiting)
+
+	return waiting
+}
+
 func noStatusFunc(_ client.Object, _ ObjectKind) (ObjectStatus, error) {
 	return NoStatus, nil
 }
@@ -465,10 +727,14 @@ var SupportedObjectKinds = []ObjectKind{
 	OCIRepositoryObjectKind,
 	BucketObjectKind,
 	PolicyAgentAuditEventObjectKind,
+	PolicyObjectKind,
+	PolicyConfigObjectKind,
 	GitOpsSetsObjectKind,
 	GitopsTemplateObjectKind,
 	CapiTemplateObjectKind,
 	AutomatedClusterDiscoveryObjectKind,
+	TerraformObjectKind,
+	PipelineObjectKind,
 }
 
 // SupportedRbacKinds list with the default supported RBAC resources.
@@ -533,6 +799,21 @@ func defaultStatusFunc(obj client.Object, objectKind ObjectKind) (ObjectStatus,
 	return Failed, nil
 }
 
+// terraformStatusFunc extends defaultStatusFunc with plans waiting for approval. A Terraform object with a
+// pending plan needs someone to act on it, whatever its Ready condition says.
+func terraformStatusFunc(obj client.Object, objectKind ObjectKind) (ObjectStatus, error) {
+	tf, ok := obj.(*tfctrl.Terraform)
+	if !ok {
+		return "", fmt.Errorf("object is not a Terraform")
+	}
+
+	if !tf.Spec.Suspend && tf.Status.Plan.Pending != "" {
+		return PendingAction, nil
+	}
+
+	return defaultStatusFunc(obj, objectKind)
+}
+
 func defaultMessageFunc(obj client.Object, objectKind ObjectKind) (string, error) {
 	if objectKind.GetConditionsFunc == nil {
 		return "", fmt.Errorf("missing get conditions func")
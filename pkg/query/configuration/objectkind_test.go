@@ -8,6 +8,9 @@ import (
 	sourcev1 "github.com/fluxcd/source-controller/api/v1"
 	. "github.com/onsi/gomega"
 	clusterreflectorv1alpha1 "github.com/weaveworks/cluster-reflector-controller/api/v1alpha1"
+	pipelinev1alpha1 "github.com/weaveworks/pipeline-controller/api/v1alpha1"
+	pacv2beta2 "github.com/weaveworks/policy-agent/api/v2beta2"
+	tfctrl "github.com/weaveworks/tf-controller/api/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -240,13 +243,12 @@ func TestStatusAndMessage(t *testing.T) {
 			},
 			objectKind: KustomizationObjectKind,
 		},
-		// TODO: Replace Kustomization with a Terraform object after Explorer starts supporting Terraform objects.
 		{
-			name:           "Fake Terraform object with Ready condition and PendingAction computed status",
+			name:           "Terraform with Ready condition and PendingAction computed status",
 			desiredStatus:  PendingAction,
 			desiredMessage: "PendingAction message for Terraform object",
-			obj: &kustomizev1.Kustomization{
-				Status: kustomizev1.KustomizationStatus{
+			obj: &tfctrl.Terraform{
+				Status: tfctrl.TerraformStatus{
 					Conditions: []metav1.Condition{
 						{
 							Type:    "Ready",
@@ -257,7 +259,129 @@ func TestStatusAndMessage(t *testing.T) {
 					},
 				},
 			},
-			objectKind: KustomizationObjectKind,
+			objectKind: TerraformObjectKind,
+		},
+		{
+			name:           "Terraform with pending plan",
+			desiredStatus:  PendingAction,
+			desiredMessage: "Plan generated: set approvePlan: \"plan-main-1234567890\" to approve this plan.",
+			obj: &tfctrl.Terraform{
+				Status: tfctrl.TerraformStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:    "Ready",
+							Status:  "True",
+							Message: "Plan generated: set approvePlan: \"plan-main-1234567890\" to approve this plan.",
+						},
+					},
+					Plan: tfctrl.PlanStatus{
+						Pending: "plan-main-1234567890",
+					},
+				},
+			},
+			objectKind: TerraformObjectKind,
+		},
+		{
+			name:           "Terraform with pending plan and Suspended computed status",
+			desiredStatus:  Suspended,
+			desiredMessage: "Applied successfully",
+			obj: &tfctrl.Terraform{
+				Spec: tfctrl.TerraformSpec{
+					Suspend: true,
+				},
+				Status: tfctrl.TerraformStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:    "Ready",
+							Status:  "True",
+							Message: "Applied successfully",
+						},
+					},
+					Plan: tfctrl.PlanStatus{
+						Pending: "plan-main-1234567890",
+					},
+				},
+			},
+			objectKind: TerraformObjectKind,
+		},
+		{
+			name:           "Pipeline without environment statuses",
+			desiredStatus:  NoStatus,
+			desiredMessage: "",
+			obj:            &pipelinev1alpha1.Pipeline{},
+			objectKind:     PipelineObjectKind,
+		},
+		{
+			name:           "Pipeline without promotions waiting for approval",
+			desiredStatus:  Success,
+			desiredMessage: "",
+			obj: &pipelinev1alpha1.Pipeline{
+				Status: pipelinev1alpha1.PipelineStatus{
+					Environments: map[string]*pipelinev1alpha1.EnvironmentStatus{
+						"dev": {},
+					},
+				},
+			},
+			objectKind: PipelineObjectKind,
+		},
+		{
+			name:           "Pipeline with promotions waiting for approval",
+			desiredStatus:  PendingAction,
+			desiredMessage: "Waiting for approval: prod (1.2.3), staging (1.2.4)",
+			obj: &pipelinev1alpha1.Pipeline{
+				Status: pipelinev1alpha1.PipelineStatus{
+					Environments: map[string]*pipelinev1alpha1.EnvironmentStatus{
+						"dev": {},
+						"staging": {
+							WaitingApproval: pipelinev1alpha1.WaitingApproval{
+								Revision: "1.2.4",
+							},
+						},
+						"prod": {
+							WaitingApproval: pipelinev1alpha1.WaitingApproval{
+								Revision: "1.2.3",
+							},
+						},
+					},
+				},
+			},
+			objectKind: PipelineObjectKind,
+		},
+		{
+			name:           "Policy",
+			desiredStatus:  NoStatus,
+			desiredMessage: "Containers Running In Privileged Mode",
+			obj: &pacv2beta2.Policy{
+				Spec: pacv2beta2.PolicySpec{
+					ID:       "weave.policies.containers-running-in-privileged-mode",
+					Name:     "Containers Running In Privileged Mode",
+					Severity: "high",
+				},
+			},
+			objectKind: PolicyObjectKind,
+		},
+		{
+			name:           "PolicyConfig with OK status",
+			desiredStatus:  Success,
+			desiredMessage: "",
+			obj: &pacv2beta2.PolicyConfig{
+				Status: pacv2beta2.PolicyConfigStatus{
+					Status: "OK",
+				},
+			},
+			objectKind: PolicyConfigObjectKind,
+		},
+		{
+			name:           "PolicyConfig with missing policies",
+			desiredStatus:  Failed,
+			desiredMessage: "Missing policies: policy-1, policy-2",
+			obj: &pacv2beta2.PolicyConfig{
+				Status: pacv2beta2.PolicyConfigStatus{
+					Status:          "Warning",
+					MissingPolicies: []string{"policy-1", "policy-2"},
+				},
+			},
+			objectKind: PolicyConfigObjectKind,
 		},
 		{
 			name:           "AutomatedClusterDiscovery with Ready condition",
@@ -280,14 +404,14 @@ func TestStatusAndMessage(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := defaultStatusFunc(tt.obj, tt.objectKind)
+			got, err := tt.objectKind.StatusFunc(tt.obj, tt.objectKind)
 			assert.NoError(t, err)
 
 			if got != tt.desiredStatus {
 				t.Errorf("Status() = %v, want %v", got, tt.desiredStatus)
 			}
 
-			msg, err := defaultMessageFunc(tt.obj, tt.objectKind)
+			msg, err := tt.objectKind.MessageFunc(tt.obj, tt.objectKind)
 			assert.NoError(t, err)
 
 			if msg != tt.desiredMessage {
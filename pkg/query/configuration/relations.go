@@ -0,0 +1,145 @@
+package configuration
+
+import (
+	"strings"
+
+	helmv2beta1 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	sourcev1beta2 "github.com/fluxcd/source-controller/api/v1beta2"
+	gitopssets "github.com/weaveworks/gitopssets-controller/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// RelationDirection tells whether an object depends on the object it references, or the other way around.
+type RelationDirection string
+
+const (
+	// RelationUpstream references an object that the object depends on, like the source of a Kustomization.
+	RelationUpstream RelationDirection = "upstream"
+	// RelationDownstream references an object that depends on the object, like a workload applied by a Kustomization.
+	RelationDownstream RelationDirection = "downstream"
+)
+
+// ObjectReference references another object in the same cluster. The version is left out
+// as references don't always have it, and any version of the object is the same object.
+type ObjectReference struct {
+	Namespace string
+	APIGroup  string
+	Kind      string
+	Name      string
+	Direction RelationDirection
+}
+
+func kustomizationRelations(obj client.Object) []ObjectReference {
+	ks, ok := obj.(*kustomizev1.Kustomization)
+	if !ok {
+		return nil
+	}
+
+	refs := []ObjectReference{
+		sourceReference(ks.Spec.SourceRef.APIVersion, ks.Spec.SourceRef.Kind, ks.Spec.SourceRef.Namespace, ks.Spec.SourceRef.Name, ks.Namespace),
+	}
+
+	if ks.Status.Inventory != nil {
+		for _, entry := range ks.Status.Inventory.Entries {
+			if ref, ok := inventoryReference(entry.ID); ok {
+				refs = append(refs, ref)
+			}
+		}
+	}
+
+	return refs
+}
+
+func helmReleaseRelations(obj client.Object) []ObjectReference {
+	hr, ok := obj.(*helmv2beta1.HelmRelease)
+	if !ok {
+		return nil
+	}
+
+	// the chart is created by the controller out of the release chart template,
+	// so the release only knows its name once it has been reconciled.
+	namespace, name := hr.Status.GetHelmChart()
+	if name == "" {
+		namespace, name = hr.Spec.Chart.GetNamespace(hr.Namespace), hr.GetHelmChartName()
+	}
+
+	return []ObjectReference{
+		{
+			Namespace: namespace,
+			APIGroup:  sourcev1beta2.GroupVersion.Group,
+			Kind:      sourcev1beta2.HelmChartKind,
+			Name:      name,
+			Direction: RelationUpstream,
+		},
+	}
+}
+
+func helmChartRelations(obj client.Object) []ObjectReference {
+	chart, ok := obj.(*sourcev1beta2.HelmChart)
+	if !ok {
+		return nil
+	}
+
+	return []ObjectReference{
+		sourceReference(chart.Spec.SourceRef.APIVersion, chart.Spec.SourceRef.Kind, "", chart.Spec.SourceRef.Name, chart.Namespace),
+	}
+}
+
+func gitOpsSetRelations(obj client.Object) []ObjectReference {
+	gs, ok := obj.(*gitopssets.GitOpsSet)
+	if !ok || gs.Status.Inventory == nil {
+		return nil
+	}
+
+	refs := []ObjectReference{}
+	for _, entry := range gs.Status.Inventory.Entries {
+		if ref, ok := inventoryReference(entry.ID); ok {
+			refs = append(refs, ref)
+		}
+	}
+
+	return refs
+}
+
+// sourceReference references the Flux source of an object. Sources are in the object namespace
+// and in the source API group unless said otherwise.
+func sourceReference(apiVersion, kind, namespace, name, defaultNamespace string) ObjectReference {
+	group := sourcev1.GroupVersion.Group
+	if apiVersion != "" {
+		if gv, err := schema.ParseGroupVersion(apiVersion); err == nil {
+			group = gv.Group
+		}
+	}
+
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+
+	return ObjectReference{
+		Namespace: namespace,
+		APIGroup:  group,
+		Kind:      kind,
+		Name:      name,
+		Direction: RelationUpstream,
+	}
+}
+
+// inventoryReference references an object applied by a Flux inventory, whose entries have
+// ids in the format '<namespace>_<name>_<group>_<kind>'.
+func inventoryReference(id string) (ObjectReference, bool) {
+	parts := strings.Split(id, "_")
+	if len(parts) != 4 || parts[1] == "" || parts[3] == "" {
+		return ObjectReference{}, false
+	}
+
+	return ObjectReference{
+		Namespace: parts[0],
+		Name:      parts[1],
+		APIGroup:  parts[2],
+		Kind:      parts[3],
+		Direction: RelationDownstream,
+	}, true
+}
@@ -0,0 +1,127 @@
+package configuration
+
+import (
+	"testing"
+
+	helmv2beta1 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	sourcev1beta2 "github.com/fluxcd/source-controller/api/v1beta2"
+	. "github.com/onsi/gomega"
+	gitopssets "github.com/weaveworks/gitopssets-controller/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestGetRelationsFunc(t *testing.T) {
+	g := NewWithT(t)
+
+	meta := metav1.ObjectMeta{Name: "podinfo", Namespace: "apps"}
+
+	tests := []struct {
+		name       string
+		objectKind ObjectKind
+		obj        client.Object
+		expected   []ObjectReference
+	}{
+		{
+			name:       "kustomization source and inventory",
+			objectKind: KustomizationObjectKind,
+			obj: &kustomizev1.Kustomization{
+				ObjectMeta: meta,
+				Spec: kustomizev1.KustomizationSpec{
+					SourceRef: kustomizev1.CrossNamespaceSourceReference{Kind: "GitRepository", Name: "flux-system", Namespace: "flux-system"},
+				},
+				Status: kustomizev1.KustomizationStatus{
+					Inventory: &kustomizev1.ResourceInventory{Entries: []kustomizev1.ResourceRef{
+						{ID: "apps_podinfo_apps_Deployment", Version: "v1"},
+						{ID: "_apps__Namespace", Version: "v1"},
+						{ID: "invalid", Version: "v1"},
+					}},
+				},
+			},
+			expected: []ObjectReference{
+				{Namespace: "flux-system", APIGroup: "source.toolkit.fluxcd.io", Kind: "GitRepository", Name: "flux-system", Direction: RelationUpstream},
+				{Namespace: "apps", APIGroup: "apps", Kind: "Deployment", Name: "podinfo", Direction: RelationDownstream},
+				{Namespace: "", APIGroup: "", Kind: "Namespace", Name: "apps", Direction: RelationDownstream},
+			},
+		},
+		{
+			name:       "kustomization source in its namespace",
+			objectKind: KustomizationObjectKind,
+			obj: &kustomizev1.Kustomization{
+				ObjectMeta: meta,
+				Spec: kustomizev1.KustomizationSpec{
+					SourceRef: kustomizev1.CrossNamespaceSourceReference{APIVersion: "source.toolkit.fluxcd.io/v1beta2", Kind: "OCIRepository", Name: "podinfo"},
+				},
+			},
+			expected: []ObjectReference{
+				{Namespace: "apps", APIGroup: "source.toolkit.fluxcd.io", Kind: "OCIRepository", Name: "podinfo", Direction: RelationUpstream},
+			},
+		},
+		{
+			name:       "helm release chart",
+			objectKind: HelmReleaseObjectKind,
+			obj: &helmv2beta1.HelmRelease{
+				ObjectMeta: meta,
+				Status:     helmv2beta1.HelmReleaseStatus{HelmChart: "flux-system/apps-podinfo"},
+			},
+			expected: []ObjectReference{
+				{Namespace: "flux-system", APIGroup: "source.toolkit.fluxcd.io", Kind: "HelmChart", Name: "apps-podinfo", Direction: RelationUpstream},
+			},
+		},
+		{
+			name:       "helm release chart before being reconciled",
+			objectKind: HelmReleaseObjectKind,
+			obj:        &helmv2beta1.HelmRelease{ObjectMeta: meta},
+			expected: []ObjectReference{
+				{Namespace: "apps", APIGroup: "source.toolkit.fluxcd.io", Kind: "HelmChart", Name: "apps-podinfo", Direction: RelationUpstream},
+			},
+		},
+		{
+			name:       "helm chart source",
+			objectKind: HelmChartObjectKind,
+			obj: &sourcev1beta2.HelmChart{
+				ObjectMeta: meta,
+				Spec: sourcev1beta2.HelmChartSpec{
+					SourceRef: sourcev1beta2.LocalHelmChartSourceReference{Kind: "HelmRepository", Name: "podinfo"},
+				},
+			},
+			expected: []ObjectReference{
+				{Namespace: "apps", APIGroup: "source.toolkit.fluxcd.io", Kind: "HelmRepository", Name: "podinfo", Direction: RelationUpstream},
+			},
+		},
+		{
+			name:       "gitopsset inventory",
+			objectKind: GitOpsSetsObjectKind,
+			obj: &gitopssets.GitOpsSet{
+				ObjectMeta: meta,
+				Status: gitopssets.GitOpsSetStatus{
+					Inventory: &gitopssets.ResourceInventory{Entries: []gitopssets.ResourceRef{
+						{ID: "apps_podinfo-dev_kustomize.toolkit.fluxcd.io_Kustomization", Version: "v1"},
+					}},
+				},
+			},
+			expected: []ObjectReference{
+				{Namespace: "apps", APIGroup: "kustomize.toolkit.fluxcd.io", Kind: "Kustomization", Name: "podinfo-dev", Direction: RelationDownstream},
+			},
+		},
+		{
+			name:       "gitopsset without inventory",
+			objectKind: GitOpsSetsObjectKind,
+			obj:        &gitopssets.GitOpsSet{ObjectMeta: meta},
+			expected:   nil,
+		},
+		{
+			name:       "unexpected object",
+			objectKind: KustomizationObjectKind,
+			obj:        &helmv2beta1.HelmRelease{ObjectMeta: meta},
+			expected:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g.Expect(tt.objectKind.GetRelationsFunc(tt.obj)).To(Equal(tt.expected))
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package configuration
+
+import (
+	"fmt"
+	"os"
+
+	"sigs.k8s.io/yaml"
+)
+
+// CuratedQueriesConfig is the format of the file of saved queries that admins publish to everyone,
+// usually mounted from a ConfigMap. Curated queries are read-only. For example:
+//
+//	savedQueries:
+//	  - name: Failing Helm releases
+//	    filters:
+//	      - kind:HelmRelease
+//	      - status:Failed
+//	    orderBy: name
+//	  - name: Production sources
+//	    terms: production
+//	    filters:
+//	      - category:source
+type CuratedQueriesConfig struct {
+	SavedQueries []CuratedQuery `json:"savedQueries"`
+}
+
+// CuratedQuery is a saved query published by admins.
+type CuratedQuery struct {
+	// Name is shown to the users and identifies the query. It must be unique.
+	Name string `json:"name"`
+	// Terms are the search terms of the query, as in DoQuery.
+	Terms string `json:"terms,omitempty"`
+	// Filters are the filters of the query, as in DoQuery, like `kind:HelmRelease`.
+	Filters []string `json:"filters,omitempty"`
+	// OrderBy is the field to order the results by.
+	OrderBy string `json:"orderBy,omitempty"`
+	// Descending orders the results in descending order.
+	Descending bool `json:"descending,omitempty"`
+}
+
+// ReadCuratedQueries reads the saved queries defined in the file at the given path.
+func ReadCuratedQueries(path string) ([]CuratedQuery, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read saved queries file: %w", err)
+	}
+
+	return ParseCuratedQueries(data)
+}
+
+// ParseCuratedQueries parses the YAML or JSON definition of saved queries, see CuratedQueriesConfig.
+func ParseCuratedQueries(data []byte) ([]CuratedQuery, error) {
+	config := CuratedQueriesConfig{}
+	if err := yaml.UnmarshalStrict(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse saved queries: %w", err)
+	}
+
+	seen := map[string]bool{}
+	for _, query := range config.SavedQueries {
+		if query.Name == "" {
+			return nil, fmt.Errorf("invalid saved query: missing name")
+		}
+
+		if seen[query.Name] {
+			return nil, fmt.Errorf("saved query %q is already defined", query.Name)
+		}
+		seen[query.Name] = true
+	}
+
+	return config.SavedQueries, nil
+}
@@ -0,0 +1,76 @@
+package configuration
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestParseCuratedQueries(t *testing.T) {
+	g := NewWithT(t)
+
+	tests := []struct {
+		name     string
+		data     string
+		expected []CuratedQuery
+		err      string
+	}{
+		{
+			name: "saved queries",
+			data: `
+savedQueries:
+  - name: Failing Helm releases
+    filters:
+      - kind:HelmRelease
+      - status:Failed
+    orderBy: name
+  - name: Production sources
+    terms: production
+    descending: true
+`,
+			expected: []CuratedQuery{
+				{Name: "Failing Helm releases", Filters: []string{"kind:HelmRelease", "status:Failed"}, OrderBy: "name"},
+				{Name: "Production sources", Terms: "production", Descending: true},
+			},
+		},
+		{
+			name: "missing name",
+			data: `
+savedQueries:
+  - terms: production
+`,
+			err: "missing name",
+		},
+		{
+			name: "duplicated name",
+			data: `
+savedQueries:
+  - name: Sources
+  - name: Sources
+`,
+			err: `saved query "Sources" is already defined`,
+		},
+		{
+			name: "unknown field",
+			data: `
+savedQueries:
+  - name: Sources
+    visibility: private
+`,
+			err: "unknown field",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queries, err := ParseCuratedQueries([]byte(tt.data))
+			if tt.err != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.err)))
+				return
+			}
+
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(queries).To(Equal(tt.expected))
+		})
+	}
+}
@@ -0,0 +1,408 @@
+package configuration
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/jsonpath"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// UserObjectKindsConfig is the format of the file that defines object kinds in addition to SupportedObjectKinds,
+// so platform teams can collect their own custom resources without code changes. For example:
+//
+//	objectKinds:
+//	  - apiVersion: argoproj.io/v1alpha1
+//	    kind: Rollout
+//	    category: automation
+//	    status:
+//	      jsonPath: "{.status.phase}"
+//	      values:
+//	        Healthy: Success
+//	        Progressing: Reconciling
+//	        Paused: Suspended
+//	        Degraded: Failed
+//	    message:
+//	      jsonPath: "{.status.message}"
+//	    suspended:
+//	      cel: "has(self.spec.paused) && self.spec.paused"
+//	    labels:
+//	      - app.kubernetes.io/part-of
+type UserObjectKindsConfig struct {
+	ObjectKinds []UserObjectKind `json:"objectKinds"`
+}
+
+// UserObjectKind is the declarative definition of an object kind. Status, message and suspended are read from
+// the object with JSONPath or CEL expressions. When an expression is not set, the value is computed like for Flux objects:
+// out of the Ready condition and spec.suspend.
+type UserObjectKind struct {
+	// APIVersion is the group and version of the kind, like `apps.example.com/v1`.
+	APIVersion string `json:"apiVersion"`
+	// Kind is the kind of the objects to collect.
+	Kind string `json:"kind"`
+	// Category groups the objectkind in the UI. It can be an existing category or a new one.
+	Category ObjectCategory `json:"category"`
+	// Status is the expression to get the status of an object.
+	Status *UserStatusExpression `json:"status,omitempty"`
+	// Message is the expression to get the message of an object.
+	Message *UserExpression `json:"message,omitempty"`
+	// Suspended is the expression to get whether an object is suspended. It must evaluate to a boolean.
+	Suspended *UserExpression `json:"suspended,omitempty"`
+	// Labels are the labels to collect and facet on.
+	Labels []string `json:"labels,omitempty"`
+	// ClusterScoped is true for kinds whose objects have no namespace.
+	ClusterScoped bool `json:"clusterScoped,omitempty"`
+}
+
+// UserExpression reads a value out of an object. Exactly one of JSONPath and CEL must be set.
+type UserExpression struct {
+	// JSONPath is a kubectl-like JSONPath template, like `{.status.phase}`. The braces are optional.
+	JSONPath string `json:"jsonPath,omitempty"`
+	// CEL is a Common Expression Language expression, like `self.status.phase`, where `self` is the object.
+	// Unlike JSONPath, missing fields are an error, so optional fields must be guarded with `has()`.
+	CEL string `json:"cel,omitempty"`
+}
+
+// UserStatusExpression reads the status of an object. Exactly one of JSONPath and CEL must be set.
+type UserStatusExpression struct {
+	// JSONPath is a kubectl-like JSONPath template, like `{.status.phase}`. The braces are optional.
+	JSONPath string `json:"jsonPath,omitempty"`
+	// CEL is a Common Expression Language expression, like `self.status.phase`, where `self` is the object.
+	// Unlike JSONPath, missing fields are an error, so optional fields must be guarded with `has()`.
+	CEL string `json:"cel,omitempty"`
+	// Values maps the values returned by the expression to object statuses. Values that are already
+	// an object status, like Success or Failed, do not need to be mapped. Any other value has no status.
+	Values map[string]ObjectStatus `json:"values,omitempty"`
+}
+
+// ReadUserObjectKinds reads the object kinds defined in the file at the given path.
+func ReadUserObjectKinds(path string) ([]ObjectKind, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read object kinds file: %w", err)
+	}
+
+	return ParseUserObjectKinds(data)
+}
+
+// ParseUserObjectKinds parses the YAML or JSON definition of object kinds, see UserObjectKindsConfig.
+func ParseUserObjectKinds(data []byte) ([]ObjectKind, error) {
+	config := UserObjectKindsConfig{}
+	if err := yaml.UnmarshalStrict(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse object kinds: %w", err)
+	}
+
+	seen := map[schema.GroupVersionKind]bool{}
+	for _, objectKind := range SupportedObjectKinds {
+		seen[objectKind.Gvk] = true
+	}
+
+	objectKinds := []ObjectKind{}
+	for _, userObjectKind := range config.ObjectKinds {
+		objectKind, err := userObjectKind.ToObjectKind()
+		if err != nil {
+			return nil, fmt.Errorf("invalid object kind %s/%s: %w", userObjectKind.APIVersion, userObjectKind.Kind, err)
+		}
+
+		if seen[objectKind.Gvk] {
+			return nil, fmt.Errorf("object kind %s is already defined", objectKind.Gvk)
+		}
+		seen[objectKind.Gvk] = true
+
+		objectKinds = append(objectKinds, objectKind)
+	}
+
+	return objectKinds, nil
+}
+
+// ToObjectKind converts the definition into an ObjectKind. Objects of the kind are collected as unstructured objects.
+func (u UserObjectKind) ToObjectKind() (ObjectKind, error) {
+	if u.APIVersion == "" {
+		return ObjectKind{}, fmt.Errorf("missing api version")
+	}
+	if u.Kind == "" {
+		return ObjectKind{}, fmt.Errorf("missing kind")
+	}
+	if u.Category == "" {
+		return ObjectKind{}, fmt.Errorf("missing category")
+	}
+
+	gv, err := schema.ParseGroupVersion(u.APIVersion)
+	if err != nil {
+		return ObjectKind{}, fmt.Errorf("invalid api version: %w", err)
+	}
+	gvk := gv.WithKind(u.Kind)
+
+	var status, message, suspended userExpressionFunc
+	if u.Status != nil {
+		if status, err = compileUserExpression(u.Status.JSONPath, u.Status.CEL); err != nil {
+			return ObjectKind{}, fmt.Errorf("invalid status expression: %w", err)
+		}
+		for value, status := range u.Status.Values {
+			if !isObjectStatus(status) {
+				return ObjectKind{}, fmt.Errorf("invalid status %q for value %q", status, value)
+			}
+		}
+	}
+	if u.Message != nil {
+		if message, err = compileUserExpression(u.Message.JSONPath, u.Message.CEL); err != nil {
+			return ObjectKind{}, fmt.Errorf("invalid message expression: %w", err)
+		}
+	}
+	if u.Suspended != nil {
+		if suspended, err = compileUserExpression(u.Suspended.JSONPath, u.Suspended.CEL); err != nil {
+			return ObjectKind{}, fmt.Errorf("invalid suspended expression: %w", err)
+		}
+	}
+
+	objectKind := ObjectKind{
+		Gvk: gvk,
+		NewClientObjectFunc: func() client.Object {
+			obj := &unstructured.Unstructured{}
+			obj.SetGroupVersionKind(gvk)
+			return obj
+		},
+		// unstructured objects do not need to be registered
+		AddToSchemeFunc: func(*runtime.Scheme) error {
+			return nil
+		},
+		GetConditionsFunc: unstructuredConditions,
+		GetSuspendedFunc: func(obj client.Object) (bool, error) {
+			if suspended == nil {
+				return unstructuredSpecSuspend(obj)
+			}
+
+			value, err := suspended(obj)
+			if err != nil {
+				return false, err
+			}
+
+			switch value := value.(type) {
+			case bool:
+				return value, nil
+			case string:
+				if value == "" {
+					return false, nil
+				}
+				suspended, err := strconv.ParseBool(value)
+				if err != nil {
+					return false, fmt.Errorf("suspended expression is not a boolean: %w", err)
+				}
+				return suspended, nil
+			default:
+				return false, fmt.Errorf("suspended expression is not a boolean: %v", value)
+			}
+		},
+		StatusFunc: func(obj client.Object, objectKind ObjectKind) (ObjectStatus, error) {
+			if status == nil {
+				return defaultStatusFunc(obj, objectKind)
+			}
+
+			suspended, err := objectKind.GetSuspendedFunc(obj)
+			if err != nil {
+				return "", fmt.Errorf("getting suspended object status: %w", err)
+			}
+			if suspended {
+				return Suspended, nil
+			}
+
+			result, err := status(obj)
+			if err != nil {
+				return "", err
+			}
+
+			value := expressionString(result)
+			if status, ok := u.Status.Values[value]; ok {
+				return status, nil
+			}
+			if isObjectStatus(ObjectStatus(value)) {
+				return ObjectStatus(value), nil
+			}
+
+			return NoStatus, nil
+		},
+		MessageFunc: func(obj client.Object, objectKind ObjectKind) (string, error) {
+			if message == nil {
+				return defaultMessageFunc(obj, objectKind)
+			}
+
+			result, err := message(obj)
+			if err != nil {
+				return "", err
+			}
+			return expressionString(result), nil
+		},
+		Labels:        u.Labels,
+		Category:      u.Category,
+		ClusterScoped: u.ClusterScoped,
+	}
+
+	if err := objectKind.Validate(); err != nil {
+		return ObjectKind{}, err
+	}
+
+	return objectKind, nil
+}
+
+func isObjectStatus(status ObjectStatus) bool {
+	switch status {
+	case Success, Failed, Reconciling, Suspended, PendingAction, NoStatus:
+		return true
+	}
+	return false
+}
+
+// userExpressionFunc evaluates a user expression against an object. JSONPath expressions evaluate to strings,
+// CEL expressions to the native Go value of their result.
+type userExpressionFunc func(obj client.Object) (interface{}, error)
+
+// compileUserExpression compiles either a JSONPath or a CEL expression, exactly one of them must be set.
+func compileUserExpression(jsonPath, celExpression string) (userExpressionFunc, error) {
+	switch {
+	case jsonPath != "" && celExpression != "":
+		return nil, fmt.Errorf("only one of jsonPath and cel can be set")
+	case strings.TrimSpace(jsonPath) == "" && strings.TrimSpace(celExpression) == "":
+		return nil, fmt.Errorf("missing jsonPath or cel")
+	case celExpression != "":
+		return compileCEL(celExpression)
+	}
+
+	if _, err := parseJSONPath(jsonPath); err != nil {
+		return nil, err
+	}
+
+	return func(obj client.Object) (interface{}, error) {
+		return evaluateJSONPath(jsonPath, obj)
+	}, nil
+}
+
+// compileCEL compiles a CEL expression, where the object is bound to `self` like in Kubernetes validation rules.
+// Unlike JSONPath, CEL programs are safe for concurrent use, so they are compiled once.
+func compileCEL(expression string) (userExpressionFunc, error) {
+	env, err := cel.NewEnv(cel.Variable("self", cel.DynType))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cel environment: %w", err)
+	}
+
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+
+	program, err := env.Program(ast)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cel program: %w", err)
+	}
+
+	return func(obj client.Object) (interface{}, error) {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert object to unstructured: %w", err)
+		}
+
+		result, _, err := program.Eval(map[string]interface{}{"self": content})
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate %q: %w", expression, err)
+		}
+
+		return result.Value(), nil
+	}, nil
+}
+
+// expressionString formats the result of an expression the way JSONPath prints values.
+func expressionString(value interface{}) string {
+	switch value := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+// parseJSONPath parses a kubectl-like JSONPath template. JSONPath is not safe for concurrent use, so expressions
+// are parsed again on every evaluation.
+func parseJSONPath(expression string) (*jsonpath.JSONPath, error) {
+	if strings.TrimSpace(expression) == "" {
+		return nil, fmt.Errorf("missing jsonPath")
+	}
+
+	if !strings.HasPrefix(expression, "{") {
+		expression = fmt.Sprintf("{%s}", expression)
+	}
+
+	jp := jsonpath.New("").AllowMissingKeys(true)
+	if err := jp.Parse(expression); err != nil {
+		return nil, err
+	}
+
+	return jp, nil
+}
+
+func evaluateJSONPath(expression string, obj client.Object) (string, error) {
+	jp, err := parseJSONPath(expression)
+	if err != nil {
+		return "", err
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return "", fmt.Errorf("failed to convert object to unstructured: %w", err)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := jp.Execute(buf, content); err != nil {
+		return "", fmt.Errorf("failed to evaluate %q: %w", expression, err)
+	}
+
+	return strings.TrimSpace(buf.String()), nil
+}
+
+func unstructuredConditions(obj client.Object) ([]metav1.Condition, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert object to unstructured: %w", err)
+	}
+
+	raw, found, err := unstructured.NestedSlice(content, "status", "conditions")
+	if err != nil || !found {
+		return nil, err
+	}
+
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal conditions: %w", err)
+	}
+
+	conditions := []metav1.Condition{}
+	if err := json.Unmarshal(data, &conditions); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal conditions: %w", err)
+	}
+
+	return conditions, nil
+}
+
+func unstructuredSpecSuspend(obj client.Object) (bool, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return false, fmt.Errorf("failed to convert object to unstructured: %w", err)
+	}
+
+	suspended, _, err := unstructured.NestedBool(content, "spec", "suspend")
+	if err != nil {
+		return false, nil
+	}
+
+	return suspended, nil
+}
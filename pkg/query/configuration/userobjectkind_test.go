@@ -0,0 +1,336 @@
+package configuration
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const rolloutKinds = `
+objectKinds:
+  - apiVersion: argoproj.io/v1alpha1
+    kind: Rollout
+    category: automation
+    status:
+      jsonPath: "{.status.phase}"
+      values:
+        Healthy: Success
+        Progressing: Reconciling
+        Degraded: Failed
+    message:
+      jsonPath: ".status.message"
+    suspended:
+      jsonPath: "{.spec.paused}"
+    labels:
+      - app.kubernetes.io/part-of
+  - apiVersion: example.com/v1
+    kind: Widget
+    category: widgets
+`
+
+func newRollout(phase, message string, paused bool) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "argoproj.io/v1alpha1",
+			"kind":       "Rollout",
+			"metadata": map[string]interface{}{
+				"name":      "rollout",
+				"namespace": "default",
+			},
+			"spec": map[string]interface{}{
+				"paused": paused,
+			},
+			"status": map[string]interface{}{
+				"phase":   phase,
+				"message": message,
+			},
+		},
+	}
+}
+
+func TestParseUserObjectKinds(t *testing.T) {
+	g := NewWithT(t)
+
+	objectKinds, err := ParseUserObjectKinds([]byte(rolloutKinds))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(objectKinds).To(HaveLen(2))
+
+	rollout := objectKinds[0]
+	g.Expect(rollout.Gvk).To(Equal(schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "Rollout"}))
+	g.Expect(rollout.Category).To(Equal(CategoryAutomation))
+	g.Expect(rollout.Labels).To(ConsistOf("app.kubernetes.io/part-of"))
+	g.Expect(rollout.NewClientObjectFunc().GetObjectKind().GroupVersionKind()).To(Equal(rollout.Gvk))
+
+	widget := objectKinds[1]
+	g.Expect(widget.Category).To(Equal(ObjectCategory("widgets")))
+
+	t.Run("should fail for invalid definitions", func(t *testing.T) {
+		tests := []struct {
+			name       string
+			definition string
+			errPattern string
+		}{
+			{
+				name:       "missing kind",
+				definition: "objectKinds: [{apiVersion: example.com/v1, category: widgets}]",
+				errPattern: "missing kind",
+			},
+			{
+				name:       "missing category",
+				definition: "objectKinds: [{apiVersion: example.com/v1, kind: Widget}]",
+				errPattern: "missing category",
+			},
+			{
+				name:       "invalid api version",
+				definition: "objectKinds: [{apiVersion: example.com/v1/v2, kind: Widget, category: widgets}]",
+				errPattern: "invalid api version",
+			},
+			{
+				name:       "invalid jsonpath",
+				definition: "objectKinds: [{apiVersion: example.com/v1, kind: Widget, category: widgets, message: {jsonPath: '{.status[}'}}]",
+				errPattern: "invalid message expression",
+			},
+			{
+				name:       "invalid cel",
+				definition: "objectKinds: [{apiVersion: example.com/v1, kind: Widget, category: widgets, message: {cel: 'self.status.'}}]",
+				errPattern: "invalid message expression",
+			},
+			{
+				name:       "jsonpath and cel",
+				definition: "objectKinds: [{apiVersion: example.com/v1, kind: Widget, category: widgets, message: {jsonPath: '{.status.message}', cel: 'self.status.message'}}]",
+				errPattern: "only one of jsonPath and cel",
+			},
+			{
+				name:       "missing expression",
+				definition: "objectKinds: [{apiVersion: example.com/v1, kind: Widget, category: widgets, suspended: {}}]",
+				errPattern: "missing jsonPath or cel",
+			},
+			{
+				name:       "invalid status value",
+				definition: "objectKinds: [{apiVersion: example.com/v1, kind: Widget, category: widgets, status: {jsonPath: '{.status.phase}', values: {Ok: Great}}}]",
+				errPattern: "invalid status",
+			},
+			{
+				name:       "unknown field",
+				definition: "objectKinds: [{apiVersion: example.com/v1, kind: Widget, category: widgets, colour: blue}]",
+				errPattern: "failed to parse object kinds",
+			},
+			{
+				name:       "duplicated kind",
+				definition: "objectKinds: [{apiVersion: example.com/v1, kind: Widget, category: widgets}, {apiVersion: example.com/v1, kind: Widget, category: widgets}]",
+				errPattern: "already defined",
+			},
+			{
+				name:       "supported kind",
+				definition: "objectKinds: [{apiVersion: helm.toolkit.fluxcd.io/v2beta1, kind: HelmRelease, category: automation}]",
+				errPattern: "already defined",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := ParseUserObjectKinds([]byte(tt.definition))
+				g.Expect(err).To(MatchError(MatchRegexp(tt.errPattern)))
+			})
+		}
+	})
+}
+
+func TestReadUserObjectKinds(t *testing.T) {
+	g := NewWithT(t)
+
+	path := filepath.Join(t.TempDir(), "kinds.yaml")
+	g.Expect(os.WriteFile(path, []byte(rolloutKinds), 0600)).To(Succeed())
+
+	objectKinds, err := ReadUserObjectKinds(path)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(objectKinds).To(HaveLen(2))
+
+	_, err = ReadUserObjectKinds(filepath.Join(t.TempDir(), "missing.yaml"))
+	g.Expect(err).To(MatchError(MatchRegexp("failed to read object kinds file")))
+}
+
+func TestUserObjectKind_StatusAndMessage(t *testing.T) {
+	g := NewWithT(t)
+
+	objectKinds, err := ParseUserObjectKinds([]byte(rolloutKinds))
+	g.Expect(err).NotTo(HaveOccurred())
+	rollout, widget := objectKinds[0], objectKinds[1]
+
+	newWidget := func(ready string, suspend bool) *unstructured.Unstructured {
+		return &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "example.com/v1",
+				"kind":       "Widget",
+				"metadata": map[string]interface{}{
+					"name":      "widget",
+					"namespace": "default",
+				},
+				"spec": map[string]interface{}{
+					"suspend": suspend,
+				},
+				"status": map[string]interface{}{
+					"conditions": []interface{}{
+						map[string]interface{}{
+							"type":               "Ready",
+							"status":             ready,
+							"reason":             "Reconciled",
+							"message":            "widget is ready",
+							"lastTransitionTime": "2023-01-01T00:00:00Z",
+						},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name            string
+		objectKind      ObjectKind
+		object          *unstructured.Unstructured
+		expectedStatus  ObjectStatus
+		expectedMessage string
+	}{
+		{
+			name:            "mapped status",
+			objectKind:      rollout,
+			object:          newRollout("Healthy", "all good", false),
+			expectedStatus:  Success,
+			expectedMessage: "all good",
+		},
+		{
+			name:            "mapped failed status",
+			objectKind:      rollout,
+			object:          newRollout("Degraded", "missing replicas", false),
+			expectedStatus:  Failed,
+			expectedMessage: "missing replicas",
+		},
+		{
+			name:            "unmapped status",
+			objectKind:      rollout,
+			object:          newRollout("Unknown", "", false),
+			expectedStatus:  NoStatus,
+			expectedMessage: "",
+		},
+		{
+			name:            "suspended",
+			objectKind:      rollout,
+			object:          newRollout("Healthy", "paused", true),
+			expectedStatus:  Suspended,
+			expectedMessage: "paused",
+		},
+		{
+			name:            "ready condition",
+			objectKind:      widget,
+			object:          newWidget("True", false),
+			expectedStatus:  Success,
+			expectedMessage: "widget is ready",
+		},
+		{
+			name:            "not ready condition",
+			objectKind:      widget,
+			object:          newWidget("False", false),
+			expectedStatus:  Failed,
+			expectedMessage: "widget is ready",
+		},
+		{
+			name:            "suspended with spec suspend",
+			objectKind:      widget,
+			object:          newWidget("True", true),
+			expectedStatus:  Suspended,
+			expectedMessage: "widget is ready",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, err := tt.objectKind.StatusFunc(tt.object, tt.objectKind)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(status).To(Equal(tt.expectedStatus))
+
+			message, err := tt.objectKind.MessageFunc(tt.object, tt.objectKind)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(message).To(Equal(tt.expectedMessage))
+		})
+	}
+}
+
+func TestUserObjectKind_CEL(t *testing.T) {
+	g := NewWithT(t)
+
+	objectKinds, err := ParseUserObjectKinds([]byte(`
+objectKinds:
+  - apiVersion: argoproj.io/v1alpha1
+    kind: Rollout
+    category: automation
+    status:
+      cel: "self.status.phase == 'Healthy' ? 'Success' : self.status.phase"
+      values:
+        Degraded: Failed
+    message:
+      cel: "has(self.status.message) ? self.status.message : 'no message'"
+    suspended:
+      cel: "self.spec.paused"
+`))
+	g.Expect(err).NotTo(HaveOccurred())
+	rollout := objectKinds[0]
+
+	tests := []struct {
+		name            string
+		object          *unstructured.Unstructured
+		expectedStatus  ObjectStatus
+		expectedMessage string
+	}{
+		{
+			name:            "status computed by the expression",
+			object:          newRollout("Healthy", "all good", false),
+			expectedStatus:  Success,
+			expectedMessage: "all good",
+		},
+		{
+			name:            "mapped status",
+			object:          newRollout("Degraded", "missing replicas", false),
+			expectedStatus:  Failed,
+			expectedMessage: "missing replicas",
+		},
+		{
+			name:            "suspended",
+			object:          newRollout("Healthy", "paused", true),
+			expectedStatus:  Suspended,
+			expectedMessage: "paused",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, err := rollout.StatusFunc(tt.object, rollout)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(status).To(Equal(tt.expectedStatus))
+
+			message, err := rollout.MessageFunc(tt.object, rollout)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(message).To(Equal(tt.expectedMessage))
+		})
+	}
+
+	t.Run("missing fields guarded with has", func(t *testing.T) {
+		object := newRollout("Healthy", "", false)
+		unstructured.RemoveNestedField(object.Object, "status", "message")
+
+		message, err := rollout.MessageFunc(object, rollout)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(message).To(Equal("no message"))
+	})
+
+	t.Run("non boolean suspended expression", func(t *testing.T) {
+		objectKinds, err := ParseUserObjectKinds([]byte("objectKinds: [{apiVersion: example.com/v1, kind: Widget, category: widgets, suspended: {cel: 'self.metadata.name'}}]"))
+		g.Expect(err).NotTo(HaveOccurred())
+
+		_, err = objectKinds[0].GetSuspendedFunc(newRollout("Healthy", "", false))
+		g.Expect(err).To(MatchError(MatchRegexp("not a boolean")))
+	})
+}
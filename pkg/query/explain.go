@@ -0,0 +1,45 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+)
+
+func (q *qs) ExplainAccess(ctx context.Context, principal *auth.UserPrincipal, id string, node models.ObjectNode) (models.AccessExplanation, error) {
+	if principal == nil || principal.ID == "" {
+		return models.AccessExplanation{}, fmt.Errorf("principal to explain is required")
+	}
+
+	caller, roles, bindings, err := q.requireAdmin(ctx)
+	if err != nil {
+		return models.AccessExplanation{}, err
+	}
+
+	// objects that are not collected, like workloads, are explained from their reference
+	obj := node.Object()
+	if id != "" {
+		iter, err := q.r.GetObjects(ctx, []string{id}, nil)
+		if err != nil {
+			return models.AccessExplanation{}, fmt.Errorf("error fetching object from the store: %w", err)
+		}
+		defer iter.Close()
+
+		objects, err := iter.All()
+		if err != nil {
+			return models.AccessExplanation{}, fmt.Errorf("error fetching object from the store: %w", err)
+		}
+
+		if len(objects) == 0 {
+			return models.AccessExplanation{}, ErrObjectNotFound
+		}
+		obj = objects[0]
+	}
+
+	explanation := q.explainer.ExplainAccess(roles, bindings, principal, obj)
+	q.log.Info("access explained", "caller", caller.ID, "principal", principal.ID, "object", obj.GetID(), "allowed", explanation.Allowed)
+
+	return explanation, nil
+}
@@ -0,0 +1,45 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	store "github.com/weaveworks/weave-gitops-enterprise/pkg/query/store"
+)
+
+func (q *qs) RebuildIndex(ctx context.Context) (int, error) {
+	principal, _, _, err := q.requireAdmin(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if q.maintainer == nil {
+		return 0, fmt.Errorf("index cannot be rebuilt")
+	}
+
+	q.log.Info("rebuilding index", "principal", principal.ID)
+
+	count, err := q.maintainer.Reindex(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to rebuild index: %w", err)
+	}
+
+	return count, nil
+}
+
+func (q *qs) CheckIndex(ctx context.Context) (store.IndexConsistency, error) {
+	if _, _, _, err := q.requireAdmin(ctx); err != nil {
+		return store.IndexConsistency{}, err
+	}
+
+	if q.maintainer == nil {
+		return store.IndexConsistency{}, fmt.Errorf("index cannot be checked")
+	}
+
+	consistency, err := q.maintainer.CheckConsistency(ctx)
+	if err != nil {
+		return store.IndexConsistency{}, fmt.Errorf("failed to check index: %w", err)
+	}
+
+	return consistency, nil
+}
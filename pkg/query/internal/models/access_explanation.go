@@ -0,0 +1,23 @@
+package models
+
+// AccessExplanation tells why a principal can or cannot see an object.
+// It is not stored in the database and is computed from the collected roles and role bindings.
+type AccessExplanation struct {
+	Allowed bool
+	// Grants are the rules that let the principal see the object.
+	Grants []AccessGrant
+	// NearMisses are the rules closest to letting the principal see the object, when it cannot.
+	NearMisses []AccessGrant
+	// Error is why the rules of the principal could not all be resolved, like a binding to a missing role.
+	// Objects are not shown when it is set.
+	Error string
+}
+
+// AccessGrant is a policy rule of a role, bound to subjects by a role binding.
+type AccessGrant struct {
+	Role        Role
+	RoleBinding RoleBinding
+	Rule        PolicyRule
+	// Mismatches are why the grant does not let the principal see the object. They are empty for grants that do.
+	Mismatches []string
+}
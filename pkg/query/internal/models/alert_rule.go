@@ -0,0 +1,130 @@
+package models
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// AlertWebhookFormat is the payload sent to the webhook of an alert rule.
+type AlertWebhookFormat string
+
+const (
+	// AlertWebhookAlertmanager posts one Alertmanager alert per object, like to the /api/v2/alerts endpoint.
+	AlertWebhookAlertmanager AlertWebhookFormat = "alertmanager"
+	// AlertWebhookJSON posts the rule, its state and its objects as a single JSON document.
+	AlertWebhookJSON AlertWebhookFormat = "json"
+)
+
+// AlertState is the state of an alert rule.
+type AlertState string
+
+const (
+	// AlertInactive rules match no objects.
+	AlertInactive AlertState = "inactive"
+	// AlertPending rules match objects, but for less time than the rule requires.
+	AlertPending AlertState = "pending"
+	// AlertFiring rules match objects for at least the time the rule requires.
+	AlertFiring AlertState = "firing"
+	// AlertResolved is notified when a firing rule stops firing, which then becomes pending or inactive.
+	AlertResolved AlertState = "resolved"
+)
+
+// AlertRule notifies a webhook when objects match a query for some time.
+type AlertRule struct {
+	ID      string   `gorm:"primaryKey;autoIncrement:false"`
+	Name    string   `json:"name" gorm:"type:text"`
+	Terms   string   `json:"terms" gorm:"type:text"`
+	Filters []string `json:"filters" gorm:"serializer:json"`
+	// For is how long an object matches before the rule fires for it.
+	For           time.Duration      `json:"for" gorm:"column:for_duration"`
+	WebhookURL    string             `json:"webhookURL" gorm:"type:text"`
+	WebhookFormat AlertWebhookFormat `json:"webhookFormat" gorm:"type:text"`
+	// Owner and OwnerGroups are the principal that the query is run as, with the current access rules.
+	// OwnerGroups are the groups of the owner when they last saved the rule, as groups are only known
+	// from the requests of a user. They are only used for a while after the rule is saved, as the owner may
+	// leave them, and the rule is not evaluated while the owner is not an admin.
+	Owner       string    `json:"owner" gorm:"type:text"`
+	OwnerGroups []string  `json:"ownerGroups" gorm:"serializer:json"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+	// State is the state of the rule when it was last evaluated. It is stored apart from the rule.
+	State AlertRuleState `json:"state" gorm:"-"`
+}
+
+func (r AlertRule) Validate() error {
+	if r.Name == "" {
+		return fmt.Errorf("missing name")
+	}
+
+	if r.Terms == "" && len(r.Filters) == 0 {
+		return fmt.Errorf("missing terms or filters")
+	}
+
+	if r.For < 0 {
+		return fmt.Errorf("for cannot be negative")
+	}
+
+	u, err := url.Parse(r.WebhookURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid webhook url %q", r.WebhookURL)
+	}
+
+	switch r.WebhookFormat {
+	case AlertWebhookAlertmanager, AlertWebhookJSON:
+	default:
+		return fmt.Errorf("invalid webhook format %q: use alertmanager or json", r.WebhookFormat)
+	}
+
+	return nil
+}
+
+func (r AlertRule) GetTerms() string {
+	return r.Terms
+}
+
+func (r AlertRule) GetFilters() []string {
+	return r.Filters
+}
+
+// AlertRuleState tracks the objects matching an alert rule, and the ones it fired for.
+type AlertRuleState struct {
+	RuleID string     `gorm:"primaryKey;autoIncrement:false"`
+	State  AlertState `json:"state" gorm:"type:text"`
+	// Matching are the ids of the matching objects, with when they started matching.
+	Matching map[string]time.Time `json:"matching" gorm:"serializer:json"`
+	// Firing are the objects the webhook was last notified of as firing.
+	Firing          []AlertObject `json:"firing" gorm:"serializer:json"`
+	FiringSince     time.Time     `json:"firingSince"`
+	ResolvedAt      time.Time     `json:"resolvedAt"`
+	LastEvaluatedAt time.Time     `json:"lastEvaluatedAt"`
+	LastNotifiedAt  time.Time     `json:"lastNotifiedAt"`
+	// LastError is why the rule could not be evaluated or notified, if it could not.
+	LastError string `json:"lastError" gorm:"type:text"`
+}
+
+// AlertObject is an object that an alert rule fires for.
+type AlertObject struct {
+	ID        string `json:"id"`
+	Cluster   string `json:"cluster"`
+	Namespace string `json:"namespace"`
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Status    string `json:"status"`
+	Message   string `json:"message"`
+	// Since is when the object started matching.
+	Since time.Time `json:"since"`
+}
+
+func NewAlertObject(obj Object, since time.Time) AlertObject {
+	return AlertObject{
+		ID:        obj.GetID(),
+		Cluster:   obj.Cluster,
+		Namespace: obj.Namespace,
+		Kind:      obj.Kind,
+		Name:      obj.Name,
+		Status:    obj.Status,
+		Message:   obj.Message,
+		Since:     since,
+	}
+}
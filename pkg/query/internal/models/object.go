@@ -25,9 +25,13 @@ type Object struct {
 	Message             string                       `json:"message" gorm:"type:text"`
 	Category            configuration.ObjectCategory `json:"category" gorm:"type:text"`
 	KubernetesDeletedAt time.Time                    `json:"kubernetesDeletedAt"`
-	Unstructured        json.RawMessage              `json:"unstructured" gorm:"type:blob"`
+	Unstructured        json.RawMessage              `json:"unstructured"`
 	Tenant              string                       `json:"tenant" gorm:"type:text"`
 	Labels              map[string]string            `json:"labels" gorm:"-"`
+	Relations           []ObjectRelation             `json:"-" gorm:"-"`
+	// ClusterScoped is true for objects of kinds that have no namespace. It is set from the
+	// ObjectKind when the object is collected, and is not stored.
+	ClusterScoped bool `json:"-" gorm:"-"`
 }
 
 func (o Object) Validate() error {
@@ -37,7 +41,7 @@ func (o Object) Validate() error {
 	if o.Name == "" {
 		return fmt.Errorf("missing name field")
 	}
-	if o.Namespace == "" {
+	if o.Namespace == "" && !o.ClusterScoped {
 		return fmt.Errorf("missing namespace field")
 	}
 	if o.APIVersion == "" {
@@ -58,6 +62,19 @@ func (o *Object) GetID() string {
 	return fmt.Sprintf("%s/%s/%s/%s", o.Cluster, o.Namespace, o.GroupVersionKind(), o.Name)
 }
 
+// ClientObjectJSON returns the JSON of the collected client object. The collector marshals the normalized
+// object, that embeds the client object, so it is unwrapped when present.
+func (o Object) ClientObjectJSON() json.RawMessage {
+	normalized := struct {
+		Object json.RawMessage
+	}{}
+	if err := json.Unmarshal(o.Unstructured, &normalized); err == nil && len(normalized.Object) > 0 {
+		return normalized.Object
+	}
+
+	return o.Unstructured
+}
+
 func (o *Object) String() string {
 	return o.GetID()
 }
@@ -104,6 +121,10 @@ type NormalizedObject interface {
 	GetCategory() (configuration.ObjectCategory, error)
 	// GetLabels returns the labels for the object
 	GetRelevantLabels() map[string]string
+	// GetRelations returns the references to other objects, as determined by the ObjectKind GetRelationsFunc
+	GetRelations() []configuration.ObjectReference
+	// IsClusterScoped returns whether the object has no namespace, as determined by the ObjectKind ClusterScoped
+	IsClusterScoped() bool
 	// Raw returns the underlying client.Object
 	Raw() client.Object
 }
@@ -121,10 +142,18 @@ func (n defaultNormalizedObject) GetMessage() (string, error) {
 	return n.config.MessageFunc(n.Object, n.config)
 }
 
-// GetRelevantLabels returns the object labels that have been configured to be selected.
+// GetRelevantLabels returns the object labels, including the ones derived by the object kind, that have been configured to be selected.
 func (n defaultNormalizedObject) GetRelevantLabels() map[string]string {
 	labels := map[string]string{}
-	objectLabels := n.GetLabels()
+	objectLabels := map[string]string{}
+	for k, v := range n.GetLabels() {
+		objectLabels[k] = v
+	}
+	if n.config.GetLabelsFunc != nil {
+		for k, v := range n.config.GetLabelsFunc(n.Object) {
+			objectLabels[k] = v
+		}
+	}
 	for _, labelKey := range n.config.Labels {
 		if objectLabels[labelKey] != "" {
 			labels[labelKey] = objectLabels[labelKey]
@@ -133,6 +162,17 @@ func (n defaultNormalizedObject) GetRelevantLabels() map[string]string {
 	return labels
 }
 
+func (n defaultNormalizedObject) GetRelations() []configuration.ObjectReference {
+	if n.config.GetRelationsFunc == nil {
+		return nil
+	}
+	return n.config.GetRelationsFunc(n.Object)
+}
+
+func (n defaultNormalizedObject) IsClusterScoped() bool {
+	return n.config.ClusterScoped
+}
+
 func (n defaultNormalizedObject) GetCategory() (configuration.ObjectCategory, error) {
 	if n.config.Category == "" {
 		return "", fmt.Errorf("category not found for object kind %q", n.config.Gvk.Kind)
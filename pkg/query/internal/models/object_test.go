@@ -6,8 +6,10 @@ import (
 
 	sourcev1beta2 "github.com/fluxcd/source-controller/api/v1beta2"
 	"github.com/google/go-cmp/cmp"
+	pipelinev1alpha1 "github.com/weaveworks/pipeline-controller/api/v1alpha1"
 	gapiv1 "github.com/weaveworks/templates-controller/apis/gitops/v1alpha2"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/configuration"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -44,6 +46,80 @@ func Test_IsExpired(t *testing.T) {
 	}
 }
 
+func TestObject_Validate(t *testing.T) {
+	valid := Object{
+		Cluster:    "management",
+		Namespace:  "flux-system",
+		APIGroup:   "source.toolkit.fluxcd.io",
+		APIVersion: "v1beta2",
+		Kind:       "Bucket",
+		Name:       "podinfo",
+		Category:   configuration.CategorySource,
+	}
+
+	tests := []struct {
+		name    string
+		object  func(o Object) Object
+		wantErr string
+	}{
+		{
+			name:   "namespaced object",
+			object: func(o Object) Object { return o },
+		},
+		{
+			name: "namespaced object without namespace",
+			object: func(o Object) Object {
+				o.Namespace = ""
+				return o
+			},
+			wantErr: "missing namespace field",
+		},
+		{
+			name: "cluster-scoped object without namespace",
+			object: func(o Object) Object {
+				o.Namespace = ""
+				o.APIGroup = "pac.weave.works"
+				o.Kind = "Policy"
+				o.Category = configuration.CategoryPolicy
+				o.ClusterScoped = true
+				return o
+			},
+		},
+		{
+			name: "object without name",
+			object: func(o Object) Object {
+				o.Name = ""
+				return o
+			},
+			wantErr: "missing name field",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.object(valid).Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestNormalizedObject_IsClusterScoped(t *testing.T) {
+	if !NewNormalizedObject(nil, configuration.PolicyObjectKind).IsClusterScoped() {
+		t.Errorf("policies should be cluster-scoped")
+	}
+	if NewNormalizedObject(nil, configuration.BucketObjectKind).IsClusterScoped() {
+		t.Errorf("buckets should not be cluster-scoped")
+	}
+}
+
 func TestGetRelevantLabels(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -89,6 +165,65 @@ func TestGetRelevantLabels(t *testing.T) {
 			},
 			want: map[string]string{},
 		},
+		{
+			name: "should return labels derived by the kind",
+			object: defaultNormalizedObject{
+				&pipelinev1alpha1.Pipeline{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "podinfo",
+						Namespace: "default",
+						Labels: map[string]string{
+							"app": "podinfo",
+						},
+					},
+					Spec: pipelinev1alpha1.PipelineSpec{
+						AppRef: pipelinev1alpha1.LocalAppReference{
+							APIVersion: "helm.toolkit.fluxcd.io/v2beta1",
+							Kind:       "HelmRelease",
+							Name:       "podinfo",
+						},
+					},
+				},
+				configuration.PipelineObjectKind,
+			},
+			want: map[string]string{
+				configuration.PipelineAppRefKindLabel: "HelmRelease",
+				configuration.PipelineAppRefNameLabel: "podinfo",
+			},
+		},
+		{
+			name: "should return labels of the policy violated by an audit event",
+			object: defaultNormalizedObject{
+				&corev1.Event{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "audit-event",
+						Namespace: "flux-system",
+						Labels: map[string]string{
+							"pac.weave.works/type": "Audit",
+						},
+						Annotations: map[string]string{
+							"policy_id":   "weave.policies.containers-running-in-privileged-mode",
+							"policy_name": "Containers Running In Privileged Mode",
+							"severity":    "high",
+							"category":    "weave.categories.pod-security",
+						},
+					},
+					InvolvedObject: corev1.ObjectReference{
+						Kind:      "Deployment",
+						Namespace: "default",
+						Name:      "podinfo",
+					},
+				},
+				configuration.PolicyAgentAuditEventObjectKind,
+			},
+			want: map[string]string{
+				configuration.PolicySeverityLabel:   "high",
+				configuration.PolicyCategoryLabel:   "weave.categories.pod-security",
+				configuration.PolicyIDLabel:         "weave.policies.containers-running-in-privileged-mode",
+				configuration.PolicyEntityKindLabel: "Deployment",
+				configuration.PolicyEntityLabel:     "default/podinfo",
+			},
+		},
 		{
 			name: "should return empty for kind without labels configured",
 			object: defaultNormalizedObject{
@@ -0,0 +1,73 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/configuration"
+)
+
+// ObjectNode identifies an object of a cluster in any of its versions, as objects reference each other without them.
+// The object may not be collected, like the workloads applied by a Kustomization.
+type ObjectNode struct {
+	Cluster   string `json:"cluster" gorm:"type:text"`
+	Namespace string `json:"namespace" gorm:"type:text"`
+	APIGroup  string `json:"apiGroup" gorm:"type:text"`
+	Kind      string `json:"kind" gorm:"type:text"`
+	Name      string `json:"name" gorm:"type:text"`
+}
+
+// NewObjectNode returns the node of the object.
+func NewObjectNode(obj Object) ObjectNode {
+	return ObjectNode{
+		Cluster:   obj.Cluster,
+		Namespace: obj.Namespace,
+		APIGroup:  obj.APIGroup,
+		Kind:      obj.Kind,
+		Name:      obj.Name,
+	}
+}
+
+func (n ObjectNode) String() string {
+	return fmt.Sprintf("%s/%s/%s/%s/%s", n.Cluster, n.Namespace, n.APIGroup, n.Kind, n.Name)
+}
+
+// Object returns an object with the fields known by the node. It allows to authorize
+// the access to objects that have not been collected.
+func (n ObjectNode) Object() Object {
+	return Object{
+		Cluster:   n.Cluster,
+		Namespace: n.Namespace,
+		APIGroup:  n.APIGroup,
+		Kind:      n.Kind,
+		Name:      n.Name,
+	}
+}
+
+// ObjectRelation is an edge of the graph of the objects of a cluster, from the object that is depended on
+// to the object that depends on it. For example, from a GitRepository to the Kustomization applying it, and
+// from the Kustomization to the workloads in its inventory.
+type ObjectRelation struct {
+	ID uint `gorm:"primaryKey"`
+	// ObjectID is the id of the object the relation was collected from.
+	ObjectID string     `json:"objectId" gorm:"type:text;index"`
+	From     ObjectNode `json:"from" gorm:"embedded;embeddedPrefix:from_"`
+	To       ObjectNode `json:"to" gorm:"embedded;embeddedPrefix:to_"`
+}
+
+// NewObjectRelation returns the relation between the object and the object it references.
+func NewObjectRelation(obj Object, ref configuration.ObjectReference) ObjectRelation {
+	node := NewObjectNode(obj)
+	other := ObjectNode{
+		Cluster:   obj.Cluster,
+		Namespace: ref.Namespace,
+		APIGroup:  ref.APIGroup,
+		Kind:      ref.Kind,
+		Name:      ref.Name,
+	}
+
+	if ref.Direction == configuration.RelationUpstream {
+		return ObjectRelation{ObjectID: obj.GetID(), From: other, To: node}
+	}
+
+	return ObjectRelation{ObjectID: obj.GetID(), From: node, To: other}
+}
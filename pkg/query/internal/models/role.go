@@ -14,7 +14,7 @@ type Role struct {
 	Namespace   string       `gorm:"type:text"`
 	Kind        string       `gorm:"type:text"`
 	Name        string       `gorm:"type:text"`
-	PolicyRules []PolicyRule `gorm:"foreignKey:RoleID"`
+	PolicyRules []PolicyRule `gorm:"foreignKey:RoleID;constraint:OnDelete:CASCADE"`
 }
 
 // PolicyRule is a rule that applies to a role.
@@ -76,7 +76,14 @@ func (o Role) Validate() error {
 // These helpers are used to ensure we always use the same separator.
 var separator = ","
 
+// emptyRuleData stands for a single empty value, like the core API group, which
+// would otherwise join to the same string as no values at all.
+var emptyRuleData = `""`
+
 func JoinRuleData(data []string) string {
+	if len(data) == 1 && data[0] == "" {
+		return emptyRuleData
+	}
 	return strings.Join(data, separator)
 }
 
@@ -84,5 +91,8 @@ func SplitRuleData(data string) []string {
 	if len(data) == 0 {
 		return nil
 	}
+	if data == emptyRuleData {
+		return []string{""}
+	}
 	return strings.Split(data, separator)
 }
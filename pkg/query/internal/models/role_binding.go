@@ -15,7 +15,7 @@ type RoleBinding struct {
 	Name        string    `gorm:"type:text"`
 	RoleRefName string    `gorm:"type:text"`
 	RoleRefKind string    `gorm:"type:text"`
-	Subjects    []Subject `gorm:"foreignKey:RoleBindingID"`
+	Subjects    []Subject `gorm:"foreignKey:RoleBindingID;constraint:OnDelete:CASCADE"`
 }
 
 // Subject is a subject that is contained in a role binding.
@@ -0,0 +1,90 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// SavedQueryVisibility is who can see and run a saved query.
+type SavedQueryVisibility string
+
+const (
+	// SavedQueryPrivate queries are only visible to their owner.
+	SavedQueryPrivate SavedQueryVisibility = "private"
+	// SavedQueryGroup queries are visible to the members of a group of the principals.
+	SavedQueryGroup SavedQueryVisibility = "group"
+	// SavedQueryGlobal queries are visible to everyone.
+	SavedQueryGlobal SavedQueryVisibility = "global"
+)
+
+// SavedQueryFilterGroup is a group of structured filters of a saved query, combined with its operand.
+type SavedQueryFilterGroup struct {
+	Operand string             `json:"operand,omitempty"`
+	Filters []SavedQueryFilter `json:"filters"`
+}
+
+// SavedQueryFilter is a structured filter of a saved query, as the filters of the store.
+type SavedQueryFilter struct {
+	Field   string   `json:"field"`
+	Operand string   `json:"operand"`
+	Values  []string `json:"values,omitempty"`
+	Min     string   `json:"min,omitempty"`
+	Max     string   `json:"max,omitempty"`
+}
+
+// SavedQuery is a query that is saved to be run again, by its owner or by the principals it is shared with.
+type SavedQuery struct {
+	ID      string   `gorm:"primaryKey;autoIncrement:false"`
+	Name    string   `json:"name" gorm:"type:text"`
+	Terms   string   `json:"terms" gorm:"type:text"`
+	Filters []string `json:"filters" gorm:"serializer:json"`
+	// FilterGroups are the structured filters of the query, that are ANDed with its filters.
+	FilterGroups []SavedQueryFilterGroup `json:"filterGroups" gorm:"serializer:json"`
+	OrderBy      string                  `json:"orderBy" gorm:"type:text"`
+	Descending   bool                    `json:"descending"`
+	Owner        string                  `json:"owner" gorm:"type:text;index"`
+	Visibility   SavedQueryVisibility    `json:"visibility" gorm:"type:text"`
+	// Group the query is shared with, when its visibility is group.
+	Group string `json:"group" gorm:"column:group_name;type:text"`
+	// ReadOnly queries are curated by admins and cannot be changed through the API. They are not stored.
+	ReadOnly  bool      `json:"readOnly" gorm:"-"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+func (q SavedQuery) Validate() error {
+	if q.Name == "" {
+		return fmt.Errorf("missing name")
+	}
+
+	switch q.Visibility {
+	case SavedQueryPrivate, SavedQueryGlobal:
+		if q.Group != "" {
+			return fmt.Errorf("group is only set for group visibility")
+		}
+	case SavedQueryGroup:
+		if q.Group == "" {
+			return fmt.Errorf("missing group")
+		}
+	default:
+		return fmt.Errorf("invalid visibility %q: use private, group or global", q.Visibility)
+	}
+
+	return nil
+}
+
+func (q SavedQuery) GetTerms() string {
+	return q.Terms
+}
+
+func (q SavedQuery) GetFilters() []string {
+	return q.Filters
+}
+
+func (q SavedQuery) GetOrderBy() string {
+	return q.OrderBy
+}
+
+func (q SavedQuery) GetDescending() bool {
+	return q.Descending
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"time"
+
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/configuration"
+)
+
+// ObjectTransition records the status and message of an object each time they change,
+// and when the object is deleted, so it can be told how the object was at a point in time.
+type ObjectTransition struct {
+	ID         uint                         `gorm:"primaryKey"`
+	ObjectID   string                       `json:"objectId" gorm:"type:text;index"`
+	Cluster    string                       `json:"cluster" gorm:"type:text"`
+	Namespace  string                       `json:"namespace" gorm:"type:text"`
+	APIGroup   string                       `json:"apiGroup" gorm:"type:text"`
+	APIVersion string                       `json:"apiVersion" gorm:"type:text"`
+	Kind       string                       `json:"kind" gorm:"type:text"`
+	Name       string                       `json:"name" gorm:"type:text"`
+	Status     string                       `json:"status" gorm:"type:text"`
+	Message    string                       `json:"message" gorm:"type:text"`
+	Category   configuration.ObjectCategory `json:"category" gorm:"type:text"`
+	// Labels are the labels of the object when the transition was recorded, as they are not kept in the store.
+	Labels    map[string]string `json:"labels" gorm:"serializer:json"`
+	Deleted   bool              `json:"deleted"`
+	Timestamp time.Time         `json:"timestamp" gorm:"index"`
+}
+
+// NewObjectTransition records the current status and message of the object.
+func NewObjectTransition(obj Object, deleted bool, ts time.Time) ObjectTransition {
+	return ObjectTransition{
+		ObjectID:   obj.GetID(),
+		Cluster:    obj.Cluster,
+		Namespace:  obj.Namespace,
+		APIGroup:   obj.APIGroup,
+		APIVersion: obj.APIVersion,
+		Kind:       obj.Kind,
+		Name:       obj.Name,
+		Status:     obj.Status,
+		Message:    obj.Message,
+		Category:   obj.Category,
+		Labels:     obj.Labels,
+		Deleted:    deleted,
+		Timestamp:  ts,
+	}
+}
+
+// Object returns the object as it was after the transition. Only the fields
+// recorded by the transition are set.
+func (t ObjectTransition) Object() Object {
+	return Object{
+		ID:         t.ObjectID,
+		Cluster:    t.Cluster,
+		Namespace:  t.Namespace,
+		APIGroup:   t.APIGroup,
+		APIVersion: t.APIVersion,
+		Kind:       t.Kind,
+		Name:       t.Name,
+		Status:     t.Status,
+		Message:    t.Message,
+		Category:   t.Category,
+		Labels:     t.Labels,
+	}
+}
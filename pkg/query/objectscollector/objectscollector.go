@@ -43,12 +43,13 @@ func NewObjectsCollector(w store.Store, idx store.IndexWriter, mgr clusters.Subs
 	}
 
 	opts := collector.CollectorOpts{
-		Name:            "objects",
-		Log:             log,
-		NewWatcherFunc:  newWatcher,
-		StopWatcherFunc: deleteWatcher,
-		Clusters:        mgr,
-		ServiceAccount:  sa,
+		Name:             "objects",
+		Log:              log,
+		NewWatcherFunc:   newWatcher,
+		StopWatcherFunc:  deleteWatcher,
+		CountObjectsFunc: w.CountObjectsByCluster,
+		Clusters:         mgr,
+		ServiceAccount:   sa,
 	}
 
 	col, err := collector.NewCollector(opts)
@@ -120,6 +121,11 @@ func processRecords(objectTransactions []models.ObjectTransaction, store store.S
 			KubernetesDeletedAt: modelTs,
 			Unstructured:        raw,
 			Labels:              o.GetRelevantLabels(),
+			ClusterScoped:       o.IsClusterScoped(),
+		}
+
+		for _, ref := range o.GetRelations() {
+			object.Relations = append(object.Relations, models.NewObjectRelation(object, ref))
 		}
 
 		if objTx.TransactionType() == models.TransactionTypeDelete {
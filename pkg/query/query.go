@@ -2,7 +2,11 @@ package query
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/weaveworks/weave-gitops/core/logger"
 
@@ -18,6 +22,85 @@ type QueryService interface {
 	RunQuery(ctx context.Context, q store.Query, opts store.QueryOption) ([]models.Object, error)
 	ListFacets(ctx context.Context, cat configuration.ObjectCategory) (store.Facets, error)
 	GetAccessRules(ctx context.Context) ([]models.AccessRule, error)
+	// WatchQuery sends the objects that match the query, and then the changes to them until the context is done.
+	WatchQuery(ctx context.Context, q store.Query, opts WatchOption, send func(WatchEvent) error) error
+	// RunAggregate counts the objects that match the query, grouped by the values of the given fields.
+	RunAggregate(ctx context.Context, q store.Query, groupBy []string) ([]AggregateBucket, error)
+	// RunQueryAsOf runs the query on the objects as they were at the given time.
+	RunQueryAsOf(ctx context.Context, q store.Query, opts store.QueryOption, asOf time.Time) ([]models.Object, error)
+	// GetObjectHistory returns the status transitions of an object, oldest first.
+	GetObjectHistory(ctx context.Context, id string) ([]models.ObjectTransition, error)
+	// GetObjectRelations returns the objects that an object depends on and that depend on it, up to a depth.
+	GetObjectRelations(ctx context.Context, id string, depth int) (ObjectRelations, error)
+	// CompareObjects compares the spec, status and labels of the objects the principal can see, like an object
+	// with the same name in several clusters, with the object of a baseline cluster.
+	CompareObjects(ctx context.Context, req CompareRequest) (Comparison, error)
+	// ExportQuery sends every object that matches the query, ignoring the query limit.
+	ExportQuery(ctx context.Context, q store.Query, opts store.QueryOption, send func(models.Object) error) error
+	// ListSavedQueries returns the saved queries that the principal can see: their own, the ones
+	// shared with their groups, and the global ones, curated by admins or not.
+	ListSavedQueries(ctx context.Context) ([]models.SavedQuery, error)
+	GetSavedQuery(ctx context.Context, id string) (models.SavedQuery, error)
+	// CreateSavedQuery saves a query owned by the principal.
+	CreateSavedQuery(ctx context.Context, query models.SavedQuery) (models.SavedQuery, error)
+	// UpdateSavedQuery changes a saved query owned by the principal.
+	UpdateSavedQuery(ctx context.Context, query models.SavedQuery) (models.SavedQuery, error)
+	DeleteSavedQuery(ctx context.Context, id string) error
+	// ExecuteSavedQuery runs a saved query that the principal can see.
+	ExecuteSavedQuery(ctx context.Context, id string, opts PageOption) ([]models.Object, error)
+	// ExplainAccess tells why a principal can or cannot see an object, given by its id or else by its
+	// reference. Only principals that can impersonate others in the management cluster can call it.
+	ExplainAccess(ctx context.Context, principal *auth.UserPrincipal, id string, node models.ObjectNode) (models.AccessExplanation, error)
+	// RebuildIndex rebuilds the index from the store and returns the number of indexed objects. Only admins can call it.
+	RebuildIndex(ctx context.Context) (int, error)
+	// CheckIndex returns the objects missing from the index or from the store. Only admins can call it.
+	CheckIndex(ctx context.Context) (store.IndexConsistency, error)
+	// ListAlertRules returns the alert rules with their state. Only admins can manage alert rules,
+	// as they post the objects they match to any webhook.
+	ListAlertRules(ctx context.Context) ([]models.AlertRule, error)
+	GetAlertRule(ctx context.Context, id string) (models.AlertRule, error)
+	// CreateAlertRule saves an alert rule whose query runs as the admin that created it.
+	CreateAlertRule(ctx context.Context, rule models.AlertRule) (models.AlertRule, error)
+	// UpdateAlertRule changes an alert rule, whose query then runs as the admin that changed it.
+	UpdateAlertRule(ctx context.Context, rule models.AlertRule) (models.AlertRule, error)
+	DeleteAlertRule(ctx context.Context, id string) error
+	// RequireAdmin returns ErrAdminRequired unless the principal is an admin, for the admin operations
+	// that are served apart from the query service, like the status of the collectors.
+	RequireAdmin(ctx context.Context) error
+}
+
+// ErrObjectNotFound is returned for objects that do not exist or that the principal cannot see.
+var ErrObjectNotFound = errors.New("object not found")
+
+// AggregateBucket is the number of objects sharing the same values for the grouped by fields.
+type AggregateBucket struct {
+	Group map[string]string
+	Count int32
+}
+
+// WatchOption orders the objects sent by a watch. Watches are not paginated:
+// additions and deletions would shift the objects in and out of a page.
+type WatchOption interface {
+	GetOrderBy() string
+	GetDescending() bool
+}
+
+type WatchEventType string
+
+const (
+	WatchEventSnapshot WatchEventType = "snapshot"
+	WatchEventAdded    WatchEventType = "added"
+	WatchEventUpdated  WatchEventType = "updated"
+	WatchEventDeleted  WatchEventType = "deleted"
+)
+
+// defaultWatchDebounce is how long watched queries wait for more changes before they are evaluated.
+const defaultWatchDebounce = time.Second
+
+// WatchEvent holds the objects that entered, changed in or left the results of a watched query.
+type WatchEvent struct {
+	Type    WatchEventType
+	Objects []models.Object
 }
 
 // Authorizer creates an authorization predicate when given a cluster name.
@@ -25,11 +108,40 @@ type Authorizer interface {
 	ObjectAuthorizer(roles []models.Role, rolebindings []models.RoleBinding, principal *auth.UserPrincipal, cluster string) func(models.Object) (bool, error)
 }
 
+// CachingAuthorizer is an Authorizer that caches the access rules, and the rules it resolves
+// out of them, rather than being given the ones read from the store for each query.
+type CachingAuthorizer interface {
+	Caching() bool
+	CachedObjectAuthorizer(ctx context.Context, principal *auth.UserPrincipal) (func(models.Object) (bool, error), error)
+}
+
+// AccessExplainer explains the decisions of an Authorizer.
+type AccessExplainer interface {
+	ExplainAccess(roles []models.Role, rolebindings []models.RoleBinding, principal *auth.UserPrincipal, obj models.Object) models.AccessExplanation
+	CanImpersonate(roles []models.Role, rolebindings []models.RoleBinding, principal *auth.UserPrincipal, cluster string) bool
+}
+
 type QueryServiceOpts struct {
 	Log         logr.Logger
 	StoreReader store.StoreReader
 	IndexReader store.IndexReader
 	Authorizer  Authorizer
+	// Watcher notifies of changes to the store and the index. Required to watch queries.
+	Watcher store.Watcher
+	// StoreWriter stores the saved queries of the principals and the alert rules. Required to save them.
+	StoreWriter store.StoreWriter
+	// CuratedQueries are saved queries that admins publish to everyone. They are read-only.
+	CuratedQueries []configuration.CuratedQuery
+	// SavedQueriesReadOnly only serves the curated queries: principals cannot save queries.
+	SavedQueriesReadOnly bool
+	// AlertRulesReadOnly refuses to save alert rules, like when the store does not outlive the process.
+	AlertRulesReadOnly bool
+	// AccessExplainer explains the decisions of the authorizer. Required to explain access.
+	AccessExplainer AccessExplainer
+	// ManagementCluster is the cluster whose roles tell who the admins are.
+	ManagementCluster string
+	// IndexMaintainer rebuilds the index. Required to rebuild and check the index.
+	IndexMaintainer store.IndexMaintainer
 }
 
 func (o QueryServiceOpts) Validate() error {
@@ -60,6 +172,19 @@ func NewQueryService(opts QueryServiceOpts) (QueryService, error) {
 		r:          opts.StoreReader,
 		index:      opts.IndexReader,
 		authorizer: opts.Authorizer,
+		watcher:    opts.Watcher,
+		w:          opts.StoreWriter,
+
+		watchDebounce: defaultWatchDebounce,
+		asOfIndexes:   store.NewAsOfIndexes(opts.StoreReader, 0, opts.Log),
+
+		curatedQueries:       newCuratedQueries(opts.CuratedQueries),
+		savedQueriesReadOnly: opts.SavedQueriesReadOnly,
+		alertRulesReadOnly:   opts.AlertRulesReadOnly,
+
+		explainer:         opts.AccessExplainer,
+		managementCluster: opts.ManagementCluster,
+		maintainer:        opts.IndexMaintainer,
 	}, nil
 }
 
@@ -69,56 +194,101 @@ type qs struct {
 	r          store.StoreReader
 	index      store.IndexReader
 	authorizer Authorizer
+	watcher    store.Watcher
+	w          store.StoreWriter
+
+	watchDebounce time.Duration
+	asOfIndexes   *store.AsOfIndexes
+
+	curatedQueries       []models.SavedQuery
+	savedQueriesReadOnly bool
+	alertRulesReadOnly   bool
+
+	explainer         AccessExplainer
+	managementCluster string
+	maintainer        store.IndexMaintainer
 }
 
 func (q *qs) RunQuery(ctx context.Context, query store.Query, opts store.QueryOption) ([]models.Object, error) {
+	return q.runQuery(ctx, q.index, query, opts)
+}
+
+func (q *qs) RunQueryAsOf(ctx context.Context, query store.Query, opts store.QueryOption, asOf time.Time) ([]models.Object, error) {
+	index, release, err := q.asOfIndexes.Get(ctx, asOf)
+	if err != nil {
+		return nil, fmt.Errorf("error indexing objects as of %s: %w", asOf.Format(time.RFC3339), err)
+	}
+	defer release()
+
+	return q.runQuery(ctx, index, query, opts)
+}
+
+func (q *qs) runQuery(ctx context.Context, index store.IndexReader, query store.Query, opts store.QueryOption) ([]models.Object, error) {
+	result := []models.Object{}
+
+	var limit int32
+
+	if opts != nil {
+		limit = opts.GetLimit()
+	}
+
+	err := q.visitQuery(ctx, index, query, opts, func(obj models.Object) (bool, error) {
+		result = append(result, obj)
+		// Limit is set in the query and reached.
+		// If Limit is 0, all objects are returned.
+		return limit <= 0 || len(result) < int(limit), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	q.debug.Info("query processed", "query", query, "numResult", len(result))
+	return result, nil
+}
+
+func (q *qs) ExportQuery(ctx context.Context, query store.Query, opts store.QueryOption, send func(models.Object) error) error {
+	count := 0
+	err := q.visitQuery(ctx, q.index, query, opts, func(obj models.Object) (bool, error) {
+		count++
+		return true, send(obj)
+	})
+	if err != nil {
+		return err
+	}
+
+	q.debug.Info("query exported", "query", query, "numResult", count)
+	return nil
+}
+
+// visitQuery visits the objects matching the query that the principal can see, in order, until
+// the visitor returns false or an error.
+func (q *qs) visitQuery(ctx context.Context, index store.IndexReader, query store.Query, opts store.QueryOption, visit func(models.Object) (bool, error)) error {
 	principal := auth.Principal(ctx)
 	if principal == nil {
-		return nil, fmt.Errorf("principal not found")
+		return fmt.Errorf("principal not found")
 	}
 	q.debug.Info("query received", "filters", query.GetFilters(), "terms", query.GetTerms(), "principal", principal.ID)
 
-	roles, err := q.r.GetRoles(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("error fetching access rules from the store: %w", err)
-	}
-	bindings, err := q.r.GetRoleBindings(ctx)
+	allow, err := q.objectAuthorizer(ctx, principal)
 	if err != nil {
-		return nil, fmt.Errorf("error fetching access rules from the store: %w", err)
+		return err
 	}
 
 	tenants, err := q.r.GetTenants(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("error fetching tenants from the store: %w", err)
+		return fmt.Errorf("error fetching tenants from the store: %w", err)
 	}
 
 	tenantLookup := createTenantLookup(tenants)
 
-	iter, err := q.index.Search(ctx, query, opts)
+	iter, err := index.Search(ctx, query, opts)
 	if err != nil {
-		return nil, fmt.Errorf("error getting objects from indexer: %w", err)
+		return fmt.Errorf("error getting objects from indexer: %w", err)
 	}
 
 	defer iter.Close()
 
-	result := []models.Object{}
-
-	var limit int32
-
-	if opts != nil {
-		limit = opts.GetLimit()
-	}
-
-	// keep track of any cluster authorize predicate we might need again
-	perClusterAllowed := map[string](func(models.Object) (bool, error)){}
-
 	for iter.Next() {
-		if limit > 0 && len(result) == int(limit) {
-			// Limit is set in the query and reached.
-			// If Limit is 0, all objects are returned.
-			break
-		}
-
 		obj, err := iter.Row()
 		if err != nil {
 			q.log.Error(err, "error getting row from iterator")
@@ -130,29 +300,354 @@ func (q *qs) RunQuery(ctx context.Context, query store.Query, opts store.QueryOp
 			obj.Tenant = tenantName
 		}
 
-		cluster := obj.Cluster
-		allow, ok := perClusterAllowed[cluster]
-		if !ok {
-			allow = q.authorizer.ObjectAuthorizer(roles, bindings, principal, cluster)
-			perClusterAllowed[cluster] = allow
-		}
-
-		ok, err = allow(obj)
+		ok, err := allow(obj)
 		if err != nil {
 			q.log.Error(err, "error checking access")
 			continue
 		}
 
-		if ok {
-			result = append(result, obj)
-		} else {
+		if !ok {
 			//unauthorised is logged for debugging
 			q.debug.Info("unauthorised access", "principal", principal.ID, "object", obj.ID)
+			continue
+		}
+
+		next, err := visit(obj)
+		if err != nil {
+			return err
+		}
+		if !next {
+			break
 		}
 	}
 
-	q.debug.Info("query processed", "query", query, "principal", principal.ID, "numResult", len(result))
-	return result, nil
+	return nil
+}
+
+func (q *qs) WatchQuery(ctx context.Context, query store.Query, opts WatchOption, send func(WatchEvent) error) error {
+	if q.watcher == nil {
+		return fmt.Errorf("watching queries is not enabled")
+	}
+
+	principal := auth.Principal(ctx)
+	if principal == nil {
+		return fmt.Errorf("principal not found")
+	}
+
+	// Subscribe before taking the snapshot, so changes made in between are not missed.
+	watch := q.watcher.Watch()
+	defer watch.Stop()
+
+	var queryOpts store.QueryOption
+	if opts != nil {
+		queryOpts = unpagedQueryOption{opts}
+	}
+
+	current, err := q.RunQuery(ctx, query, queryOpts)
+	if err != nil {
+		return err
+	}
+
+	if err := send(WatchEvent{Type: WatchEventSnapshot, Objects: current}); err != nil {
+		return fmt.Errorf("failed to send snapshot: %w", err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			q.debug.Info("watch stopped", "principal", principal.ID)
+			return nil
+		case <-watch.Changed():
+		}
+
+		// Writes come in batches, one per cluster and kind, so waiting a little lets
+		// a burst of them be evaluated once.
+		select {
+		case <-ctx.Done():
+			q.debug.Info("watch stopped", "principal", principal.ID)
+			return nil
+		case <-time.After(q.watchDebounce):
+		}
+
+		changes := watch.Take()
+
+		var next []models.Object
+		if changes.Unscoped {
+			next, err = q.RunQuery(ctx, query, queryOpts)
+		} else {
+			next, err = q.applyChanges(ctx, query, queryOpts, current, changes)
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, event := range diffObjects(current, next, changes.Upserted) {
+			if err := send(event); err != nil {
+				return fmt.Errorf("failed to send %s objects: %w", event.Type, err)
+			}
+		}
+
+		current = next
+	}
+}
+
+// applyChanges computes the next results of a query out of the current ones, by only querying
+// the objects upserted since then. Changes to the access rules or tenants are unscoped, and need
+// the query to run again.
+func (q *qs) applyChanges(ctx context.Context, query store.Query, opts store.QueryOption, current []models.Object, changes store.Changes) ([]models.Object, error) {
+	matched := map[string]models.Object{}
+	if len(changes.Upserted) > 0 {
+		ids := make([]string, 0, len(changes.Upserted))
+		for id := range changes.Upserted {
+			ids = append(ids, id)
+		}
+
+		objects, err := q.RunQuery(ctx, store.WithIDs(query, ids), opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range objects {
+			matched[obj.GetID()] = obj
+		}
+	}
+
+	next := []models.Object{}
+	for _, obj := range current {
+		id := obj.GetID()
+		if changes.Removed[id] {
+			continue
+		}
+		if changes.Upserted[id] {
+			obj, ok := matched[id]
+			if !ok {
+				// the object no longer matches the query
+				continue
+			}
+			delete(matched, id)
+			next = append(next, obj)
+			continue
+		}
+		next = append(next, obj)
+	}
+	for _, obj := range matched {
+		next = append(next, obj)
+	}
+
+	return next, nil
+}
+
+// diffObjects computes the events that turn the previous results into the next ones. Objects in both
+// results are updated when they were upserted or the tenant they belong to changed.
+func diffObjects(previous, next []models.Object, upserted map[string]bool) []WatchEvent {
+	previousByID := map[string]models.Object{}
+	for _, obj := range previous {
+		previousByID[obj.GetID()] = obj
+	}
+
+	nextIDs := map[string]bool{}
+	added := []models.Object{}
+	updated := []models.Object{}
+
+	for _, obj := range next {
+		id := obj.GetID()
+		nextIDs[id] = true
+
+		prev, ok := previousByID[id]
+		if !ok {
+			added = append(added, obj)
+			continue
+		}
+
+		if upserted[id] || prev.Tenant != obj.Tenant {
+			updated = append(updated, obj)
+		}
+	}
+
+	deleted := []models.Object{}
+	for _, obj := range previous {
+		if !nextIDs[obj.GetID()] {
+			deleted = append(deleted, obj)
+		}
+	}
+
+	events := []WatchEvent{}
+	if len(added) > 0 {
+		events = append(events, WatchEvent{Type: WatchEventAdded, Objects: added})
+	}
+	if len(updated) > 0 {
+		events = append(events, WatchEvent{Type: WatchEventUpdated, Objects: updated})
+	}
+	if len(deleted) > 0 {
+		events = append(events, WatchEvent{Type: WatchEventDeleted, Objects: deleted})
+	}
+
+	return events
+}
+
+type unpagedQueryOption struct {
+	WatchOption
+}
+
+func (unpagedQueryOption) GetLimit() int32 {
+	return 0
+}
+
+func (unpagedQueryOption) GetOffset() int32 {
+	return 0
+}
+
+func (q *qs) RunAggregate(ctx context.Context, query store.Query, groupBy []string) ([]AggregateBucket, error) {
+	if len(groupBy) == 0 {
+		return nil, fmt.Errorf("at least one group by field is required")
+	}
+	for _, field := range groupBy {
+		if !isGroupByField(field) {
+			return nil, fmt.Errorf("unsupported group by field: %s", field)
+		}
+	}
+
+	buckets := []AggregateBucket{}
+	bucketIndex := map[string]int{}
+
+	// Counting the results of the query keeps the counts in line with what the principal can see.
+	// They are counted as they are read, so the objects are not kept.
+	err := q.ExportQuery(ctx, query, nil, func(obj models.Object) error {
+		group := map[string]string{}
+		values := []string{}
+		for _, field := range groupBy {
+			value := groupByValue(obj, field)
+			group[field] = value
+			values = append(values, value)
+		}
+
+		key := strings.Join(values, "\x00")
+		index, ok := bucketIndex[key]
+		if !ok {
+			index = len(buckets)
+			bucketIndex[key] = index
+			buckets = append(buckets, AggregateBucket{Group: group})
+		}
+		buckets[index].Count++
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Largest buckets first, then by their values so the order is stable.
+	sort.SliceStable(buckets, func(i, j int) bool {
+		if buckets[i].Count != buckets[j].Count {
+			return buckets[i].Count > buckets[j].Count
+		}
+		for _, field := range groupBy {
+			if buckets[i].Group[field] != buckets[j].Group[field] {
+				return buckets[i].Group[field] < buckets[j].Group[field]
+			}
+		}
+		return false
+	})
+
+	q.debug.Info("aggregate processed", "query", query, "groupBy", groupBy, "numBuckets", len(buckets))
+	return buckets, nil
+}
+
+const (
+	GroupByCluster   = "cluster"
+	GroupByNamespace = "namespace"
+	GroupByKind      = "kind"
+	GroupByStatus    = "status"
+	GroupByTenant    = "tenant"
+	// GroupByLabelPrefix groups by the value of a label, like labels.weave.works/template-type
+	GroupByLabelPrefix = "labels."
+)
+
+func isGroupByField(field string) bool {
+	switch field {
+	case GroupByCluster, GroupByNamespace, GroupByKind, GroupByStatus, GroupByTenant:
+		return true
+	}
+	return strings.HasPrefix(field, GroupByLabelPrefix) && len(field) > len(GroupByLabelPrefix)
+}
+
+func groupByValue(obj models.Object, field string) string {
+	switch field {
+	case GroupByCluster:
+		return obj.Cluster
+	case GroupByNamespace:
+		return obj.Namespace
+	case GroupByKind:
+		return obj.Kind
+	case GroupByStatus:
+		return obj.Status
+	case GroupByTenant:
+		return obj.Tenant
+	}
+	return obj.Labels[strings.TrimPrefix(field, GroupByLabelPrefix)]
+}
+
+func (q *qs) GetObjectHistory(ctx context.Context, id string) ([]models.ObjectTransition, error) {
+	principal := auth.Principal(ctx)
+	if principal == nil {
+		return nil, fmt.Errorf("principal not found")
+	}
+
+	transitions, err := q.r.GetObjectTransitions(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching object transitions from the store: %w", err)
+	}
+
+	if len(transitions) == 0 {
+		return nil, ErrObjectNotFound
+	}
+
+	allow, err := q.objectAuthorizer(ctx, principal)
+	if err != nil {
+		return nil, err
+	}
+
+	// Access is checked against the object as it is now, or was when it got deleted.
+	obj := transitions[len(transitions)-1].Object()
+
+	ok, err := allow(obj)
+	if err != nil {
+		return nil, fmt.Errorf("error checking access: %w", err)
+	}
+
+	if !ok {
+		// unauthorised objects are not found, so their existence is not disclosed
+		q.debug.Info("unauthorised access", "principal", principal.ID, "object", id)
+		return nil, ErrObjectNotFound
+	}
+
+	return transitions, nil
+}
+
+// objectAuthorizer returns the authorization predicate of the principal for objects of any cluster.
+// The access rules are read from the store once per call, unless the authorizer caches them.
+func (q *qs) objectAuthorizer(ctx context.Context, principal *auth.UserPrincipal) (func(models.Object) (bool, error), error) {
+	if caching, ok := q.authorizer.(CachingAuthorizer); ok && caching.Caching() {
+		return caching.CachedObjectAuthorizer(ctx, principal)
+	}
+
+	roles, err := q.r.GetRoles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching access rules from the store: %w", err)
+	}
+	bindings, err := q.r.GetRoleBindings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching access rules from the store: %w", err)
+	}
+
+	// keep track of any cluster authorize predicate we might need again
+	perClusterAllowed := map[string](func(models.Object) (bool, error)){}
+	return func(obj models.Object) (bool, error) {
+		allow, ok := perClusterAllowed[obj.Cluster]
+		if !ok {
+			allow = q.authorizer.ObjectAuthorizer(roles, bindings, principal, obj.Cluster)
+			perClusterAllowed[obj.Cluster] = allow
+		}
+		return allow(obj)
+	}, nil
 }
 
 func (q *qs) GetAccessRules(ctx context.Context) ([]models.AccessRule, error) {
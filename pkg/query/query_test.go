@@ -5,19 +5,26 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"runtime"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	. "github.com/onsi/gomega"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	sourcev1beta2 "github.com/fluxcd/source-controller/api/v1beta2"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/configuration"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/rbac"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/store"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/store/storefakes"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/utils/testutils"
 	"github.com/weaveworks/weave-gitops/pkg/server/auth"
 )
 
@@ -872,6 +879,1116 @@ func TestQuery_Tenants(t *testing.T) {
 
 }
 
+func TestWatchQuery(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	dir, err := os.MkdirTemp("", "test")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	db, err := store.CreateSQLiteDB(dir)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	s, err := store.NewSQLiteStore(db, logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	idxDir, err := os.MkdirTemp("", "indexer-test")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	idx, err := store.NewIndexer(s, idxDir, logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	broadcaster := store.NewBroadcaster()
+	idx = store.NewWatchableIndexer(idx, broadcaster)
+
+	var revoked atomic.Bool
+	q := &qs{
+		log:   logr.Discard(),
+		debug: logr.Discard(),
+		r:     s,
+		index: idx,
+		authorizer: predicateAuthz{
+			predicate: func(obj models.Object) (bool, error) {
+				return obj.Namespace != "forbidden" && !revoked.Load(), nil
+			},
+		},
+		watcher: broadcaster,
+	}
+
+	newObject := func(name, namespace, status string) models.Object {
+		return models.Object{
+			Cluster:    "test-cluster",
+			Name:       name,
+			Namespace:  namespace,
+			Kind:       "HelmRelease",
+			APIGroup:   "helm.toolkit.fluxcd.io",
+			APIVersion: "v2beta1",
+			Status:     status,
+			Category:   configuration.CategoryAutomation,
+		}
+	}
+
+	existing := newObject("existing", "default", "Success")
+	g.Expect(store.SeedObjects(db, []models.Object{existing})).To(Succeed())
+	g.Expect(idx.Add(context.Background(), []models.Object{existing})).To(Succeed())
+
+	ctx, cancel := context.WithCancel(auth.WithPrincipal(context.Background(), &auth.UserPrincipal{
+		ID: "test",
+	}))
+	defer cancel()
+
+	events := make(chan WatchEvent, 10)
+	done := make(chan error)
+	go func() {
+		done <- q.WatchQuery(ctx, &query{filters: []string{"kind:HelmRelease"}}, &query{}, func(e WatchEvent) error {
+			events <- e
+			return nil
+		})
+	}()
+
+	names := func(e WatchEvent) []string {
+		result := []string{}
+		for _, o := range e.Objects {
+			result = append(result, o.Name)
+		}
+		return result
+	}
+
+	var event WatchEvent
+	g.Eventually(events).Should(Receive(&event))
+	g.Expect(event.Type).To(Equal(WatchEventSnapshot))
+	g.Expect(names(event)).To(ConsistOf("existing"))
+
+	t.Run("sends added objects", func(t *testing.T) {
+		added := []models.Object{newObject("added", "default", "Success"), newObject("hidden", "forbidden", "Success")}
+		g.Expect(s.StoreObjects(context.Background(), added)).To(Succeed())
+		g.Expect(idx.Add(context.Background(), added)).To(Succeed())
+
+		g.Eventually(events).Should(Receive(&event))
+		g.Expect(event.Type).To(Equal(WatchEventAdded))
+		g.Expect(names(event)).To(ConsistOf("added"))
+	})
+
+	t.Run("sends updated objects", func(t *testing.T) {
+		updated := newObject("existing", "default", "Failed")
+		g.Expect(s.StoreObjects(context.Background(), []models.Object{updated})).To(Succeed())
+		g.Expect(idx.Add(context.Background(), []models.Object{updated})).To(Succeed())
+
+		g.Eventually(events).Should(Receive(&event))
+		g.Expect(event.Type).To(Equal(WatchEventUpdated))
+		g.Expect(names(event)).To(ConsistOf("existing"))
+		g.Expect(event.Objects[0].Status).To(Equal("Failed"))
+	})
+
+	t.Run("sends deleted objects", func(t *testing.T) {
+		deleted := newObject("added", "default", "Success")
+		g.Expect(s.DeleteObjects(context.Background(), []models.Object{deleted})).To(Succeed())
+		g.Expect(idx.Remove(context.Background(), []models.Object{deleted})).To(Succeed())
+
+		g.Eventually(events).Should(Receive(&event))
+		g.Expect(event.Type).To(Equal(WatchEventDeleted))
+		g.Expect(names(event)).To(ConsistOf("added"))
+	})
+
+	t.Run("sends objects that are no longer visible as deleted", func(t *testing.T) {
+		revoked.Store(true)
+
+		// access rules changes are not scoped to objects, so the query runs again
+		g.Expect(store.NewWatchableStore(s, broadcaster).StoreRoles(context.Background(), []models.Role{})).To(Succeed())
+
+		g.Eventually(events).Should(Receive(&event))
+		g.Expect(event.Type).To(Equal(WatchEventDeleted))
+		g.Expect(names(event)).To(ConsistOf("existing"))
+	})
+
+	t.Run("stops when the context is done", func(t *testing.T) {
+		cancel()
+		g.Eventually(done).Should(Receive(BeNil()))
+	})
+}
+
+func TestWatchQuery_Debounce(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	dir, err := os.MkdirTemp("", "test")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	db, err := store.CreateSQLiteDB(dir)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	s, err := store.NewSQLiteStore(db, logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	idxDir, err := os.MkdirTemp("", "indexer-test")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	idx, err := store.NewIndexer(s, idxDir, logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	broadcaster := store.NewBroadcaster()
+	idx = store.NewWatchableIndexer(idx, broadcaster)
+
+	q := &qs{
+		log:   logr.Discard(),
+		debug: logr.Discard(),
+		r:     s,
+		index: idx,
+		authorizer: predicateAuthz{
+			predicate: func(obj models.Object) (bool, error) {
+				return true, nil
+			},
+		},
+		watcher:       broadcaster,
+		watchDebounce: 200 * time.Millisecond,
+	}
+
+	ctx, cancel := context.WithCancel(auth.WithPrincipal(context.Background(), &auth.UserPrincipal{
+		ID: "test",
+	}))
+	defer cancel()
+
+	events := make(chan WatchEvent, 10)
+	go func() {
+		_ = q.WatchQuery(ctx, &query{filters: []string{"kind:HelmRelease"}}, &query{}, func(e WatchEvent) error {
+			events <- e
+			return nil
+		})
+	}()
+
+	var event WatchEvent
+	g.Eventually(events).Should(Receive(&event))
+	g.Expect(event.Type).To(Equal(WatchEventSnapshot))
+
+	for _, name := range []string{"first", "second"} {
+		obj := models.Object{
+			Cluster:    "test-cluster",
+			Name:       name,
+			Namespace:  "default",
+			Kind:       "HelmRelease",
+			APIGroup:   "helm.toolkit.fluxcd.io",
+			APIVersion: "v2beta1",
+			Category:   configuration.CategoryAutomation,
+		}
+		g.Expect(s.StoreObjects(context.Background(), []models.Object{obj})).To(Succeed())
+		g.Expect(idx.Add(context.Background(), []models.Object{obj})).To(Succeed())
+	}
+
+	// both writes are evaluated at once
+	g.Eventually(events).Should(Receive(&event))
+	g.Expect(event.Type).To(Equal(WatchEventAdded))
+	g.Expect(event.Objects).To(HaveLen(2))
+	g.Consistently(events, 400*time.Millisecond).ShouldNot(Receive())
+}
+
+func TestDiffObjects(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	a := models.Object{Cluster: "c", Namespace: "ns", Kind: "Kustomization", Name: "a"}
+	b := models.Object{Cluster: "c", Namespace: "ns", Kind: "Kustomization", Name: "b"}
+	c := models.Object{Cluster: "c", Namespace: "ns", Kind: "Kustomization", Name: "c"}
+	bWithTenant := b
+	bWithTenant.Tenant = "tenant"
+
+	tests := []struct {
+		name     string
+		previous []models.Object
+		next     []models.Object
+		upserted map[string]bool
+		want     []WatchEvent
+	}{
+		{
+			name:     "no changes",
+			previous: []models.Object{a, b},
+			next:     []models.Object{a, b},
+			want:     []WatchEvent{},
+		},
+		{
+			name:     "added and deleted",
+			previous: []models.Object{a, b},
+			next:     []models.Object{b, c},
+			want: []WatchEvent{
+				{Type: WatchEventAdded, Objects: []models.Object{c}},
+				{Type: WatchEventDeleted, Objects: []models.Object{a}},
+			},
+		},
+		{
+			name:     "upserted objects are updated",
+			previous: []models.Object{a, b},
+			next:     []models.Object{a, b},
+			upserted: map[string]bool{a.GetID(): true, c.GetID(): true},
+			want: []WatchEvent{
+				{Type: WatchEventUpdated, Objects: []models.Object{a}},
+			},
+		},
+		{
+			name:     "objects moved to another tenant are updated",
+			previous: []models.Object{a, b},
+			next:     []models.Object{a, bWithTenant},
+			want: []WatchEvent{
+				{Type: WatchEventUpdated, Objects: []models.Object{bWithTenant}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g.Expect(diffObjects(tt.previous, tt.next, tt.upserted)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestRunAggregate(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	dir, err := os.MkdirTemp("", "test")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	db, err := store.CreateSQLiteDB(dir)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	s, err := store.NewSQLiteStore(db, logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	objectKinds := []configuration.ObjectKind{{Labels: []string{"team"}}}
+	idx, err := store.NewIndexerWithObjectKinds(s, dir, objectKinds, logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	newObject := func(name, cluster, namespace, status, team string) models.Object {
+		return models.Object{
+			Cluster:    cluster,
+			Name:       name,
+			Namespace:  namespace,
+			Kind:       "HelmRelease",
+			APIGroup:   "helm.toolkit.fluxcd.io",
+			APIVersion: "v2beta1",
+			Status:     status,
+			Category:   configuration.CategoryAutomation,
+			Labels:     map[string]string{"team": team},
+		}
+	}
+
+	objects := []models.Object{
+		newObject("podinfo", "cluster-a", "default", "Failed", "dev"),
+		newObject("redis", "cluster-a", "default", "Success", "ops"),
+		newObject("nginx", "cluster-b", "default", "Failed", "dev"),
+		newObject("mysql", "cluster-b", "default", "Failed", "dev"),
+		newObject("secret", "cluster-b", "forbidden", "Failed", "ops"),
+	}
+
+	g.Expect(store.SeedObjects(db, objects)).To(Succeed())
+	g.Expect(idx.Add(context.Background(), objects)).To(Succeed())
+
+	tenants := []models.Tenant{{ID: "tenant-a", Name: "tenant-a", Namespace: "default", ClusterName: "cluster-a"}}
+	g.Expect(db.Create(&tenants).Error).NotTo(HaveOccurred())
+
+	q := &qs{
+		log:   logr.Discard(),
+		debug: logr.Discard(),
+		r:     s,
+		index: idx,
+		authorizer: predicateAuthz{
+			predicate: func(obj models.Object) (bool, error) {
+				return obj.Namespace != "forbidden", nil
+			},
+		},
+	}
+
+	ctx := auth.WithPrincipal(context.Background(), &auth.UserPrincipal{
+		ID: "test",
+	})
+
+	tests := []struct {
+		name       string
+		query      *query
+		groupBy    []string
+		expected   []AggregateBucket
+		errPattern string
+	}{
+		{
+			name:    "counts by cluster",
+			query:   &query{},
+			groupBy: []string{"cluster"},
+			expected: []AggregateBucket{
+				{Group: map[string]string{"cluster": "cluster-a"}, Count: 2},
+				{Group: map[string]string{"cluster": "cluster-b"}, Count: 2},
+			},
+		},
+		{
+			name:    "counts matching objects by cluster and status",
+			query:   &query{filters: []string{"kind:HelmRelease"}},
+			groupBy: []string{"cluster", "status"},
+			expected: []AggregateBucket{
+				{Group: map[string]string{"cluster": "cluster-b", "status": "Failed"}, Count: 2},
+				{Group: map[string]string{"cluster": "cluster-a", "status": "Failed"}, Count: 1},
+				{Group: map[string]string{"cluster": "cluster-a", "status": "Success"}, Count: 1},
+			},
+		},
+		{
+			name:    "counts by tenant",
+			query:   &query{},
+			groupBy: []string{"tenant"},
+			expected: []AggregateBucket{
+				{Group: map[string]string{"tenant": ""}, Count: 2},
+				{Group: map[string]string{"tenant": "tenant-a"}, Count: 2},
+			},
+		},
+		{
+			name:    "counts by label",
+			query:   &query{},
+			groupBy: []string{"labels.team"},
+			expected: []AggregateBucket{
+				{Group: map[string]string{"labels.team": "dev"}, Count: 3},
+				{Group: map[string]string{"labels.team": "ops"}, Count: 1},
+			},
+		},
+		{
+			name:       "fails without group by fields",
+			query:      &query{},
+			errPattern: "at least one group by field is required",
+		},
+		{
+			name:       "fails for unsupported group by fields",
+			query:      &query{},
+			groupBy:    []string{"name"},
+			errPattern: "unsupported group by field: name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buckets, err := q.RunAggregate(ctx, tt.query, tt.groupBy)
+			if tt.errPattern != "" {
+				g.Expect(err).To(MatchError(MatchRegexp(tt.errPattern)))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(buckets).To(Equal(tt.expected))
+		})
+	}
+}
+
+func TestRunQueryAsOf(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	dir, err := os.MkdirTemp("", "test")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	db, err := store.CreateSQLiteDB(dir)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	s, err := store.NewSQLiteStore(db, logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	idx, err := store.NewIndexer(s, dir, logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	q := &qs{
+		log:   logr.Discard(),
+		debug: logr.Discard(),
+		r:     s,
+		index: idx,
+		authorizer: predicateAuthz{
+			predicate: func(obj models.Object) (bool, error) {
+				return obj.Namespace != "forbidden", nil
+			},
+		},
+		asOfIndexes: store.NewAsOfIndexes(s, 0, logr.Discard()),
+	}
+
+	newObject := func(name, namespace, status string) models.Object {
+		return models.Object{
+			Cluster:    "test-cluster",
+			Name:       name,
+			Namespace:  namespace,
+			Labels:     map[string]string{"app.kubernetes.io/part-of": name},
+			Kind:       "Kustomization",
+			APIGroup:   "kustomize.toolkit.fluxcd.io",
+			APIVersion: "v1",
+			Status:     status,
+			Category:   configuration.CategoryAutomation,
+		}
+	}
+
+	ctx := auth.WithPrincipal(context.Background(), &auth.UserPrincipal{
+		ID: "test",
+	})
+
+	g.Expect(s.StoreObjects(ctx, []models.Object{
+		newObject("podinfo", "default", "Success"),
+		newObject("removed", "default", "Success"),
+		newObject("hidden", "forbidden", "Failed"),
+	})).To(Succeed())
+	lastNight := time.Now()
+
+	g.Expect(s.StoreObjects(ctx, []models.Object{newObject("podinfo", "default", "Failed")})).To(Succeed())
+	g.Expect(s.StoreObjects(ctx, []models.Object{newObject("added", "default", "Success")})).To(Succeed())
+	g.Expect(s.DeleteObjects(ctx, []models.Object{newObject("removed", "default", "Success")})).To(Succeed())
+
+	statuses := func(objects []models.Object) map[string]string {
+		result := map[string]string{}
+		for _, o := range objects {
+			result[o.Name] = o.Status
+		}
+		return result
+	}
+
+	objects, err := q.RunQueryAsOf(ctx, &query{}, nil, lastNight)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(statuses(objects)).To(Equal(map[string]string{"podinfo": "Success", "removed": "Success"}))
+
+	objects, err = q.RunQueryAsOf(ctx, &query{filters: []string{"kind:Kustomization"}}, nil, time.Now())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(statuses(objects)).To(Equal(map[string]string{"podinfo": "Failed", "added": "Success"}))
+
+	objects, err = q.RunQueryAsOf(ctx, &query{}, nil, lastNight.Add(-time.Hour))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(objects).To(BeEmpty())
+
+	t.Run("keeps the labels and tenants of the objects", func(t *testing.T) {
+		g.Expect(s.StoreTenants(ctx, []models.Tenant{{Name: "team", ClusterName: "test-cluster", Namespace: "default"}})).To(Succeed())
+
+		objects, err := q.RunQueryAsOf(ctx, &query{filters: []string{"name:podinfo"}}, nil, lastNight)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(objects).To(HaveLen(1))
+		g.Expect(objects[0].Labels).To(Equal(map[string]string{"app.kubernetes.io/part-of": "podinfo"}))
+		g.Expect(objects[0].Tenant).To(Equal("team"))
+	})
+}
+
+func TestGetObjectHistory(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	dir, err := os.MkdirTemp("", "test")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	db, err := store.CreateSQLiteDB(dir)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	s, err := store.NewSQLiteStore(db, logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	q := &qs{
+		log:   logr.Discard(),
+		debug: logr.Discard(),
+		r:     s,
+		authorizer: predicateAuthz{
+			predicate: func(obj models.Object) (bool, error) {
+				return obj.Namespace != "forbidden", nil
+			},
+		},
+	}
+
+	ctx := auth.WithPrincipal(context.Background(), &auth.UserPrincipal{
+		ID: "test",
+	})
+
+	podinfo := models.Object{
+		Cluster:    "test-cluster",
+		Name:       "podinfo",
+		Namespace:  "default",
+		Kind:       "Kustomization",
+		APIGroup:   "kustomize.toolkit.fluxcd.io",
+		APIVersion: "v1",
+		Status:     "Success",
+		Category:   configuration.CategoryAutomation,
+	}
+	hidden := podinfo
+	hidden.Namespace = "forbidden"
+
+	g.Expect(s.StoreObjects(ctx, []models.Object{podinfo, hidden})).To(Succeed())
+	podinfo.Status = "Failed"
+	g.Expect(s.StoreObjects(ctx, []models.Object{podinfo})).To(Succeed())
+
+	transitions, err := q.GetObjectHistory(ctx, podinfo.GetID())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(transitions).To(HaveLen(2))
+	g.Expect(transitions[0].Status).To(Equal("Success"))
+	g.Expect(transitions[1].Status).To(Equal("Failed"))
+
+	_, err = q.GetObjectHistory(ctx, hidden.GetID())
+	g.Expect(err).To(MatchError(ErrObjectNotFound))
+
+	_, err = q.GetObjectHistory(ctx, "test-cluster/default/missing")
+	g.Expect(err).To(MatchError(ErrObjectNotFound))
+}
+
+func TestGetObjectRelations(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	dir, err := os.MkdirTemp("", "test")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	db, err := store.CreateSQLiteDB(dir)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	s, err := store.NewSQLiteStore(db, logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	q := &qs{
+		log:   logr.Discard(),
+		debug: logr.Discard(),
+		r:     s,
+		authorizer: predicateAuthz{
+			predicate: func(obj models.Object) (bool, error) {
+				return obj.Namespace != "forbidden", nil
+			},
+		},
+	}
+
+	ctx := auth.WithPrincipal(context.Background(), &auth.UserPrincipal{
+		ID: "test",
+	})
+
+	source := configuration.ObjectReference{Namespace: "flux-system", APIGroup: "source.toolkit.fluxcd.io", Kind: "GitRepository", Name: "flux-system", Direction: configuration.RelationUpstream}
+	workload := func(namespace, name string) configuration.ObjectReference {
+		return configuration.ObjectReference{Namespace: namespace, APIGroup: "apps", Kind: "Deployment", Name: name, Direction: configuration.RelationDownstream}
+	}
+	newKustomization := func(namespace, name string, refs ...configuration.ObjectReference) models.Object {
+		obj := models.Object{
+			Cluster:    "test-cluster",
+			Name:       name,
+			Namespace:  namespace,
+			Kind:       "Kustomization",
+			APIGroup:   "kustomize.toolkit.fluxcd.io",
+			APIVersion: "v1",
+			Category:   configuration.CategoryAutomation,
+		}
+		for _, ref := range refs {
+			obj.Relations = append(obj.Relations, models.NewObjectRelation(obj, ref))
+		}
+		return obj
+	}
+
+	repository := models.Object{
+		Cluster:    "test-cluster",
+		Name:       "flux-system",
+		Namespace:  "flux-system",
+		Kind:       "GitRepository",
+		APIGroup:   "source.toolkit.fluxcd.io",
+		APIVersion: "v1",
+		Category:   configuration.CategorySource,
+	}
+	apps := newKustomization("flux-system", "apps", source, workload("apps", "podinfo"), workload("forbidden", "secret"))
+	// the ingress is only reached through a kustomization that cannot be seen
+	infra := newKustomization("forbidden", "infra", source, workload("ingress", "ingress"))
+
+	g.Expect(s.StoreObjects(ctx, []models.Object{repository, apps, infra})).To(Succeed())
+
+	related := func(relations ObjectRelations) []string {
+		result := []string{}
+		for _, o := range relations.Objects {
+			result = append(result, fmt.Sprintf("%s %s/%s %d %t", o.Direction, o.Kind, o.Name, o.Depth, o.Object != nil))
+		}
+		return result
+	}
+
+	tests := []struct {
+		name              string
+		id                string
+		depth             int
+		expected          []string
+		expectedRelations int
+	}{
+		{
+			name:              "downstream of a source",
+			id:                repository.GetID(),
+			depth:             1,
+			expected:          []string{"downstream Kustomization/apps 1 true"},
+			expectedRelations: 1,
+		},
+		{
+			name:  "downstream of a source with depth",
+			id:    repository.GetID(),
+			depth: 2,
+			expected: []string{
+				"downstream Kustomization/apps 1 true",
+				"downstream Deployment/podinfo 2 false",
+			},
+			expectedRelations: 2,
+		},
+		{
+			name:  "upstream and downstream",
+			id:    apps.GetID(),
+			depth: 0,
+			expected: []string{
+				"upstream GitRepository/flux-system 1 true",
+				"downstream Deployment/podinfo 1 false",
+			},
+			expectedRelations: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			relations, err := q.GetObjectRelations(ctx, tt.id, tt.depth)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(related(relations)).To(Equal(tt.expected))
+			g.Expect(relations.Relations).To(HaveLen(tt.expectedRelations))
+		})
+	}
+
+	t.Run("unauthorised objects are not found", func(t *testing.T) {
+		_, err := q.GetObjectRelations(ctx, infra.GetID(), 1)
+		g.Expect(err).To(MatchError(ErrObjectNotFound))
+
+		_, err = q.GetObjectRelations(ctx, "test-cluster/default/missing", 1)
+		g.Expect(err).To(MatchError(ErrObjectNotFound))
+	})
+
+	t.Run("depth is limited", func(t *testing.T) {
+		_, err := q.GetObjectRelations(ctx, repository.GetID(), MaxRelationsDepth+1)
+		g.Expect(err).To(MatchError(MatchRegexp("depth 11 exceeds the maximum of 10")))
+	})
+}
+
+func TestCompareObjects(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	dir, err := os.MkdirTemp("", "test")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	db, err := store.CreateSQLiteDB(dir)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	s, err := store.NewSQLiteStore(db, logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	q := &qs{
+		log:   logr.Discard(),
+		debug: logr.Discard(),
+		r:     s,
+		authorizer: predicateAuthz{
+			predicate: func(obj models.Object) (bool, error) {
+				return obj.Cluster != "forbidden", nil
+			},
+		},
+	}
+
+	ctx := auth.WithPrincipal(context.Background(), &auth.UserPrincipal{
+		ID: "test",
+	})
+
+	newKustomization := func(cluster string, u map[string]interface{}) models.Object {
+		// stored as the collector stores them
+		raw, err := json.Marshal(models.NewNormalizedObject(&unstructured.Unstructured{Object: u}, configuration.KustomizationObjectKind))
+		g.Expect(err).NotTo(HaveOccurred())
+
+		return models.Object{
+			Cluster:      cluster,
+			Name:         "apps",
+			Namespace:    "flux-system",
+			Kind:         "Kustomization",
+			APIGroup:     "kustomize.toolkit.fluxcd.io",
+			APIVersion:   "v1",
+			Category:     configuration.CategoryAutomation,
+			Unstructured: raw,
+		}
+	}
+
+	prod := newKustomization("prod", map[string]interface{}{
+		"metadata": map[string]interface{}{"labels": map[string]interface{}{"env": "prod", "team": "apps"}},
+		"spec":     map[string]interface{}{"interval": "10m", "path": "./apps/prod", "prune": true},
+		"status":   map[string]interface{}{"lastAppliedRevision": "main@sha1:abc"},
+	})
+	staging := newKustomization("staging", map[string]interface{}{
+		"metadata": map[string]interface{}{"labels": map[string]interface{}{"env": "staging"}},
+		"spec":     map[string]interface{}{"interval": "10m", "path": "./apps/staging", "suspend": true, "prune": true},
+		"status":   map[string]interface{}{"lastAppliedRevision": "main@sha1:abc"},
+	})
+	forbidden := newKustomization("forbidden", map[string]interface{}{
+		"spec": map[string]interface{}{"interval": "1h"},
+	})
+
+	g.Expect(s.StoreObjects(ctx, []models.Object{prod, staging, forbidden})).To(Succeed())
+
+	t.Run("compares an object across clusters with the baseline cluster", func(t *testing.T) {
+		comparison, err := q.CompareObjects(ctx, CompareRequest{Kind: "Kustomization", Namespace: "flux-system", Name: "apps", BaselineCluster: "prod"})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(comparison.Baseline.Cluster).To(Equal("prod"))
+		// objects of clusters that cannot be seen are left out
+		g.Expect(comparison.Objects).To(HaveLen(1))
+
+		c := comparison.Objects[0]
+		g.Expect(c.Object.Cluster).To(Equal("staging"))
+		g.Expect(c.Spec).To(Equal([]FieldDifference{
+			{Path: "path", Change: DiffChanged, Baseline: "./apps/prod", Value: "./apps/staging"},
+			{Path: "suspend", Change: DiffAdded, Value: true},
+		}))
+		g.Expect(c.Status).To(BeEmpty())
+		g.Expect(c.Labels).To(Equal([]FieldDifference{
+			{Path: "env", Change: DiffChanged, Baseline: "prod", Value: "staging"},
+			{Path: "team", Change: DiffRemoved, Baseline: "apps"},
+		}))
+	})
+
+	t.Run("the first cluster is the baseline by default", func(t *testing.T) {
+		comparison, err := q.CompareObjects(ctx, CompareRequest{IDs: []string{staging.GetID(), prod.GetID()}})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(comparison.Baseline.Cluster).To(Equal("prod"))
+		g.Expect(comparison.Objects).To(HaveLen(1))
+	})
+
+	t.Run("unauthorised baselines are not found", func(t *testing.T) {
+		_, err := q.CompareObjects(ctx, CompareRequest{Kind: "Kustomization", Namespace: "flux-system", Name: "apps", BaselineCluster: "forbidden"})
+		g.Expect(err).To(MatchError(ErrBaselineNotFound))
+
+		_, err = q.CompareObjects(ctx, CompareRequest{IDs: []string{forbidden.GetID()}})
+		g.Expect(err).To(MatchError(ErrObjectNotFound))
+	})
+}
+
+func TestRunAggregate_LargeResults(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	// The objects are made as they are read, each with its own payload, and reading them all at
+	// once fails. The memory in use is measured when the last one is read.
+	const numObjects = 10000
+	const payloadSize = 4096
+	iter := &storefakes.FakeIterator{}
+	read := 0
+	iter.NextStub = func() bool {
+		read++
+		return read <= numObjects
+	}
+	var baseline, last runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&baseline)
+	iter.RowStub = func() (models.Object, error) {
+		if read == numObjects {
+			runtime.GC()
+			runtime.ReadMemStats(&last)
+		}
+		return models.Object{
+			Cluster:      fmt.Sprintf("cluster-%d", read%4),
+			Namespace:    "default",
+			Name:         fmt.Sprintf("podinfo-%d", read),
+			Kind:         "HelmRelease",
+			Unstructured: make(json.RawMessage, payloadSize),
+		}, nil
+	}
+	iter.AllReturns(nil, fmt.Errorf("objects must not be kept"))
+	iter.PageReturns(nil, fmt.Errorf("objects must not be kept"))
+
+	idx := &storefakes.FakeIndexReader{}
+	idx.SearchReturns(iter, nil)
+
+	q := &qs{
+		log:   logr.Discard(),
+		debug: logr.Discard(),
+		r:     &storefakes.FakeStoreReader{},
+		index: idx,
+		authorizer: predicateAuthz{
+			predicate: func(obj models.Object) (bool, error) {
+				return obj.Cluster != "cluster-0", nil
+			},
+		},
+	}
+
+	ctx := auth.WithPrincipal(context.Background(), &auth.UserPrincipal{
+		ID: "test",
+	})
+
+	buckets, err := q.RunAggregate(ctx, &query{}, []string{"cluster"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(buckets).To(Equal([]AggregateBucket{
+		{Group: map[string]string{"cluster": "cluster-1"}, Count: numObjects / 4},
+		{Group: map[string]string{"cluster": "cluster-2"}, Count: numObjects / 4},
+		{Group: map[string]string{"cluster": "cluster-3"}, Count: numObjects / 4},
+	}))
+	g.Expect(iter.RowCallCount()).To(Equal(numObjects))
+	g.Expect(iter.CloseCallCount()).To(Equal(1))
+	// keeping the objects would hold on to numObjects*payloadSize bytes
+	g.Expect(int64(last.HeapAlloc) - int64(baseline.HeapAlloc)).To(BeNumerically("<", numObjects*payloadSize/4))
+}
+
+func TestExportQuery(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	dir, err := os.MkdirTemp("", "test")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	db, err := store.CreateSQLiteDB(dir)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	s, err := store.NewSQLiteStore(db, logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	idx, err := store.NewIndexer(s, dir, logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	q := &qs{
+		log:   logr.Discard(),
+		debug: logr.Discard(),
+		r:     s,
+		index: idx,
+		authorizer: predicateAuthz{
+			predicate: func(obj models.Object) (bool, error) {
+				return obj.Namespace != "forbidden", nil
+			},
+		},
+	}
+
+	ctx := auth.WithPrincipal(context.Background(), &auth.UserPrincipal{
+		ID: "test",
+	})
+
+	objects := []models.Object{}
+	for i := 0; i < 5; i++ {
+		namespace := "default"
+		if i == 2 {
+			namespace = "forbidden"
+		}
+		objects = append(objects, models.Object{
+			Cluster:    "test-cluster",
+			Name:       fmt.Sprintf("podinfo-%d", i),
+			Namespace:  namespace,
+			Kind:       "HelmRelease",
+			APIGroup:   "helm.toolkit.fluxcd.io",
+			APIVersion: "v2beta1",
+			Category:   configuration.CategoryAutomation,
+		})
+	}
+
+	g.Expect(s.StoreObjects(ctx, objects)).To(Succeed())
+	g.Expect(idx.Add(ctx, objects)).To(Succeed())
+
+	t.Run("exports every object the principal can see regardless of the limit", func(t *testing.T) {
+		names := []string{}
+		err := q.ExportQuery(ctx, &query{orderBy: "name", limit: 2}, &query{orderBy: "name", limit: 2}, func(obj models.Object) error {
+			names = append(names, obj.Name)
+			return nil
+		})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(names).To(Equal([]string{"podinfo-0", "podinfo-1", "podinfo-3", "podinfo-4"}))
+	})
+
+	t.Run("stops when an object cannot be sent", func(t *testing.T) {
+		sent := 0
+		err := q.ExportQuery(ctx, &query{}, nil, func(obj models.Object) error {
+			sent++
+			return fmt.Errorf("connection closed")
+		})
+		g.Expect(err).To(MatchError("connection closed"))
+		g.Expect(sent).To(Equal(1))
+	})
+}
+
+func TestSavedQueries(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	dir, err := os.MkdirTemp("", "test")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	db, err := store.CreateSQLiteDB(dir)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	s, err := store.NewSQLiteStore(db, logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	idx, err := store.NewIndexer(s, dir, logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(s.StoreRoles(context.Background(), []models.Role{{
+		Name:    "impersonator",
+		Cluster: "management",
+		Kind:    "ClusterRole",
+		PolicyRules: []models.PolicyRule{{
+			APIGroups: models.JoinRuleData([]string{""}),
+			Resources: "users,groups",
+			Verbs:     "impersonate",
+		}},
+	}})).To(Succeed())
+	g.Expect(s.StoreRoleBindings(context.Background(), []models.RoleBinding{{
+		Name:        "admins",
+		Cluster:     "management",
+		Kind:        "ClusterRoleBinding",
+		RoleRefName: "impersonator",
+		RoleRefKind: "ClusterRole",
+		Subjects:    []models.Subject{{Kind: "User", Name: "alice"}},
+	}})).To(Succeed())
+
+	kindToResourceMap, err := testutils.CreateDefaultResourceKindMap()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	q := &qs{
+		log:               logr.Discard(),
+		debug:             logr.Discard(),
+		r:                 s,
+		w:                 s,
+		index:             idx,
+		authorizer:        allowAll,
+		explainer:         rbac.NewAuthorizer(kindToResourceMap),
+		managementCluster: "management",
+		curatedQueries: newCuratedQueries([]configuration.CuratedQuery{
+			{Name: "Helm releases", Filters: []string{"kind:HelmRelease"}, OrderBy: "name", Descending: true},
+		}),
+	}
+
+	alice := auth.WithPrincipal(context.Background(), &auth.UserPrincipal{ID: "alice", Groups: []string{"team-a"}})
+	bob := auth.WithPrincipal(context.Background(), &auth.UserPrincipal{ID: "bob", Groups: []string{"team-a"}})
+	carol := auth.WithPrincipal(context.Background(), &auth.UserPrincipal{ID: "carol", Groups: []string{"team-b"}})
+
+	objects := []models.Object{}
+	for i := 0; i < 3; i++ {
+		objects = append(objects, models.Object{
+			Cluster:    "test-cluster",
+			Name:       fmt.Sprintf("podinfo-%d", i),
+			Namespace:  "default",
+			Kind:       "HelmRelease",
+			APIGroup:   "helm.toolkit.fluxcd.io",
+			APIVersion: "v2beta1",
+			Category:   configuration.CategoryAutomation,
+		})
+	}
+	objects = append(objects, models.Object{
+		Cluster:    "test-cluster",
+		Name:       "flux-system",
+		Namespace:  "flux-system",
+		Kind:       "Kustomization",
+		APIGroup:   "kustomize.toolkit.fluxcd.io",
+		APIVersion: "v1",
+		Category:   configuration.CategoryAutomation,
+	})
+	g.Expect(s.StoreObjects(alice, objects)).To(Succeed())
+	g.Expect(idx.Add(alice, objects)).To(Succeed())
+
+	private, err := q.CreateSavedQuery(alice, models.SavedQuery{Name: "Kustomizations", Filters: []string{"kind:Kustomization"}})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(private.ID).NotTo(BeEmpty())
+	g.Expect(private.Owner).To(Equal("alice"))
+	g.Expect(private.Visibility).To(Equal(models.SavedQueryPrivate))
+
+	group, err := q.CreateSavedQuery(alice, models.SavedQuery{Name: "Team", Terms: "podinfo", Visibility: models.SavedQueryGroup, Group: "team-a"})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	global, err := q.CreateSavedQuery(alice, models.SavedQuery{Name: "Everything", Visibility: models.SavedQueryGlobal})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	structured, err := q.CreateSavedQuery(bob, models.SavedQuery{
+		Name: "Podinfo releases",
+		FilterGroups: []models.SavedQueryFilterGroup{{
+			Filters: []models.SavedQueryFilter{
+				{Field: "kind", Operand: "equal", Values: []string{"HelmRelease"}},
+				{Field: "name", Operand: "in", Values: []string{"podinfo-0", "podinfo-2"}},
+			},
+		}},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	names := func(queries []models.SavedQuery) []string {
+		result := []string{}
+		for _, query := range queries {
+			result = append(result, query.Name)
+		}
+		return result
+	}
+
+	t.Run("rejects invalid queries", func(t *testing.T) {
+		_, err := q.CreateSavedQuery(alice, models.SavedQuery{Visibility: models.SavedQueryGlobal})
+		g.Expect(err).To(MatchError(ErrInvalidSavedQuery))
+
+		_, err = q.CreateSavedQuery(alice, models.SavedQuery{Name: "Other team", Visibility: models.SavedQueryGroup, Group: "team-b"})
+		g.Expect(err).To(MatchError(ErrSavedQueryForbidden))
+
+		_, err = q.CreateSavedQuery(alice, models.SavedQuery{
+			Name:         "Invalid",
+			FilterGroups: []models.SavedQueryFilterGroup{{Filters: []models.SavedQueryFilter{{Field: "kind", Operand: "unknown"}}}},
+		})
+		g.Expect(err).To(MatchError(ErrInvalidSavedQuery))
+	})
+
+	t.Run("only admins save global queries", func(t *testing.T) {
+		_, err := q.CreateSavedQuery(bob, models.SavedQuery{Name: "Everything", Visibility: models.SavedQueryGlobal})
+		g.Expect(err).To(MatchError(ErrSavedQueryForbidden))
+
+		_, err = q.UpdateSavedQuery(bob, models.SavedQuery{ID: structured.ID, Name: "Podinfo releases", Visibility: models.SavedQueryGlobal})
+		g.Expect(err).To(MatchError(ErrSavedQueryForbidden))
+	})
+
+	t.Run("lists the queries visible to the principal", func(t *testing.T) {
+		queries, err := q.ListSavedQueries(alice)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(names(queries)).To(Equal([]string{"Everything", "Helm releases", "Kustomizations", "Team"}))
+
+		queries, err = q.ListSavedQueries(bob)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(names(queries)).To(Equal([]string{"Everything", "Helm releases", "Podinfo releases", "Team"}))
+
+		queries, err = q.ListSavedQueries(carol)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(names(queries)).To(Equal([]string{"Everything", "Helm releases"}))
+	})
+
+	t.Run("hides the queries that are not visible", func(t *testing.T) {
+		_, err := q.GetSavedQuery(bob, private.ID)
+		g.Expect(err).To(MatchError(ErrSavedQueryNotFound))
+
+		_, err = q.ExecuteSavedQuery(carol, group.ID, &query{})
+		g.Expect(err).To(MatchError(ErrSavedQueryNotFound))
+
+		_, err = q.GetSavedQuery(alice, "missing")
+		g.Expect(err).To(MatchError(ErrSavedQueryNotFound))
+	})
+
+	t.Run("executes the queries as saved", func(t *testing.T) {
+		curated := q.curatedQueries[0]
+
+		objs, err := q.ExecuteSavedQuery(carol, curated.ID, &query{limit: 2})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(objs).To(HaveLen(2))
+		g.Expect(objs[0].Name).To(Equal("podinfo-2"))
+		g.Expect(objs[1].Name).To(Equal("podinfo-1"))
+
+		objs, err = q.ExecuteSavedQuery(alice, private.ID, &query{})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(objs).To(HaveLen(1))
+		g.Expect(objs[0].Name).To(Equal("flux-system"))
+
+		objs, err = q.ExecuteSavedQuery(bob, structured.ID, &query{})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(objs).To(HaveLen(2))
+		g.Expect([]string{objs[0].Name, objs[1].Name}).To(ConsistOf("podinfo-0", "podinfo-2"))
+
+		stored, err := q.GetSavedQuery(bob, structured.ID)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(stored.FilterGroups).To(Equal(structured.FilterGroups))
+	})
+
+	t.Run("only the owner changes a query", func(t *testing.T) {
+		_, err := q.UpdateSavedQuery(bob, models.SavedQuery{ID: group.ID, Name: "Mine now", Visibility: models.SavedQueryPrivate})
+		g.Expect(err).To(MatchError(ErrSavedQueryForbidden))
+
+		g.Expect(q.DeleteSavedQuery(carol, global.ID)).To(MatchError(ErrSavedQueryForbidden))
+
+		_, err = q.UpdateSavedQuery(alice, models.SavedQuery{ID: q.curatedQueries[0].ID, Name: "Releases"})
+		g.Expect(err).To(MatchError(ErrSavedQueryForbidden))
+
+		updated, err := q.UpdateSavedQuery(alice, models.SavedQuery{ID: group.ID, Name: "Team podinfo", Terms: "podinfo", Visibility: models.SavedQueryGroup, Group: "team-a"})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(updated.CreatedAt).To(BeTemporally("==", group.CreatedAt))
+
+		stored, err := q.GetSavedQuery(bob, group.ID)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(stored.Name).To(Equal("Team podinfo"))
+		g.Expect(stored.Owner).To(Equal("alice"))
+
+		g.Expect(q.DeleteSavedQuery(alice, private.ID)).To(Succeed())
+		_, err = q.GetSavedQuery(alice, private.ID)
+		g.Expect(err).To(MatchError(ErrSavedQueryNotFound))
+	})
+
+	t.Run("only serves curated queries when read-only", func(t *testing.T) {
+		q.savedQueriesReadOnly = true
+		defer func() { q.savedQueriesReadOnly = false }()
+
+		_, err := q.CreateSavedQuery(alice, models.SavedQuery{Name: "Sources"})
+		g.Expect(err).To(MatchError(ErrSavedQueryForbidden))
+
+		queries, err := q.ListSavedQueries(alice)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(names(queries)).To(Equal([]string{"Helm releases"}))
+	})
+}
+
 type query struct {
 	terms      string
 	filters    []string
@@ -0,0 +1,277 @@
+package rbac
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	rbacv1 "k8s.io/api/rbac/v1"
+	rbacvalidation "k8s.io/kubernetes/pkg/registry/rbac/validation"
+
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+)
+
+// RulesReader reads the roles and role bindings collected from the clusters.
+type RulesReader interface {
+	GetRoles(ctx context.Context) ([]models.Role, error)
+	GetRoleBindings(ctx context.Context) ([]models.RoleBinding, error)
+}
+
+// RuleCache caches the roles and role bindings of the store, and the rules resolved out of them for
+// each principal, cluster and namespace. Queries then neither read the access rules from the store
+// nor resolve them again, which dominates their latency with many role bindings.
+//
+// The cache is invalidated for the clusters whose roles or role bindings are written, see
+// store.NewInvalidatingStore. When other processes write to the store too, like the replicas of
+// sharded collection, maxAge bounds how long the rules they write take to be seen, including the
+// access they revoke. It also bounds how long the rules of principals that stopped querying are kept,
+// along with the size of the cache, which drops the least recently used rules once full.
+type RuleCache struct {
+	reader RulesReader
+	maxAge time.Duration
+	size   int
+	now    func() time.Time
+
+	mu sync.Mutex
+	// generation changes on every invalidation, so that rules read or resolved meanwhile are not cached
+	generation uint64
+	loaded     bool
+	loadedAt   time.Time
+	roles      []models.Role
+	bindings   []models.RoleBinding
+	rules      map[ruleCacheKey]*list.Element
+	// recent orders the cached rules from the most to the least recently used
+	recent *list.List
+}
+
+type ruleCacheKey struct {
+	principal string
+	cluster   string
+	namespace string
+}
+
+type resolvedRules struct {
+	rules []rbacv1.PolicyRule
+	err   error
+}
+
+type ruleCacheEntry struct {
+	key   ruleCacheKey
+	rules resolvedRules
+}
+
+const (
+	// DefaultRuleCacheMaxAge is how long rules are cached when no max age is given.
+	DefaultRuleCacheMaxAge = 10 * time.Minute
+	// ruleCacheSize is how many rules are cached, for as many principals, clusters and namespaces.
+	ruleCacheSize = 10000
+)
+
+// NewRuleCache returns an empty cache of the access rules read from the reader. Cached rules are
+// resolved again after maxAge, or DefaultRuleCacheMaxAge when it is zero.
+func NewRuleCache(reader RulesReader, maxAge time.Duration) *RuleCache {
+	if maxAge <= 0 {
+		maxAge = DefaultRuleCacheMaxAge
+	}
+
+	return &RuleCache{
+		reader: reader,
+		maxAge: maxAge,
+		size:   ruleCacheSize,
+		now:    time.Now,
+		rules:  map[ruleCacheKey]*list.Element{},
+		recent: list.New(),
+	}
+}
+
+// Invalidate drops the rules of the clusters, whose roles or role bindings have changed.
+func (c *RuleCache) Invalidate(clusters []string) {
+	if len(clusters) == 0 {
+		return
+	}
+
+	invalid := map[string]bool{}
+	for _, cluster := range clusters {
+		invalid[cluster] = true
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// the roles and role bindings are read again on the next query, and the rules of other clusters are kept
+	c.generation++
+	c.loaded = false
+	c.roles, c.bindings = nil, nil
+
+	for k, e := range c.rules {
+		if invalid[k.cluster] {
+			c.recent.Remove(e)
+			delete(c.rules, k)
+		}
+	}
+}
+
+// InvalidateAll drops every cached rule.
+func (c *RuleCache) InvalidateAll() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.invalidateAll()
+}
+
+func (c *RuleCache) invalidateAll() {
+	c.generation++
+	c.loaded = false
+	c.roles, c.bindings = nil, nil
+	c.rules = map[ruleCacheKey]*list.Element{}
+	c.recent.Init()
+}
+
+// cached returns the rules of the principal in a namespace of the cluster, if they are cached.
+func (c *RuleCache) cached(key ruleCacheKey) (resolvedRules, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.rules[key]
+	if !ok {
+		return resolvedRules{}, false
+	}
+
+	c.recent.MoveToFront(e)
+	return e.Value.(*ruleCacheEntry).rules, true
+}
+
+// store caches rules resolved out of the access rules of a generation, unless they have changed since.
+// The least recently used rules are dropped when the cache is full.
+func (c *RuleCache) store(key ruleCacheKey, generation uint64, r resolvedRules) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.generation != generation {
+		return
+	}
+
+	if e, ok := c.rules[key]; ok {
+		e.Value.(*ruleCacheEntry).rules = r
+		c.recent.MoveToFront(e)
+		return
+	}
+
+	c.rules[key] = c.recent.PushFront(&ruleCacheEntry{key: key, rules: r})
+	for len(c.rules) > c.size {
+		oldest := c.recent.Back()
+		c.recent.Remove(oldest)
+		delete(c.rules, oldest.Value.(*ruleCacheEntry).key)
+	}
+}
+
+// accessRules returns the roles and role bindings, reading them from the store when they are not cached,
+// along with the generation they belong to. Everything is invalidated once they are older than maxAge.
+func (c *RuleCache) accessRules(ctx context.Context) ([]models.Role, []models.RoleBinding, uint64, error) {
+	c.mu.Lock()
+	if c.loaded && c.now().Sub(c.loadedAt) > c.maxAge {
+		c.invalidateAll()
+	}
+	generation := c.generation
+	if c.loaded {
+		defer c.mu.Unlock()
+		return c.roles, c.bindings, generation, nil
+	}
+	c.mu.Unlock()
+
+	roles, err := c.reader.GetRoles(ctx)
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("error fetching access rules from the store: %w", err)
+	}
+	bindings, err := c.reader.GetRoleBindings(ctx)
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("error fetching access rules from the store: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// the access rules read while they were invalidated may be stale, so they are only used for this query
+	if c.generation == generation {
+		c.roles, c.bindings = roles, bindings
+		c.loaded = true
+		c.loadedAt = c.now()
+	}
+
+	return roles, bindings, generation, nil
+}
+
+// principalRules resolves the rules of a principal for a single query. The resolver of a cluster is
+// built on its first miss, as resolvers cannot be shared between queries.
+type principalRules struct {
+	cache      *RuleCache
+	principal  *auth.UserPrincipal
+	key        string
+	roles      []models.Role
+	bindings   []models.RoleBinding
+	generation uint64
+	resolvers  map[string]rbacvalidation.AuthorizationRuleResolver
+}
+
+// principalRules returns the rules of the principal, with the access rules of the current generation.
+func (c *RuleCache) principalRules(ctx context.Context, principal *auth.UserPrincipal) (*principalRules, error) {
+	roles, bindings, generation, err := c.accessRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &principalRules{
+		cache:      c,
+		principal:  principal,
+		key:        principalKey(principal),
+		roles:      roles,
+		bindings:   bindings,
+		generation: generation,
+		resolvers:  map[string]rbacvalidation.AuthorizationRuleResolver{},
+	}, nil
+}
+
+func (r *principalRules) rulesFor(cluster, namespace string) ([]rbacv1.PolicyRule, error) {
+	key := ruleCacheKey{principal: r.key, cluster: cluster, namespace: namespace}
+	if cached, ok := r.cache.cached(key); ok {
+		return cached.rules, cached.err
+	}
+
+	resolver, ok := r.resolvers[cluster]
+	if !ok {
+		resolver = newRuleResolver(r.roles, r.bindings, cluster)
+		r.resolvers[cluster] = resolver
+	}
+
+	rules, err := resolver.RulesFor((*principalAsInfo)(r.principal), namespace)
+	r.cache.store(key, r.generation, resolvedRules{rules: rules, err: err})
+
+	return rules, err
+}
+
+func newRuleResolver(roles []models.Role, rolebindings []models.RoleBinding, cluster string) rbacvalidation.AuthorizationRuleResolver {
+	getlist := &clusterRBACGetLister{
+		cluster:      cluster,
+		roles:        roles,
+		rolebindings: rolebindings,
+	}
+	getlist.init()
+
+	return rbacvalidation.NewDefaultRuleResolver(
+		rbacvalidation.RoleGetter(getlist),
+		rbacvalidation.RoleBindingLister(getlist),
+		rbacvalidation.ClusterRoleGetter(getlist),
+		rbacvalidation.ClusterRoleBindingLister(getlist))
+}
+
+// principalKey identifies a principal by its id and groups, which are all the rules are resolved from.
+func principalKey(principal *auth.UserPrincipal) string {
+	groups := append([]string{}, principal.Groups...)
+	sort.Strings(groups)
+
+	return principal.ID + "\x00" + strings.Join(groups, "\x00")
+}
@@ -0,0 +1,203 @@
+package rbac
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+)
+
+// staticRulesReader returns the same roles and role bindings, and counts how often they are read.
+type staticRulesReader struct {
+	roles    []models.Role
+	bindings []models.RoleBinding
+	reads    int
+	onRead   func()
+}
+
+func (r *staticRulesReader) GetRoles(ctx context.Context) ([]models.Role, error) {
+	r.reads++
+	if r.onRead != nil {
+		r.onRead()
+	}
+	return r.roles, nil
+}
+
+func (r *staticRulesReader) GetRoleBindings(ctx context.Context) ([]models.RoleBinding, error) {
+	return r.bindings, nil
+}
+
+func TestCachedObjectAuthorizer(t *testing.T) {
+	ctx := context.Background()
+	kindToResource := map[string]string{"HelmRelease": "helmreleases"}
+
+	helmReader := models.Role{
+		Kind: "ClusterRole",
+		Name: "helm-reader",
+		PolicyRules: []models.PolicyRule{{
+			APIGroups: "helm.toolkit.fluxcd.io",
+			Resources: "helmreleases",
+			Verbs:     "get,list,watch",
+		}},
+	}
+	role := func(cluster string) models.Role {
+		r := helmReader
+		r.Cluster = cluster
+		return r
+	}
+	binding := func(cluster, namespace string) models.RoleBinding {
+		return models.RoleBinding{
+			Cluster:     cluster,
+			Kind:        "RoleBinding",
+			Namespace:   namespace,
+			Name:        "helm-reader",
+			RoleRefKind: "ClusterRole",
+			RoleRefName: "helm-reader",
+			Subjects:    []models.Subject{{Kind: "Group", Name: "team-a"}},
+		}
+	}
+	release := func(cluster, namespace string) models.Object {
+		return models.Object{
+			Cluster:    cluster,
+			Namespace:  namespace,
+			APIGroup:   "helm.toolkit.fluxcd.io",
+			APIVersion: "v2beta1",
+			Kind:       "HelmRelease",
+			Name:       "podinfo",
+		}
+	}
+
+	alice := &auth.UserPrincipal{ID: "alice", Groups: []string{"team-a"}}
+
+	newReader := func() *staticRulesReader {
+		return &staticRulesReader{
+			roles:    []models.Role{role("management"), role("leaf")},
+			bindings: []models.RoleBinding{binding("management", "apps"), binding("leaf", "apps")},
+		}
+	}
+
+	allowed := func(g *WithT, authz *Authorizer, principal *auth.UserPrincipal, obj models.Object) bool {
+		allow, err := authz.CachedObjectAuthorizer(ctx, principal)
+		g.Expect(err).NotTo(HaveOccurred())
+		ok, err := allow(obj)
+		g.Expect(err).NotTo(HaveOccurred())
+		return ok
+	}
+
+	t.Run("agrees with the authorizer of the store rules", func(t *testing.T) {
+		g := NewWithT(t)
+		reader := newReader()
+		cached := NewCachingAuthorizer(kindToResource, NewRuleCache(reader, 0))
+		uncached := NewAuthorizer(kindToResource)
+
+		bob := &auth.UserPrincipal{ID: "bob", Groups: []string{"team-b"}}
+		for _, principal := range []*auth.UserPrincipal{alice, bob} {
+			for _, obj := range []models.Object{release("management", "apps"), release("leaf", "apps"), release("leaf", "default")} {
+				expected, err := uncached.ObjectAuthorizer(reader.roles, reader.bindings, principal, obj.Cluster)(obj)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(allowed(g, cached, principal, obj)).To(Equal(expected), "%s %s/%s", principal.ID, obj.Cluster, obj.Namespace)
+			}
+		}
+	})
+
+	t.Run("reads the rules once", func(t *testing.T) {
+		g := NewWithT(t)
+		reader := newReader()
+		authz := NewCachingAuthorizer(kindToResource, NewRuleCache(reader, 0))
+
+		g.Expect(allowed(g, authz, alice, release("leaf", "apps"))).To(BeTrue())
+		g.Expect(allowed(g, authz, alice, release("leaf", "apps"))).To(BeTrue())
+		g.Expect(allowed(g, authz, &auth.UserPrincipal{ID: "alice", Groups: []string{"team-a"}}, release("management", "apps"))).To(BeTrue())
+		g.Expect(reader.reads).To(Equal(1))
+	})
+
+	t.Run("reads the rules again once invalidated", func(t *testing.T) {
+		g := NewWithT(t)
+		reader := newReader()
+		cache := NewRuleCache(reader, 0)
+		authz := NewCachingAuthorizer(kindToResource, cache)
+
+		g.Expect(allowed(g, authz, alice, release("leaf", "apps"))).To(BeTrue())
+
+		reader.bindings = []models.RoleBinding{binding("management", "apps")}
+		cache.Invalidate([]string{"leaf"})
+
+		g.Expect(allowed(g, authz, alice, release("leaf", "apps"))).To(BeFalse())
+		g.Expect(reader.reads).To(Equal(2))
+	})
+
+	t.Run("does not cache rules invalidated while they are read", func(t *testing.T) {
+		g := NewWithT(t)
+		reader := newReader()
+		cache := NewRuleCache(reader, 0)
+		authz := NewCachingAuthorizer(kindToResource, cache)
+
+		reader.onRead = func() {
+			reader.onRead = nil
+			cache.Invalidate([]string{"leaf"})
+		}
+
+		g.Expect(allowed(g, authz, alice, release("leaf", "apps"))).To(BeTrue())
+		g.Expect(cache.rules).To(BeEmpty())
+
+		g.Expect(allowed(g, authz, alice, release("leaf", "apps"))).To(BeTrue())
+		g.Expect(cache.rules).To(HaveLen(1))
+		g.Expect(reader.reads).To(Equal(2))
+	})
+
+	t.Run("reads the rules again after the max age", func(t *testing.T) {
+		g := NewWithT(t)
+		reader := newReader()
+		cache := NewRuleCache(reader, time.Minute)
+		now := time.Now()
+		cache.now = func() time.Time { return now }
+		authz := NewCachingAuthorizer(kindToResource, cache)
+
+		g.Expect(allowed(g, authz, alice, release("leaf", "apps"))).To(BeTrue())
+
+		now = now.Add(30 * time.Second)
+		g.Expect(allowed(g, authz, alice, release("leaf", "apps"))).To(BeTrue())
+		g.Expect(reader.reads).To(Equal(1))
+
+		now = now.Add(time.Minute)
+		reader.bindings = nil
+		g.Expect(allowed(g, authz, alice, release("leaf", "apps"))).To(BeFalse())
+		g.Expect(reader.reads).To(Equal(2))
+	})
+
+	t.Run("reads the rules again after the default max age", func(t *testing.T) {
+		g := NewWithT(t)
+		reader := newReader()
+		cache := NewRuleCache(reader, 0)
+		now := time.Now()
+		cache.now = func() time.Time { return now }
+		authz := NewCachingAuthorizer(kindToResource, cache)
+
+		g.Expect(allowed(g, authz, alice, release("leaf", "apps"))).To(BeTrue())
+
+		now = now.Add(DefaultRuleCacheMaxAge + time.Second)
+		g.Expect(allowed(g, authz, alice, release("leaf", "apps"))).To(BeTrue())
+		g.Expect(reader.reads).To(Equal(2))
+	})
+
+	t.Run("drops the least recently used rules once full", func(t *testing.T) {
+		g := NewWithT(t)
+		reader := newReader()
+		cache := NewRuleCache(reader, 0)
+		cache.size = 2
+		authz := NewCachingAuthorizer(kindToResource, cache)
+
+		g.Expect(allowed(g, authz, alice, release("leaf", "apps"))).To(BeTrue())
+		g.Expect(allowed(g, authz, alice, release("leaf", "default"))).To(BeFalse())
+		g.Expect(allowed(g, authz, alice, release("leaf", "apps"))).To(BeTrue())
+		g.Expect(allowed(g, authz, alice, release("management", "apps"))).To(BeTrue())
+
+		g.Expect(cache.rules).To(HaveLen(2))
+		g.Expect(cache.rules).To(HaveKey(ruleCacheKey{principal: principalKey(alice), cluster: "leaf", namespace: "apps"}))
+		g.Expect(cache.rules).To(HaveKey(ruleCacheKey{principal: principalKey(alice), cluster: "management", namespace: "apps"}))
+	})
+}
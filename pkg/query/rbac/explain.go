@@ -0,0 +1,190 @@
+package rbac
+
+import (
+	"fmt"
+	"sort"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+	k8suser "k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	rbacv1helpers "k8s.io/kubernetes/pkg/apis/rbac/v1"
+	rbacvalidation "k8s.io/kubernetes/pkg/registry/rbac/validation"
+	rbacauth "k8s.io/kubernetes/plugin/pkg/auth/authorizer/rbac"
+
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+)
+
+// MaxNearMisses is the number of near misses returned when a principal cannot see an object.
+const MaxNearMisses = 5
+
+// ExplainAccess tells why the principal can or cannot see the object. The decision is the one
+// of ObjectAuthorizer. The explanation goes through the role bindings of the object's cluster,
+// and returns the rules that let the principal see the object or, when none does, the rules
+// that would with the fewest changes: binding the principal, binding in the object's namespace,
+// or granting the missing verb, API group, resource or resource name.
+func (authz *Authorizer) ExplainAccess(roles []models.Role, rolebindings []models.RoleBinding, principal *auth.UserPrincipal, obj models.Object) models.AccessExplanation {
+	explanation := models.AccessExplanation{}
+
+	allowed, err := authz.ObjectAuthorizer(roles, rolebindings, principal, obj.Cluster)(obj)
+	// objects are hidden when the rules cannot all be resolved, see the query service
+	explanation.Allowed = allowed && err == nil
+	if err != nil {
+		explanation.Error = err.Error()
+	}
+
+	request := &objectAsAttributes{user: principal, kindToResource: authz.kindToResource, object: obj}
+	user := request.GetUser()
+
+	candidates := []models.AccessGrant{}
+	for _, binding := range rolebindings {
+		if binding.Cluster != obj.Cluster {
+			continue
+		}
+
+		role, ok := findBoundRole(roles, binding)
+		if !ok {
+			continue
+		}
+
+		bindingMismatches := []string{}
+		if !bindingAppliesTo(user, binding) {
+			bindingMismatches = append(bindingMismatches, fmt.Sprintf("%s %s does not bind user %s or groups %v", binding.Kind, bindingName(binding), principal.ID, principal.Groups))
+		}
+		if binding.Kind == "RoleBinding" && binding.Namespace != obj.Namespace {
+			bindingMismatches = append(bindingMismatches, fmt.Sprintf("%s %s is not in namespace %q", binding.Kind, bindingName(binding), obj.Namespace))
+		}
+
+		for _, rule := range role.PolicyRules {
+			mismatches := append(append([]string{}, bindingMismatches...), ruleMismatches(request, rule)...)
+			candidates = append(candidates, models.AccessGrant{
+				Role:        role,
+				RoleBinding: binding,
+				Rule:        rule,
+				Mismatches:  mismatches,
+			})
+		}
+	}
+
+	nearMisses := []models.AccessGrant{}
+	for _, candidate := range candidates {
+		if len(candidate.Mismatches) == 0 {
+			explanation.Grants = append(explanation.Grants, candidate)
+		} else {
+			nearMisses = append(nearMisses, candidate)
+		}
+	}
+
+	if !explanation.Allowed {
+		sort.SliceStable(nearMisses, func(i, j int) bool {
+			return len(nearMisses[i].Mismatches) < len(nearMisses[j].Mismatches)
+		})
+		if len(nearMisses) > MaxNearMisses {
+			nearMisses = nearMisses[:MaxNearMisses]
+		}
+		explanation.NearMisses = nearMisses
+	}
+
+	return explanation
+}
+
+// CanImpersonate returns whether the principal can impersonate users and groups in the cluster.
+// Only principals that could act as others are told about the access of others.
+func (authz *Authorizer) CanImpersonate(roles []models.Role, rolebindings []models.RoleBinding, principal *auth.UserPrincipal, cluster string) bool {
+	getlist := &clusterRBACGetLister{
+		cluster:      cluster,
+		roles:        roles,
+		rolebindings: rolebindings,
+	}
+	getlist.init()
+	resolver := rbacvalidation.NewDefaultRuleResolver(getlist, getlist, getlist, getlist)
+
+	user := (*principalAsInfo)(principal)
+	// impersonation is cluster-scoped: only cluster role bindings grant it
+	rules, _ := resolver.RulesFor(user, "")
+
+	for _, resource := range []string{"users", "groups"} {
+		request := authorizer.AttributesRecord{
+			User:            user,
+			Verb:            "impersonate",
+			Resource:        resource,
+			ResourceRequest: true,
+		}
+		if !rbacauth.RulesAllow(request, rules...) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// findBoundRole returns the role a binding refers to, in the namespace of the binding for a Role.
+func findBoundRole(roles []models.Role, binding models.RoleBinding) (models.Role, bool) {
+	for _, role := range roles {
+		if role.Cluster != binding.Cluster || role.Kind != binding.RoleRefKind || role.Name != binding.RoleRefName {
+			continue
+		}
+		if role.Kind == "Role" && role.Namespace != binding.Namespace {
+			continue
+		}
+		return role, true
+	}
+	return models.Role{}, false
+}
+
+// bindingAppliesTo is the subject matching of the Kubernetes rule resolver.
+func bindingAppliesTo(user k8suser.Info, binding models.RoleBinding) bool {
+	for _, subject := range binding.Subjects {
+		switch subject.Kind {
+		case rbacv1.UserKind:
+			if subject.Name == user.GetName() {
+				return true
+			}
+		case rbacv1.GroupKind:
+			for _, group := range user.GetGroups() {
+				if subject.Name == group {
+					return true
+				}
+			}
+		case rbacv1.ServiceAccountKind:
+			namespace := subject.Namespace
+			if namespace == "" {
+				namespace = binding.Namespace
+			}
+			if namespace != "" && serviceaccount.MatchesUsername(namespace, subject.Name, user.GetName()) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ruleMismatches returns why the rule does not allow the request, like DebugRuleAllows.
+func ruleMismatches(req authorizer.Attributes, rule models.PolicyRule) []string {
+	policyRule := makePolicyRules([]models.PolicyRule{rule})[0]
+	mismatches := []string{}
+
+	if !rbacv1helpers.VerbMatches(&policyRule, req.GetVerb()) {
+		mismatches = append(mismatches, fmt.Sprintf("verbs %v do not include %s", policyRule.Verbs, req.GetVerb()))
+	}
+	if !rbacv1helpers.APIGroupMatches(&policyRule, req.GetAPIGroup()) {
+		mismatches = append(mismatches, fmt.Sprintf("API groups %v do not include %q", policyRule.APIGroups, req.GetAPIGroup()))
+	}
+	if !rbacv1helpers.ResourceMatches(&policyRule, req.GetResource(), req.GetSubresource()) {
+		mismatches = append(mismatches, fmt.Sprintf("resources %v do not include %q", policyRule.Resources, req.GetResource()))
+	}
+	if !rbacv1helpers.ResourceNameMatches(&policyRule, req.GetName()) {
+		mismatches = append(mismatches, fmt.Sprintf("resource names %v do not include %s", policyRule.ResourceNames, req.GetName()))
+	}
+
+	return mismatches
+}
+
+func bindingName(binding models.RoleBinding) string {
+	if binding.Namespace == "" {
+		return binding.Name
+	}
+	return binding.Namespace + "/" + binding.Name
+}
@@ -0,0 +1,159 @@
+package rbac
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+)
+
+func TestExplainAccess(t *testing.T) {
+	g := NewWithT(t)
+
+	authz := NewAuthorizer(map[string]string{"HelmRelease": "helmreleases"})
+
+	podinfo := models.Object{
+		Cluster:    "management",
+		Namespace:  "apps",
+		APIGroup:   "helm.toolkit.fluxcd.io",
+		APIVersion: "v2beta1",
+		Kind:       "HelmRelease",
+		Name:       "podinfo",
+	}
+
+	helmReader := models.Role{
+		Cluster: "management",
+		Kind:    "ClusterRole",
+		Name:    "helm-reader",
+		PolicyRules: []models.PolicyRule{{
+			APIGroups: "helm.toolkit.fluxcd.io",
+			Resources: "helmreleases",
+			Verbs:     "get,list,watch",
+		}},
+	}
+	kustomizeReader := models.Role{
+		Cluster: "management",
+		Kind:    "ClusterRole",
+		Name:    "kustomize-reader",
+		PolicyRules: []models.PolicyRule{{
+			APIGroups: "kustomize.toolkit.fluxcd.io",
+			Resources: "kustomizations",
+			Verbs:     "get,list,watch",
+		}},
+	}
+	roles := []models.Role{helmReader, kustomizeReader}
+
+	binding := func(kind, namespace, roleName string, subjects ...models.Subject) models.RoleBinding {
+		return models.RoleBinding{
+			Cluster:     "management",
+			Kind:        kind,
+			Namespace:   namespace,
+			Name:        roleName + "-" + namespace,
+			RoleRefKind: "ClusterRole",
+			RoleRefName: roleName,
+			Subjects:    subjects,
+		}
+	}
+	teamA := models.Subject{Kind: "Group", Name: "team-a"}
+	teamB := models.Subject{Kind: "Group", Name: "team-b"}
+
+	alice := &auth.UserPrincipal{ID: "alice", Groups: []string{"team-a"}}
+
+	tests := []struct {
+		name               string
+		bindings           []models.RoleBinding
+		expectedAllowed    bool
+		expectedGrants     []string
+		expectedNearMisses [][]string
+	}{
+		{
+			name: "allowed by a role binding to a group",
+			bindings: []models.RoleBinding{
+				binding("RoleBinding", "apps", "helm-reader", teamA),
+				binding("RoleBinding", "apps", "kustomize-reader", teamA),
+			},
+			expectedAllowed: true,
+			expectedGrants:  []string{"helm-reader-apps"},
+		},
+		{
+			name: "near misses, closest first",
+			bindings: []models.RoleBinding{
+				binding("RoleBinding", "apps", "kustomize-reader", teamA),
+				binding("RoleBinding", "apps", "helm-reader", teamB),
+				binding("RoleBinding", "default", "helm-reader", teamA),
+			},
+			expectedAllowed: false,
+			expectedNearMisses: [][]string{
+				{`RoleBinding apps/helm-reader-apps does not bind user alice or groups [team-a]`},
+				{`RoleBinding default/helm-reader-default is not in namespace "apps"`},
+				{
+					`API groups [kustomize.toolkit.fluxcd.io] do not include "helm.toolkit.fluxcd.io"`,
+					`resources [kustomizations] do not include "helmreleases"`,
+				},
+			},
+		},
+		{
+			name: "binding to a missing role",
+			bindings: []models.RoleBinding{
+				binding("ClusterRoleBinding", "", "helm-reader", teamA),
+				binding("ClusterRoleBinding", "", "missing", teamA),
+			},
+			expectedAllowed: false,
+			expectedGrants:  []string{"helm-reader-"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			explanation := authz.ExplainAccess(roles, tt.bindings, alice, podinfo)
+
+			g.Expect(explanation.Allowed).To(Equal(tt.expectedAllowed))
+
+			grants := []string{}
+			for _, grant := range explanation.Grants {
+				grants = append(grants, grant.RoleBinding.Name)
+			}
+			g.Expect(grants).To(ConsistOf(tt.expectedGrants))
+
+			nearMisses := [][]string{}
+			for _, nearMiss := range explanation.NearMisses {
+				nearMisses = append(nearMisses, nearMiss.Mismatches)
+			}
+			g.Expect(nearMisses).To(Equal(append([][]string{}, tt.expectedNearMisses...)))
+		})
+	}
+}
+
+func TestCanImpersonate(t *testing.T) {
+	g := NewWithT(t)
+
+	authz := NewAuthorizer(map[string]string{})
+
+	roles := []models.Role{{
+		Cluster: "management",
+		Kind:    "ClusterRole",
+		Name:    "impersonator",
+		PolicyRules: []models.PolicyRule{{
+			APIGroups: models.JoinRuleData([]string{""}),
+			Resources: "users,groups",
+			Verbs:     "impersonate",
+		}},
+	}}
+	bindings := []models.RoleBinding{{
+		Cluster:     "management",
+		Kind:        "ClusterRoleBinding",
+		Name:        "admins",
+		RoleRefKind: "ClusterRole",
+		RoleRefName: "impersonator",
+		Subjects:    []models.Subject{{Kind: "Group", Name: "admins"}},
+	}}
+
+	admin := &auth.UserPrincipal{ID: "alice", Groups: []string{"admins"}}
+	user := &auth.UserPrincipal{ID: "bob", Groups: []string{"team-a"}}
+
+	g.Expect(authz.CanImpersonate(roles, bindings, admin, "management")).To(BeTrue())
+	g.Expect(authz.CanImpersonate(roles, bindings, user, "management")).To(BeFalse())
+	g.Expect(authz.CanImpersonate(roles, bindings, admin, "leaf-cluster")).To(BeFalse())
+}
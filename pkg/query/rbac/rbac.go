@@ -21,6 +21,7 @@ package rbac
 // checks whether a rule permits access to an object.
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -28,7 +29,6 @@ import (
 	k8suser "k8s.io/apiserver/pkg/authentication/user"
 	"k8s.io/apiserver/pkg/authorization/authorizer"
 	rbacv1helpers "k8s.io/kubernetes/pkg/apis/rbac/v1"
-	rbacvalidation "k8s.io/kubernetes/pkg/registry/rbac/validation"
 	rbacauth "k8s.io/kubernetes/plugin/pkg/auth/authorizer/rbac"
 
 	"github.com/weaveworks/weave-gitops/pkg/server/auth"
@@ -44,36 +44,66 @@ func NewAuthorizer(kindToResource map[string]string) *Authorizer {
 	}
 }
 
+// NewCachingAuthorizer constructs an authorizer that also authorizes
+// objects with the access rules and resolved rules of the cache.
+func NewCachingAuthorizer(kindToResource map[string]string, cache *RuleCache) *Authorizer {
+	return &Authorizer{
+		kindToResource: kindToResource,
+		cache:          cache,
+	}
+}
+
 type Authorizer struct {
 	kindToResource map[string]string
+	cache          *RuleCache
 }
 
 // ObjectAuthorizer constructs an authorization predicate given the
 // roles and rolebindings, for the particular cluster and principal.
 func (authz *Authorizer) ObjectAuthorizer(roles []models.Role, rolebindings []models.RoleBinding, principal *auth.UserPrincipal, cluster string) func(models.Object) (bool, error) {
-	getlist := &clusterRBACGetLister{
-		cluster:      cluster,
-		roles:        roles,
-		rolebindings: rolebindings,
-	}
-	getlist.init()
-	resolver := rbacvalidation.NewDefaultRuleResolver(
-		rbacvalidation.RoleGetter(getlist),
-		rbacvalidation.RoleBindingLister(getlist),
-		rbacvalidation.ClusterRoleGetter(getlist),
-		rbacvalidation.ClusterRoleBindingLister(getlist))
+	resolver := newRuleResolver(roles, rolebindings, cluster)
 	request := &objectAsAttributes{user: principal, kindToResource: authz.kindToResource}
 	return func(obj models.Object) (bool, error) {
 		request.object = obj
 		rules, err := resolver.RulesFor(request.GetUser(), obj.Namespace)
-		var ok bool
-		for i := range rules {
-			if ok = rbacauth.RuleAllows(request, &rules[i]); ok {
-				break
-			}
+		return rulesAllow(request, rules), err
+	}
+}
+
+// CachedObjectAuthorizer constructs an authorization predicate for
+// the principal in any cluster, with the access rules and resolved
+// rules of the cache rather than ones read for each query. The
+// predicate is used by a single query at a time.
+func (authz *Authorizer) CachedObjectAuthorizer(ctx context.Context, principal *auth.UserPrincipal) (func(models.Object) (bool, error), error) {
+	if authz.cache == nil {
+		return nil, fmt.Errorf("authorizer has no rule cache")
+	}
+
+	principalRules, err := authz.cache.principalRules(ctx, principal)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &objectAsAttributes{user: principal, kindToResource: authz.kindToResource}
+	return func(obj models.Object) (bool, error) {
+		request.object = obj
+		rules, err := principalRules.rulesFor(obj.Cluster, obj.Namespace)
+		return rulesAllow(request, rules), err
+	}, nil
+}
+
+// Caching tells whether the authorizer has a rule cache.
+func (authz *Authorizer) Caching() bool {
+	return authz.cache != nil
+}
+
+func rulesAllow(request authorizer.Attributes, rules []rbacv1.PolicyRule) bool {
+	for i := range rules {
+		if rbacauth.RuleAllows(request, &rules[i]) {
+			return true
 		}
-		return ok, err
 	}
+	return false
 }
 
 // This is a copy of RuleAllows in
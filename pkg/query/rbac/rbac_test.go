@@ -1,20 +1,73 @@
 package rbac
 
 import (
+	"context"
 	"math/rand"
 	"testing"
 
+	. "github.com/onsi/gomega"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
 	"github.com/weaveworks/weave-gitops/pkg/server/auth"
 )
 
+func TestObjectAuthorizer_CoreGroup(t *testing.T) {
+	authz := NewAuthorizer(map[string]string{"Event": "events"})
+
+	event := models.Object{
+		Cluster:    "management",
+		Namespace:  "default",
+		APIVersion: "v1",
+		Kind:       "Event",
+		Name:       "podinfo.1786a1c2",
+	}
+	user := &auth.UserPrincipal{ID: "alice"}
+
+	authorize := func(apiGroups []string) (bool, error) {
+		roles := []models.Role{{
+			Cluster: "management",
+			Kind:    "ClusterRole",
+			Name:    "event-reader",
+			PolicyRules: []models.PolicyRule{{
+				APIGroups: models.JoinRuleData(apiGroups),
+				Resources: models.JoinRuleData([]string{"events"}),
+				Verbs:     models.JoinRuleData([]string{"get", "list", "watch"}),
+			}},
+		}}
+		bindings := []models.RoleBinding{{
+			Cluster:     "management",
+			Kind:        "ClusterRoleBinding",
+			Name:        "event-readers",
+			RoleRefKind: "ClusterRole",
+			RoleRefName: "event-reader",
+			Subjects:    []models.Subject{{Kind: "User", Name: "alice"}},
+		}}
+		return authz.ObjectAuthorizer(roles, bindings, user, "management")(event)
+	}
+
+	t.Run("rules for the core group allow core objects", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(models.SplitRuleData(models.JoinRuleData([]string{""}))).To(Equal([]string{""}))
+		g.Expect(authorize([]string{""})).To(BeTrue())
+		g.Expect(authorize([]string{"", "apps"})).To(BeTrue())
+	})
+
+	t.Run("rules without API groups allow nothing", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(models.SplitRuleData(models.JoinRuleData(nil))).To(BeNil())
+		g.Expect(authorize(nil)).To(BeFalse())
+		g.Expect(authorize([]string{"apps"})).To(BeFalse())
+	})
+}
+
 // Note on use -- you can run this before and after a change to see
 // what effect it has. To run:
 //
 //	go test -run XXX -bench RBAC ./pkg/query/rbac
 //
 // (the -run XXX bit stops it running tests at the same time)
-func benchmark_RBAC(b *testing.B, numObjects int) {
+func benchmark_RBAC(b *testing.B, numObjects, numRolesPerCluster int, cached bool) {
 	// This tries to give an indicative score for how efficient the
 	// RBAC authorisation is. The worst case is that no rules match
 	// any of the objects, and that could be benchmarked
@@ -61,7 +114,6 @@ func benchmark_RBAC(b *testing.B, numObjects int) {
 		numNamespacesPerCluster = 10  // number of namespaces in each cluster; the objects are assumed to be distributed uniformly amongst the clusters
 		numClusterRoles         = 10  // assume these are the same in each cluster
 		numClusterRoleBindings  = 100 // number of bindings of cluster roles to subjects (usually not our user)
-		numRolesPerCluster      = 100 // make these up and assign randomly to a namespace
 		oddsOfBindingUser       = 50  // assume most roles bind to one subject, what is the chance (1/oddsOfBindingUser) it's our user?
 	)
 
@@ -241,10 +293,26 @@ func benchmark_RBAC(b *testing.B, numObjects int) {
 
 	user := auth.NewUserPrincipal(auth.ID(username), auth.Groups(usergroups))
 
+	// the cache outlives the queries, so it is warmed up by the first
+	// iteration and used by the others, as the rules do not change.
+	cache := NewRuleCache(&staticRulesReader{roles: roles, bindings: rolebindings}, 0)
+
 	b.ResetTimer()
 	resetops()
 
 	for i := 0; i < b.N; i++ {
+		if cached {
+			authz := NewCachingAuthorizer(kindToResource, cache)
+			a, err := authz.CachedObjectAuthorizer(context.Background(), user)
+			if err != nil {
+				b.Fatal(err)
+			}
+			for j := range objects {
+				_, _ = a(objects[j])
+			}
+			continue
+		}
+
 		// this is constructed once and supplied to the service
 		authz := NewAuthorizer(kindToResource)
 
@@ -274,12 +342,28 @@ func benchmark_RBAC(b *testing.B, numObjects int) {
 // This benchmarks the case when all clusters are very likely to have
 // objects.
 func Benchmark_RBAC_50perCluster(b *testing.B) {
-	benchmark_RBAC(b, 50*100)
+	benchmark_RBAC(b, 50*100, 100, false)
+}
+
+// This benchmarks the same case, with the rules resolved for the
+// clusters and namespaces cached across queries.
+func Benchmark_RBAC_50perCluster_Cached(b *testing.B) {
+	benchmark_RBAC(b, 50*100, 100, true)
+}
+
+// This benchmarks a large estate, with some 30000 role bindings,
+// where resolving the rules for each query dominates its latency.
+func Benchmark_RBAC_Large(b *testing.B) {
+	benchmark_RBAC(b, 50*100, 300, false)
+}
+
+func Benchmark_RBAC_Large_Cached(b *testing.B) {
+	benchmark_RBAC(b, 50*100, 300, true)
 }
 
 // This benchmarks the case where the objects only come from a few
 // clusters. This is useful to benchmark, because it is sensitive to
 // improvements that rely on e.g., a lot of sorting ahead of time.
 func Benchmark_RBAC_ltOnePerCluster(b *testing.B) {
-	benchmark_RBAC(b, 10)
+	benchmark_RBAC(b, 10, 100, false)
 }
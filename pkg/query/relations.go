@@ -0,0 +1,181 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/configuration"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+)
+
+// MaxRelationsDepth is the furthest that related objects are looked up.
+const MaxRelationsDepth = 10
+
+// RelatedObject is an object reached from another one through their relations.
+type RelatedObject struct {
+	models.ObjectNode
+	// Object is the related object, or nil when it is not collected, like the workloads applied by a Kustomization.
+	Object *models.Object
+	// Direction tells whether the other object depends on this one, or this one depends on it.
+	Direction configuration.RelationDirection
+	// Depth is the number of relations between both objects.
+	Depth int
+}
+
+// ObjectRelations is the graph of the objects related to an object.
+type ObjectRelations struct {
+	Objects   []RelatedObject
+	Relations []models.ObjectRelation
+}
+
+func (q *qs) GetObjectRelations(ctx context.Context, id string, depth int) (ObjectRelations, error) {
+	principal := auth.Principal(ctx)
+	if principal == nil {
+		return ObjectRelations{}, fmt.Errorf("principal not found")
+	}
+
+	if depth < 1 {
+		depth = 1
+	}
+	if depth > MaxRelationsDepth {
+		return ObjectRelations{}, fmt.Errorf("depth %d exceeds the maximum of %d", depth, MaxRelationsDepth)
+	}
+
+	iter, err := q.r.GetObjects(ctx, []string{id}, nil)
+	if err != nil {
+		return ObjectRelations{}, fmt.Errorf("error fetching object from the store: %w", err)
+	}
+	defer iter.Close()
+
+	objects, err := iter.All()
+	if err != nil {
+		return ObjectRelations{}, fmt.Errorf("error fetching object from the store: %w", err)
+	}
+
+	if len(objects) == 0 {
+		return ObjectRelations{}, ErrObjectNotFound
+	}
+
+	allowed, err := q.objectAuthorizer(ctx, principal)
+	if err != nil {
+		return ObjectRelations{}, err
+	}
+
+	ok, err := allowed(objects[0])
+	if err != nil {
+		return ObjectRelations{}, fmt.Errorf("error checking access: %w", err)
+	}
+	if !ok {
+		// unauthorised objects are not found, so their existence is not disclosed
+		q.debug.Info("unauthorised access", "principal", principal.ID, "object", id)
+		return ObjectRelations{}, ErrObjectNotFound
+	}
+
+	walk := &relationsWalk{
+		qs:        q,
+		allowed:   allowed,
+		relations: map[uint]bool{},
+		result: ObjectRelations{
+			Objects:   []RelatedObject{},
+			Relations: []models.ObjectRelation{},
+		},
+	}
+
+	start := models.NewObjectNode(objects[0])
+	for _, direction := range []configuration.RelationDirection{configuration.RelationUpstream, configuration.RelationDownstream} {
+		if err := walk.walk(ctx, start, direction, depth); err != nil {
+			return ObjectRelations{}, err
+		}
+	}
+
+	return walk.result, nil
+}
+
+type relationsWalk struct {
+	qs      *qs
+	allowed func(models.Object) (bool, error)
+	// relations holds the relations added to the result, as they can be reached twice
+	relations map[uint]bool
+	result    ObjectRelations
+}
+
+// walk adds the objects related to the start node in a direction, breadth first. Objects that the principal
+// cannot see are left out, and so are the objects only reached through them.
+func (w *relationsWalk) walk(ctx context.Context, start models.ObjectNode, direction configuration.RelationDirection, depth int) error {
+	// visible holds the nodes already reached, and whether the principal can see them
+	visible := map[string]bool{start.String(): true}
+	current := []models.ObjectNode{start}
+
+	for level := 1; level <= depth && len(current) > 0; level++ {
+		next := []models.ObjectNode{}
+
+		for _, node := range current {
+			relations, err := w.qs.r.GetObjectRelations(ctx, node)
+			if err != nil {
+				return fmt.Errorf("error fetching object relations from the store: %w", err)
+			}
+
+			for _, relation := range relations {
+				neighbour := relation.From
+				if direction == configuration.RelationDownstream {
+					neighbour = relation.To
+				}
+
+				if neighbour == node {
+					// the relation goes the other way
+					continue
+				}
+
+				ok, reached := visible[neighbour.String()]
+				if !reached {
+					related, allowed, err := w.qs.relatedObject(ctx, neighbour, w.allowed)
+					if err != nil {
+						return err
+					}
+
+					visible[neighbour.String()] = allowed
+					ok = allowed
+
+					if allowed {
+						related.Direction = direction
+						related.Depth = level
+						w.result.Objects = append(w.result.Objects, related)
+						next = append(next, neighbour)
+					}
+				}
+
+				if ok && !w.relations[relation.ID] {
+					w.relations[relation.ID] = true
+					w.result.Relations = append(w.result.Relations, relation)
+				}
+			}
+		}
+
+		current = next
+	}
+
+	return nil
+}
+
+// relatedObject returns the object of the node, and whether the principal can see it.
+func (q *qs) relatedObject(ctx context.Context, node models.ObjectNode, allowed func(models.Object) (bool, error)) (RelatedObject, bool, error) {
+	objects, err := q.r.GetObjectsByNode(ctx, node)
+	if err != nil {
+		return RelatedObject{}, false, fmt.Errorf("error fetching related object from the store: %w", err)
+	}
+
+	related := RelatedObject{ObjectNode: node}
+	obj := node.Object()
+	if len(objects) > 0 {
+		related.Object = &objects[0]
+		obj = objects[0]
+	}
+
+	ok, err := allowed(obj)
+	if err != nil {
+		return RelatedObject{}, false, fmt.Errorf("error checking access: %w", err)
+	}
+
+	return related, ok, nil
+}
@@ -40,8 +40,11 @@ func NewRoleCollector(w store.Store, mgr clusters.Subscriber, sa collector.Imper
 		Log:             log,
 		NewWatcherFunc:  newWatcher,
 		StopWatcherFunc: deleteWatcher,
-		Clusters:        mgr,
-		ServiceAccount:  sa,
+		CountObjectsFunc: func(ctx context.Context) (map[string]int, error) {
+			return countRules(ctx, w)
+		},
+		Clusters:       mgr,
+		ServiceAccount: sa,
 	}
 
 	col, err := collector.NewCollector(opts)
@@ -52,6 +55,27 @@ func NewRoleCollector(w store.Store, mgr clusters.Subscriber, sa collector.Imper
 	return col, nil
 }
 
+// countRules returns the number of roles and role bindings of each cluster.
+func countRules(ctx context.Context, r store.StoreReader) (map[string]int, error) {
+	roles, err := r.GetRoles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get roles: %w", err)
+	}
+	bindings, err := r.GetRoleBindings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role bindings: %w", err)
+	}
+
+	counts := map[string]int{}
+	for _, role := range roles {
+		counts[role.Cluster]++
+	}
+	for _, binding := range bindings {
+		counts[binding.Cluster]++
+	}
+	return counts, nil
+}
+
 func processRecords(objectTransactions []models.ObjectTransaction, store store.Store, log logr.Logger) error {
 	ctx := context.Background()
 	deleteAll := []string{}
@@ -0,0 +1,293 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/configuration"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+	store "github.com/weaveworks/weave-gitops-enterprise/pkg/query/store"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"k8s.io/kubectl/pkg/util/slice"
+)
+
+var (
+	// ErrSavedQueryNotFound is returned for saved queries that do not exist or that the principal cannot see.
+	ErrSavedQueryNotFound = errors.New("saved query not found")
+	// ErrSavedQueryForbidden is returned when changing a saved query that the principal does not own,
+	// a curated one, any saved query when they are read-only, or a global one when they are not an admin.
+	ErrSavedQueryForbidden = errors.New("saved query cannot be changed")
+	// ErrInvalidSavedQuery is returned when saving a query without a name or with an unknown visibility.
+	ErrInvalidSavedQuery = errors.New("invalid saved query")
+)
+
+// curatedQueryNamespace derives stable ids for curated queries from their names.
+var curatedQueryNamespace = uuid.MustParse("5d7d4bbd-4d8e-4b6c-9c1a-6a3c1f0f2b8e")
+
+// PageOption paginates the results of a saved query, that are ordered as saved.
+type PageOption interface {
+	GetLimit() int32
+	GetOffset() int32
+}
+
+// savedQueryOption orders the results as saved and paginates them as requested.
+type savedQueryOption struct {
+	models.SavedQuery
+	page PageOption
+}
+
+func (o savedQueryOption) GetLimit() int32 {
+	return o.page.GetLimit()
+}
+
+func (o savedQueryOption) GetOffset() int32 {
+	return o.page.GetOffset()
+}
+
+// savedStructuredQuery adds the filter groups of a saved query, in the store model, to it.
+type savedStructuredQuery struct {
+	models.SavedQuery
+	filterGroups []store.FilterGroup
+}
+
+func (q savedStructuredQuery) GetFilterGroups() []store.FilterGroup {
+	return q.filterGroups
+}
+
+func toStoreFilterGroups(groups []models.SavedQueryFilterGroup) []store.FilterGroup {
+	result := []store.FilterGroup{}
+	for _, g := range groups {
+		group := store.FilterGroup{
+			Operand: store.GlobalOperand(g.Operand),
+			Filters: []store.Filter{},
+		}
+		for _, f := range g.Filters {
+			group.Filters = append(group.Filters, store.Filter{
+				Field:   f.Field,
+				Operand: store.QueryOperand(f.Operand),
+				Values:  f.Values,
+				Min:     f.Min,
+				Max:     f.Max,
+			})
+		}
+		result = append(result, group)
+	}
+	return result
+}
+
+func newCuratedQueries(curated []configuration.CuratedQuery) []models.SavedQuery {
+	queries := []models.SavedQuery{}
+	for _, c := range curated {
+		queries = append(queries, models.SavedQuery{
+			ID:         uuid.NewSHA1(curatedQueryNamespace, []byte(c.Name)).String(),
+			Name:       c.Name,
+			Terms:      c.Terms,
+			Filters:    c.Filters,
+			OrderBy:    c.OrderBy,
+			Descending: c.Descending,
+			Visibility: models.SavedQueryGlobal,
+			ReadOnly:   true,
+		})
+	}
+	return queries
+}
+
+// canSeeSavedQuery returns whether a saved query is owned by the principal, shared with one of
+// their groups, or global.
+func canSeeSavedQuery(principal *auth.UserPrincipal, query models.SavedQuery) bool {
+	switch query.Visibility {
+	case models.SavedQueryGlobal:
+		return true
+	case models.SavedQueryGroup:
+		return query.Owner == principal.ID || slice.ContainsString(principal.Groups, query.Group, nil)
+	default:
+		return query.Owner == principal.ID
+	}
+}
+
+func (q *qs) ListSavedQueries(ctx context.Context) ([]models.SavedQuery, error) {
+	principal := auth.Principal(ctx)
+	if principal == nil {
+		return nil, fmt.Errorf("principal not found")
+	}
+
+	result := append([]models.SavedQuery{}, q.curatedQueries...)
+
+	if !q.savedQueriesReadOnly {
+		stored, err := q.r.GetSavedQueries(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching saved queries from the store: %w", err)
+		}
+
+		for _, query := range stored {
+			if canSeeSavedQuery(principal, query) {
+				result = append(result, query)
+			}
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result, nil
+}
+
+func (q *qs) GetSavedQuery(ctx context.Context, id string) (models.SavedQuery, error) {
+	principal := auth.Principal(ctx)
+	if principal == nil {
+		return models.SavedQuery{}, fmt.Errorf("principal not found")
+	}
+
+	for _, query := range q.curatedQueries {
+		if query.ID == id {
+			return query, nil
+		}
+	}
+
+	if q.savedQueriesReadOnly {
+		return models.SavedQuery{}, ErrSavedQueryNotFound
+	}
+
+	query, err := q.r.GetSavedQuery(ctx, id)
+	if err != nil {
+		if errors.Is(err, store.ErrSavedQueryNotFound) {
+			return models.SavedQuery{}, ErrSavedQueryNotFound
+		}
+		return models.SavedQuery{}, fmt.Errorf("error fetching saved query from the store: %w", err)
+	}
+
+	if !canSeeSavedQuery(principal, query) {
+		return models.SavedQuery{}, ErrSavedQueryNotFound
+	}
+
+	return query, nil
+}
+
+func (q *qs) CreateSavedQuery(ctx context.Context, query models.SavedQuery) (models.SavedQuery, error) {
+	principal := auth.Principal(ctx)
+	if principal == nil {
+		return models.SavedQuery{}, fmt.Errorf("principal not found")
+	}
+
+	if q.savedQueriesReadOnly || q.w == nil {
+		return models.SavedQuery{}, ErrSavedQueryForbidden
+	}
+
+	now := time.Now().UTC()
+	query.ID = uuid.NewString()
+	query.Owner = principal.ID
+	query.ReadOnly = false
+	if query.Visibility == "" {
+		query.Visibility = models.SavedQueryPrivate
+	}
+	query.CreatedAt = now
+	query.UpdatedAt = now
+
+	if err := q.storeSavedQuery(ctx, principal, query); err != nil {
+		return models.SavedQuery{}, err
+	}
+
+	return query, nil
+}
+
+func (q *qs) UpdateSavedQuery(ctx context.Context, query models.SavedQuery) (models.SavedQuery, error) {
+	existing, err := q.ownedSavedQuery(ctx, query.ID)
+	if err != nil {
+		return models.SavedQuery{}, err
+	}
+
+	existing.Name = query.Name
+	existing.Terms = query.Terms
+	existing.Filters = query.Filters
+	existing.FilterGroups = query.FilterGroups
+	existing.OrderBy = query.OrderBy
+	existing.Descending = query.Descending
+	existing.Visibility = query.Visibility
+	if existing.Visibility == "" {
+		existing.Visibility = models.SavedQueryPrivate
+	}
+	existing.Group = query.Group
+	existing.UpdatedAt = time.Now().UTC()
+
+	if err := q.storeSavedQuery(ctx, auth.Principal(ctx), existing); err != nil {
+		return models.SavedQuery{}, err
+	}
+
+	return existing, nil
+}
+
+func (q *qs) DeleteSavedQuery(ctx context.Context, id string) error {
+	if _, err := q.ownedSavedQuery(ctx, id); err != nil {
+		return err
+	}
+
+	if err := q.w.DeleteSavedQuery(ctx, id); err != nil {
+		return fmt.Errorf("error deleting saved query: %w", err)
+	}
+
+	return nil
+}
+
+func (q *qs) ExecuteSavedQuery(ctx context.Context, id string, opts PageOption) ([]models.Object, error) {
+	query, err := q.GetSavedQuery(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	opt := savedQueryOption{SavedQuery: query, page: opts}
+	if len(query.FilterGroups) > 0 {
+		return q.RunQuery(ctx, savedStructuredQuery{SavedQuery: query, filterGroups: toStoreFilterGroups(query.FilterGroups)}, opt)
+	}
+
+	return q.RunQuery(ctx, query, opt)
+}
+
+// ownedSavedQuery returns a saved query that the principal can change.
+func (q *qs) ownedSavedQuery(ctx context.Context, id string) (models.SavedQuery, error) {
+	query, err := q.GetSavedQuery(ctx, id)
+	if err != nil {
+		return models.SavedQuery{}, err
+	}
+
+	if query.ReadOnly || q.w == nil || query.Owner != auth.Principal(ctx).ID {
+		return models.SavedQuery{}, ErrSavedQueryForbidden
+	}
+
+	return query, nil
+}
+
+func (q *qs) storeSavedQuery(ctx context.Context, principal *auth.UserPrincipal, query models.SavedQuery) error {
+	if err := query.Validate(); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSavedQuery, err)
+	}
+
+	if err := store.ValidateFilterGroups(toStoreFilterGroups(query.FilterGroups)); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSavedQuery, err)
+	}
+
+	// global queries are shown to everyone, so only admins can share them
+	if query.Visibility == models.SavedQueryGlobal {
+		if _, _, _, err := q.requireAdmin(ctx); err != nil {
+			if errors.Is(err, ErrAdminRequired) {
+				return fmt.Errorf("%w: only admins can save global queries", ErrSavedQueryForbidden)
+			}
+			return err
+		}
+	}
+
+	// queries can only be shared with the groups of their owner
+	if query.Visibility == models.SavedQueryGroup && !slice.ContainsString(principal.Groups, query.Group, nil) {
+		return fmt.Errorf("%w: not a member of group %s", ErrSavedQueryForbidden, query.Group)
+	}
+
+	if err := q.w.StoreSavedQuery(ctx, query); err != nil {
+		return fmt.Errorf("error storing saved query: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/weaveworks/weave-gitops-enterprise/pkg/api/query"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// alertRuleRequest is implemented by the create and update alert rule requests.
+type alertRuleRequest interface {
+	GetName() string
+	GetTerms() string
+	GetFilters() []string
+	GetFor() string
+	GetWebhookUrl() string
+	GetWebhookFormat() string
+}
+
+func (s *server) ListAlertRules(ctx context.Context, msg *pb.ListAlertRulesRequest) (*pb.ListAlertRulesResponse, error) {
+	rules, err := s.qs.ListAlertRules(ctx)
+	if err != nil {
+		return nil, alertRuleError(err, "", "failed to list alert rules")
+	}
+
+	response := &pb.ListAlertRulesResponse{Rules: []*pb.AlertRule{}}
+	for _, r := range rules {
+		response.Rules = append(response.Rules, convertToPbAlertRule(r))
+	}
+
+	return response, nil
+}
+
+func (s *server) GetAlertRule(ctx context.Context, msg *pb.GetAlertRuleRequest) (*pb.GetAlertRuleResponse, error) {
+	r, err := s.qs.GetAlertRule(ctx, msg.GetId())
+	if err != nil {
+		return nil, alertRuleError(err, msg.GetId(), "failed to get alert rule")
+	}
+
+	return &pb.GetAlertRuleResponse{Rule: convertToPbAlertRule(r)}, nil
+}
+
+func (s *server) CreateAlertRule(ctx context.Context, msg *pb.CreateAlertRuleRequest) (*pb.CreateAlertRuleResponse, error) {
+	rule, err := convertFromPbAlertRule("", msg)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := s.qs.CreateAlertRule(ctx, rule)
+	if err != nil {
+		return nil, alertRuleError(err, "", "failed to create alert rule")
+	}
+
+	return &pb.CreateAlertRuleResponse{Rule: convertToPbAlertRule(r)}, nil
+}
+
+func (s *server) UpdateAlertRule(ctx context.Context, msg *pb.UpdateAlertRuleRequest) (*pb.UpdateAlertRuleResponse, error) {
+	rule, err := convertFromPbAlertRule(msg.GetId(), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := s.qs.UpdateAlertRule(ctx, rule)
+	if err != nil {
+		return nil, alertRuleError(err, msg.GetId(), "failed to update alert rule")
+	}
+
+	return &pb.UpdateAlertRuleResponse{Rule: convertToPbAlertRule(r)}, nil
+}
+
+func (s *server) DeleteAlertRule(ctx context.Context, msg *pb.DeleteAlertRuleRequest) (*pb.DeleteAlertRuleResponse, error) {
+	if err := s.qs.DeleteAlertRule(ctx, msg.GetId()); err != nil {
+		return nil, alertRuleError(err, msg.GetId(), "failed to delete alert rule")
+	}
+
+	return &pb.DeleteAlertRuleResponse{}, nil
+}
+
+// alertRuleError maps the errors of the alert rules to status codes.
+func alertRuleError(err error, id string, message string) error {
+	switch {
+	case errors.Is(err, query.ErrAdminRequired):
+		return status.Errorf(codes.PermissionDenied, "%v", err)
+	case errors.Is(err, query.ErrAlertRuleNotFound):
+		return status.Errorf(codes.NotFound, "alert rule %s not found", id)
+	case errors.Is(err, query.ErrInvalidAlertRule):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, query.ErrAlertRulesReadOnly):
+		return status.Errorf(codes.FailedPrecondition, "%v: they require a postgres store to be persisted", err)
+	default:
+		return fmt.Errorf("%s: %w", message, err)
+	}
+}
+
+func convertFromPbAlertRule(id string, msg alertRuleRequest) (models.AlertRule, error) {
+	rule := models.AlertRule{
+		ID:            id,
+		Name:          msg.GetName(),
+		Terms:         msg.GetTerms(),
+		Filters:       msg.GetFilters(),
+		WebhookURL:    msg.GetWebhookUrl(),
+		WebhookFormat: models.AlertWebhookFormat(msg.GetWebhookFormat()),
+	}
+
+	if msg.GetFor() != "" {
+		d, err := time.ParseDuration(msg.GetFor())
+		if err != nil {
+			return models.AlertRule{}, status.Errorf(codes.InvalidArgument, "invalid for duration %q: %v", msg.GetFor(), err)
+		}
+		rule.For = d
+	}
+
+	return rule, nil
+}
+
+func convertToPbAlertRule(r models.AlertRule) *pb.AlertRule {
+	state := r.State.State
+	if state == "" {
+		state = models.AlertInactive
+	}
+
+	pbState := &pb.AlertRuleState{
+		State:           string(state),
+		MatchingCount:   int32(len(r.State.Matching)),
+		Firing:          []*pb.AlertObject{},
+		FiringSince:     formatTime(r.State.FiringSince),
+		ResolvedAt:      formatTime(r.State.ResolvedAt),
+		LastEvaluatedAt: formatTime(r.State.LastEvaluatedAt),
+		LastNotifiedAt:  formatTime(r.State.LastNotifiedAt),
+		LastError:       r.State.LastError,
+	}
+	for _, obj := range r.State.Firing {
+		pbState.Firing = append(pbState.Firing, &pb.AlertObject{
+			Id:        obj.ID,
+			Cluster:   obj.Cluster,
+			Namespace: obj.Namespace,
+			Kind:      obj.Kind,
+			Name:      obj.Name,
+			Status:    obj.Status,
+			Message:   obj.Message,
+			Since:     formatTime(obj.Since),
+		})
+	}
+
+	return &pb.AlertRule{
+		Id:            r.ID,
+		Name:          r.Name,
+		Terms:         r.Terms,
+		Filters:       r.Filters,
+		For:           r.For.String(),
+		WebhookUrl:    r.WebhookURL,
+		WebhookFormat: string(r.WebhookFormat),
+		Owner:         r.Owner,
+		CreatedAt:     formatTime(r.CreatedAt),
+		UpdatedAt:     formatTime(r.UpdatedAt),
+		State:         pbState,
+	}
+}
@@ -0,0 +1,239 @@
+package server
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	pb "github.com/weaveworks/weave-gitops-enterprise/pkg/api/query"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sigs.k8s.io/yaml"
+)
+
+// exportQueryPath is the HTTP route that exports the results of a query.
+const exportQueryPath = "/v1/query/export"
+
+type exportFormat string
+
+const (
+	exportFormatCSV    exportFormat = "csv"
+	exportFormatNDJSON exportFormat = "ndjson"
+	exportFormatYAML   exportFormat = "yaml"
+)
+
+var exportContentTypes = map[exportFormat]string{
+	exportFormatCSV:    "text/csv",
+	exportFormatNDJSON: "application/x-ndjson",
+	exportFormatYAML:   "application/yaml",
+}
+
+// exportColumns are the fields of the exported objects, followed by the selected labels.
+var exportColumns = []string{"id", "cluster", "namespace", "apiGroup", "apiVersion", "kind", "name", "status", "message", "category", "tenant"}
+
+// exportedObject is an exported object. Its fields match exportColumns.
+type exportedObject struct {
+	ID         string            `json:"id"`
+	Cluster    string            `json:"cluster"`
+	Namespace  string            `json:"namespace"`
+	APIGroup   string            `json:"apiGroup"`
+	APIVersion string            `json:"apiVersion"`
+	Kind       string            `json:"kind"`
+	Name       string            `json:"name"`
+	Status     string            `json:"status"`
+	Message    string            `json:"message"`
+	Category   string            `json:"category"`
+	Tenant     string            `json:"tenant"`
+	Labels     map[string]string `json:"labels,omitempty"`
+}
+
+func (o exportedObject) values() []string {
+	return []string{o.ID, o.Cluster, o.Namespace, o.APIGroup, o.APIVersion, o.Kind, o.Name, o.Status, o.Message, o.Category, o.Tenant}
+}
+
+// queryExporter exports every object matching a query that the principal can see.
+type queryExporter interface {
+	ExportQuery(ctx context.Context, msg *pb.DoQueryRequest, send func(models.Object) error) error
+}
+
+func (s *server) ExportQuery(ctx context.Context, msg *pb.DoQueryRequest, send func(models.Object) error) error {
+	if msg.GetAsOf() != "" {
+		return status.Errorf(codes.InvalidArgument, "exports cannot be made as of a time")
+	}
+
+	q, err := newStructuredQuery(msg)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid query: %v", err)
+	}
+
+	if err := s.qs.ExportQuery(ctx, q, msg, send); err != nil {
+		return fmt.Errorf("failed to export query: %w", err)
+	}
+
+	return nil
+}
+
+// newExportQueryHandler serves the results of a query as a file. The query is the same as in DoQuery,
+// in the query parameters or as the body of a POST, but every result is exported regardless of the limit.
+// The format and the labels to export are query parameters:
+//
+//	/v1/query/export?format=csv&labels=templateType&terms=podinfo
+func newExportQueryHandler(mux *runtime.ServeMux, srv queryExporter) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		inbound, outbound := runtime.MarshalerForRequest(mux, r)
+		httpError := func(err error) {
+			runtime.HTTPError(r.Context(), mux, outbound, w, r, err)
+		}
+
+		params := r.URL.Query()
+
+		format := exportFormat(params.Get("format"))
+		if format == "" {
+			format = exportFormatCSV
+		}
+		if _, ok := exportContentTypes[format]; !ok {
+			httpError(status.Errorf(codes.InvalidArgument, "unsupported export format %q: use csv, ndjson or yaml", format))
+			return
+		}
+
+		labels := []string{}
+		for _, value := range params["labels"] {
+			for _, label := range strings.Split(value, ",") {
+				if label = strings.TrimPrefix(strings.TrimSpace(label), "labels."); label != "" {
+					labels = append(labels, label)
+				}
+			}
+		}
+
+		msg := &pb.DoQueryRequest{}
+		if r.Method == http.MethodPost {
+			if err := inbound.NewDecoder(r.Body).Decode(msg); err != nil {
+				httpError(status.Errorf(codes.InvalidArgument, "invalid export query: %v", err))
+				return
+			}
+		} else {
+			filter := utilities.NewDoubleArray([][]string{{"format"}, {"labels"}})
+			if err := runtime.PopulateQueryParameters(msg, params, filter); err != nil {
+				httpError(status.Errorf(codes.InvalidArgument, "invalid export query: %v", err))
+				return
+			}
+		}
+
+		exporter := &objectExporter{w: w, format: format, labels: labels}
+
+		err := srv.ExportQuery(r.Context(), msg, exporter.write)
+		if err == nil {
+			err = exporter.close()
+		}
+		// once started, the status is already written, and the export is left unfinished
+		if err != nil && !exporter.started {
+			httpError(err)
+		}
+	}
+}
+
+// objectExporter writes the exported objects. The response starts with the first object, or
+// when closed, so a query that fails before any object is found is responded with an error.
+type objectExporter struct {
+	w       http.ResponseWriter
+	format  exportFormat
+	labels  []string
+	csv     *csv.Writer
+	started bool
+}
+
+func (e *objectExporter) start() error {
+	if e.started {
+		return nil
+	}
+	e.started = true
+
+	e.w.Header().Set("Content-Type", exportContentTypes[e.format])
+	e.w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", "explorer-export."+string(e.format)))
+	e.w.WriteHeader(http.StatusOK)
+
+	if e.format != exportFormatCSV {
+		return nil
+	}
+
+	e.csv = csv.NewWriter(e.w)
+
+	header := append([]string{}, exportColumns...)
+	for _, label := range e.labels {
+		header = append(header, "labels."+label)
+	}
+
+	return e.csv.Write(header)
+}
+
+func (e *objectExporter) write(obj models.Object) error {
+	if err := e.start(); err != nil {
+		return fmt.Errorf("failed to write export: %w", err)
+	}
+
+	exported := exportedObject{
+		ID:         obj.GetID(),
+		Cluster:    obj.Cluster,
+		Namespace:  obj.Namespace,
+		APIGroup:   obj.APIGroup,
+		APIVersion: obj.APIVersion,
+		Kind:       obj.Kind,
+		Name:       obj.Name,
+		Status:     obj.Status,
+		Message:    obj.Message,
+		Category:   string(obj.Category),
+		Tenant:     obj.Tenant,
+		Labels:     obj.Labels,
+	}
+
+	if len(e.labels) > 0 {
+		exported.Labels = map[string]string{}
+		for _, label := range e.labels {
+			if value, ok := obj.Labels[label]; ok {
+				exported.Labels[label] = value
+			}
+		}
+	}
+
+	var err error
+	switch e.format {
+	case exportFormatCSV:
+		values := exported.values()
+		for _, label := range e.labels {
+			values = append(values, exported.Labels[label])
+		}
+		err = e.csv.Write(values)
+	case exportFormatNDJSON:
+		err = json.NewEncoder(e.w).Encode(exported)
+	case exportFormatYAML:
+		var data []byte
+		data, err = yaml.Marshal(exported)
+		if err == nil {
+			_, err = fmt.Fprintf(e.w, "---\n%s", data)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write export: %w", err)
+	}
+
+	return nil
+}
+
+func (e *objectExporter) close() error {
+	if err := e.start(); err != nil {
+		return fmt.Errorf("failed to write export: %w", err)
+	}
+
+	if e.csv != nil {
+		e.csv.Flush()
+		return e.csv.Error()
+	}
+
+	return nil
+}
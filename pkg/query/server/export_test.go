@@ -0,0 +1,177 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	. "github.com/onsi/gomega"
+	pb "github.com/weaveworks/weave-gitops-enterprise/pkg/api/query"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeQueryExporter struct {
+	received *pb.DoQueryRequest
+	objects  []models.Object
+	err      error
+}
+
+func (e *fakeQueryExporter) ExportQuery(ctx context.Context, msg *pb.DoQueryRequest, send func(models.Object) error) error {
+	e.received = msg
+
+	if e.err != nil {
+		return e.err
+	}
+
+	for _, obj := range e.objects {
+		if err := send(obj); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func TestExportQueryHandler(t *testing.T) {
+	g := NewWithT(t)
+
+	objects := []models.Object{
+		{
+			Cluster:    "management",
+			Namespace:  "flux-system",
+			APIGroup:   "helm.toolkit.fluxcd.io",
+			APIVersion: "v2beta1",
+			Kind:       "HelmRelease",
+			Name:       "podinfo",
+			Status:     "Success",
+			Message:    "Release reconciliation succeeded, upgrade: ok",
+			Category:   "automation",
+			Labels:     map[string]string{"chart": "podinfo", "team": "apps"},
+		},
+		{
+			Cluster:    "dev",
+			Namespace:  "flux-system",
+			APIGroup:   "helm.toolkit.fluxcd.io",
+			APIVersion: "v2beta1",
+			Kind:       "HelmRelease",
+			Name:       "redis",
+			Status:     "Failed",
+			Category:   "automation",
+			Tenant:     "dev-team",
+		},
+	}
+
+	tests := []struct {
+		name         string
+		method       string
+		url          string
+		body         string
+		err          error
+		expectedCode int
+		expectedType string
+		expected     []string
+	}{
+		{
+			name:         "csv with selected labels",
+			method:       http.MethodGet,
+			url:          exportQueryPath + "?terms=podinfo&filters=kind:HelmRelease&labels=chart,labels.team",
+			expectedCode: http.StatusOK,
+			expectedType: "text/csv",
+			expected: []string{
+				"id,cluster,namespace,apiGroup,apiVersion,kind,name,status,message,category,tenant,labels.chart,labels.team",
+				`management/flux-system/helm.toolkit.fluxcd.io/v2beta1/HelmRelease/podinfo,management,flux-system,helm.toolkit.fluxcd.io,v2beta1,HelmRelease,podinfo,Success,"Release reconciliation succeeded, upgrade: ok",automation,,podinfo,apps`,
+				"dev/flux-system/helm.toolkit.fluxcd.io/v2beta1/HelmRelease/redis,dev,flux-system,helm.toolkit.fluxcd.io,v2beta1,HelmRelease,redis,Failed,,automation,dev-team,,",
+			},
+		},
+		{
+			name:         "ndjson",
+			method:       http.MethodGet,
+			url:          exportQueryPath + "?format=ndjson&terms=podinfo&filters=kind:HelmRelease&labels=team",
+			expectedCode: http.StatusOK,
+			expectedType: "application/x-ndjson",
+			expected: []string{
+				`{"id":"management/flux-system/helm.toolkit.fluxcd.io/v2beta1/HelmRelease/podinfo","cluster":"management","namespace":"flux-system","apiGroup":"helm.toolkit.fluxcd.io","apiVersion":"v2beta1","kind":"HelmRelease","name":"podinfo","status":"Success","message":"Release reconciliation succeeded, upgrade: ok","category":"automation","tenant":"","labels":{"team":"apps"}}`,
+				`{"id":"dev/flux-system/helm.toolkit.fluxcd.io/v2beta1/HelmRelease/redis","cluster":"dev","namespace":"flux-system","apiGroup":"helm.toolkit.fluxcd.io","apiVersion":"v2beta1","kind":"HelmRelease","name":"redis","status":"Failed","message":"","category":"automation","tenant":"dev-team"}`,
+			},
+		},
+		{
+			name:         "yaml from a posted query",
+			method:       http.MethodPost,
+			url:          exportQueryPath + "?format=yaml",
+			body:         `{"terms":"podinfo","filters":["kind:HelmRelease"]}`,
+			expectedCode: http.StatusOK,
+			expectedType: "application/yaml",
+			expected: []string{
+				"---",
+				"apiGroup: helm.toolkit.fluxcd.io",
+				"apiVersion: v2beta1",
+				"category: automation",
+				"cluster: management",
+				"id: management/flux-system/helm.toolkit.fluxcd.io/v2beta1/HelmRelease/podinfo",
+				"kind: HelmRelease",
+				"labels:",
+				"  chart: podinfo",
+				"  team: apps",
+				"message: 'Release reconciliation succeeded, upgrade: ok'",
+				"name: podinfo",
+				"namespace: flux-system",
+				"status: Success",
+				`tenant: ""`,
+				"---",
+				"apiGroup: helm.toolkit.fluxcd.io",
+				"apiVersion: v2beta1",
+				"category: automation",
+				"cluster: dev",
+				"id: dev/flux-system/helm.toolkit.fluxcd.io/v2beta1/HelmRelease/redis",
+				"kind: HelmRelease",
+				`message: ""`,
+				"name: redis",
+				"namespace: flux-system",
+				"status: Failed",
+				"tenant: dev-team",
+			},
+		},
+		{
+			name:         "unsupported format",
+			method:       http.MethodGet,
+			url:          exportQueryPath + "?format=xlsx&terms=podinfo&filters=kind:HelmRelease",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "invalid query",
+			method:       http.MethodGet,
+			url:          exportQueryPath + "?terms=podinfo&filters=kind:HelmRelease",
+			err:          status.Errorf(codes.InvalidArgument, "invalid query"),
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux := runtime.NewServeMux(runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{}))
+			srv := &fakeQueryExporter{objects: objects, err: tt.err}
+			g.Expect(mux.HandlePath(tt.method, exportQueryPath, newExportQueryHandler(mux, srv))).To(Succeed())
+
+			req := httptest.NewRequest(tt.method, tt.url, strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+
+			mux.ServeHTTP(w, req)
+
+			g.Expect(w.Code).To(Equal(tt.expectedCode))
+			if tt.expectedCode != http.StatusOK {
+				return
+			}
+
+			g.Expect(w.Header().Get("Content-Type")).To(Equal(tt.expectedType))
+			g.Expect(strings.Split(strings.TrimSuffix(w.Body.String(), "\n"), "\n")).To(Equal(tt.expected))
+
+			g.Expect(srv.received.GetTerms()).To(Equal("podinfo"))
+			g.Expect(srv.received.GetFilters()).To(Equal([]string{"kind:HelmRelease"}))
+		})
+	}
+}
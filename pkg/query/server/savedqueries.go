@@ -0,0 +1,181 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/weaveworks/weave-gitops-enterprise/pkg/api/query"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *server) ListSavedQueries(ctx context.Context, msg *pb.ListSavedQueriesRequest) (*pb.ListSavedQueriesResponse, error) {
+	queries, err := s.qs.ListSavedQueries(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list saved queries: %w", err)
+	}
+
+	response := &pb.ListSavedQueriesResponse{SavedQueries: []*pb.SavedQuery{}}
+	for _, q := range queries {
+		response.SavedQueries = append(response.SavedQueries, convertToPbSavedQuery(q))
+	}
+
+	return response, nil
+}
+
+func (s *server) GetSavedQuery(ctx context.Context, msg *pb.GetSavedQueryRequest) (*pb.GetSavedQueryResponse, error) {
+	q, err := s.qs.GetSavedQuery(ctx, msg.GetId())
+	if err != nil {
+		return nil, savedQueryError(err, msg.GetId(), "failed to get saved query")
+	}
+
+	return &pb.GetSavedQueryResponse{SavedQuery: convertToPbSavedQuery(q)}, nil
+}
+
+func (s *server) CreateSavedQuery(ctx context.Context, msg *pb.CreateSavedQueryRequest) (*pb.CreateSavedQueryResponse, error) {
+	q, err := s.qs.CreateSavedQuery(ctx, models.SavedQuery{
+		Name:         msg.GetName(),
+		Terms:        msg.GetTerms(),
+		Filters:      msg.GetFilters(),
+		FilterGroups: convertFromPbSavedQueryFilterGroups(msg.GetFilterGroups()),
+		OrderBy:      msg.GetOrderBy(),
+		Descending:   msg.GetDescending(),
+		Visibility:   models.SavedQueryVisibility(msg.GetVisibility()),
+		Group:        msg.GetGroup(),
+	})
+	if err != nil {
+		return nil, savedQueryError(err, "", "failed to create saved query")
+	}
+
+	return &pb.CreateSavedQueryResponse{SavedQuery: convertToPbSavedQuery(q)}, nil
+}
+
+func (s *server) UpdateSavedQuery(ctx context.Context, msg *pb.UpdateSavedQueryRequest) (*pb.UpdateSavedQueryResponse, error) {
+	q, err := s.qs.UpdateSavedQuery(ctx, models.SavedQuery{
+		ID:           msg.GetId(),
+		Name:         msg.GetName(),
+		Terms:        msg.GetTerms(),
+		Filters:      msg.GetFilters(),
+		FilterGroups: convertFromPbSavedQueryFilterGroups(msg.GetFilterGroups()),
+		OrderBy:      msg.GetOrderBy(),
+		Descending:   msg.GetDescending(),
+		Visibility:   models.SavedQueryVisibility(msg.GetVisibility()),
+		Group:        msg.GetGroup(),
+	})
+	if err != nil {
+		return nil, savedQueryError(err, msg.GetId(), "failed to update saved query")
+	}
+
+	return &pb.UpdateSavedQueryResponse{SavedQuery: convertToPbSavedQuery(q)}, nil
+}
+
+func (s *server) DeleteSavedQuery(ctx context.Context, msg *pb.DeleteSavedQueryRequest) (*pb.DeleteSavedQueryResponse, error) {
+	if err := s.qs.DeleteSavedQuery(ctx, msg.GetId()); err != nil {
+		return nil, savedQueryError(err, msg.GetId(), "failed to delete saved query")
+	}
+
+	return &pb.DeleteSavedQueryResponse{}, nil
+}
+
+func (s *server) ExecuteSavedQuery(ctx context.Context, msg *pb.ExecuteSavedQueryRequest) (*pb.ExecuteSavedQueryResponse, error) {
+	objs, err := s.qs.ExecuteSavedQuery(ctx, msg.GetId(), msg)
+	if err != nil {
+		return nil, savedQueryError(err, msg.GetId(), "failed to execute saved query")
+	}
+
+	return &pb.ExecuteSavedQueryResponse{
+		Objects: convertToPbObject(objs),
+	}, nil
+}
+
+// savedQueryError maps the errors of the saved queries to status codes.
+func savedQueryError(err error, id string, message string) error {
+	switch {
+	case errors.Is(err, query.ErrSavedQueryNotFound):
+		return status.Errorf(codes.NotFound, "saved query %s not found", id)
+	case errors.Is(err, query.ErrSavedQueryForbidden):
+		return status.Errorf(codes.PermissionDenied, "%v", err)
+	case errors.Is(err, query.ErrInvalidSavedQuery):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	default:
+		return fmt.Errorf("%s: %w", message, err)
+	}
+}
+
+func convertToPbSavedQuery(q models.SavedQuery) *pb.SavedQuery {
+	pbQuery := &pb.SavedQuery{
+		Id:           q.ID,
+		Name:         q.Name,
+		Terms:        q.Terms,
+		Filters:      q.Filters,
+		FilterGroups: convertToPbSavedQueryFilterGroups(q.FilterGroups),
+		OrderBy:      q.OrderBy,
+		Descending:   q.Descending,
+		Owner:        q.Owner,
+		Visibility:   string(q.Visibility),
+		Group:        q.Group,
+		ReadOnly:     q.ReadOnly,
+	}
+
+	// curated queries are not stored
+	if !q.CreatedAt.IsZero() {
+		pbQuery.CreatedAt = q.CreatedAt.Format(time.RFC3339)
+		pbQuery.UpdatedAt = q.UpdatedAt.Format(time.RFC3339)
+	}
+
+	return pbQuery
+}
+
+func convertFromPbSavedQueryFilterGroups(pbGroups []*pb.FilterGroup) []models.SavedQueryFilterGroup {
+	groups := []models.SavedQueryFilterGroup{}
+
+	for _, g := range pbGroups {
+		group := models.SavedQueryFilterGroup{
+			Operand: g.Operand,
+			Filters: []models.SavedQueryFilter{},
+		}
+
+		for _, f := range g.Filters {
+			group.Filters = append(group.Filters, models.SavedQueryFilter{
+				Field:   f.Field,
+				Operand: f.Operand,
+				Values:  f.Values,
+				Min:     f.Min,
+				Max:     f.Max,
+			})
+		}
+
+		groups = append(groups, group)
+	}
+
+	return groups
+}
+
+func convertToPbSavedQueryFilterGroups(groups []models.SavedQueryFilterGroup) []*pb.FilterGroup {
+	pbGroups := []*pb.FilterGroup{}
+
+	for _, g := range groups {
+		group := &pb.FilterGroup{
+			Operand: g.Operand,
+			Filters: []*pb.QueryFilter{},
+		}
+
+		for _, f := range g.Filters {
+			group.Filters = append(group.Filters, &pb.QueryFilter{
+				Field:   f.Field,
+				Operand: f.Operand,
+				Values:  f.Values,
+				Min:     f.Min,
+				Max:     f.Max,
+			})
+		}
+
+		pbGroups = append(pbGroups, group)
+	}
+
+	return pbGroups
+}
@@ -2,8 +2,12 @@ package server
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"os"
+	"sort"
 	"time"
 
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/cleaner"
@@ -18,29 +22,46 @@ import (
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/query"
 
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/accesschecker"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/alerting"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/collector"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/collector/clusters"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/collector/sharding"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/rolecollector"
 
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/objectscollector"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/rbac"
 	store "github.com/weaveworks/weave-gitops-enterprise/pkg/query/store"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/store/metrics"
 	"github.com/weaveworks/weave-gitops/core/clustersmngr"
 	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	coordinationv1client "k8s.io/client-go/kubernetes/typed/coordination/v1"
 )
 
+// collectorShards tells which replica collects each cluster, like the sharding.Sharder.
+type collectorShards interface {
+	Identity() string
+	Owners() map[string]string
+}
+
 type server struct {
 	pb.UnimplementedQueryServer
 
 	qs   query.QueryService
 	arc  collector.Collector
 	objs collector.Collector
+	// collectors are the running collectors, nil when collection is done elsewhere.
+	collectors []collector.Collector
+	// shards tells the replica collecting each cluster, nil when collection is not sharded.
+	shards collectorShards
 
 	cancelCollection context.CancelFunc
 	cleaner          cleaner.ObjectCleaner
 	enabledFor       []string
 	clustersManager  clustersmngr.ClustersManager
+	objectKinds      []configuration.ObjectKind
 }
 
 func (s *server) StopCollection() error {
@@ -61,24 +82,75 @@ func (s *server) StopCollection() error {
 	return nil
 }
 
+const (
+	// shardedIndexChangesPeriod is how often the objects written to the shared store by the other replicas are
+	// indexed when collection is sharded.
+	shardedIndexChangesPeriod = 15 * time.Second
+	// shardedIndexRepairPeriod is how often the index is checked against the shared store, to be rebuilt
+	// if it has drifted, when collection is sharded.
+	shardedIndexRepairPeriod = time.Hour
+	// shardedRefreshPeriod is how often the inventory is refreshed from the shared store when collection is sharded.
+	shardedRefreshPeriod = 5 * time.Minute
+	// shardedRuleCacheMaxAge is how long the cached access rules are used when collection is sharded, which
+	// bounds how long access revoked in the clusters collected by other replicas is still granted.
+	shardedRuleCacheMaxAge = time.Minute
+)
+
+// alertingShardKey is sharded like a cluster, so a single replica evaluates the alert rules.
+const alertingShardKey = "explorer-alerting"
+
 type ServerOpts struct {
 	Logger logr.Logger
 	// required to watch clusters
 	ClustersManager clustersmngr.ClustersManager
 	SkipCollection  bool
-	StoreType       string
+	// StoreType is the storage backend for collected objects. Defaults to sqlite.
+	StoreType string
+	// StoreURI is the connection string for the store. Ignored by sqlite, which uses a temporary directory.
+	StoreURI string
 	// required to map GVRs to GVKs for authz purporses
 	DiscoveryClient     discovery.DiscoveryInterface
 	ObjectKinds         []configuration.ObjectKind
 	ServiceAccount      collector.ImpersonateServiceAccount
 	EnableObjectCleaner bool
 	EnabledFor          []string
+	// CuratedQueries are saved queries published to every user. They are read-only.
+	CuratedQueries []configuration.CuratedQuery
+	// SavedQueriesReadOnly only serves the curated queries: users cannot save queries. They are read-only
+	// with the sqlite store too, which does not outlive the process.
+	SavedQueriesReadOnly bool
+	// ManagementCluster is the name of the management cluster. Users that can impersonate others in it are admins:
+	// they can explain access and rebuild the index.
+	ManagementCluster string
+	// EnableSharding spreads the clusters over the replicas collecting them, which coordinate through
+	// leases in ShardingNamespace. The replicas must share a postgres store.
+	EnableSharding    bool
+	ShardingNamespace string
+	LeasesClient      coordinationv1client.LeasesGetter
 }
 
 func (s *server) DoQuery(ctx context.Context, msg *pb.DoQueryRequest) (*pb.DoQueryResponse, error) {
-	objs, err := s.qs.RunQuery(ctx, msg, msg)
+	q, err := newStructuredQuery(msg)
 	if err != nil {
-		return nil, fmt.Errorf("failed to run query: %w", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid query: %v", err)
+	}
+
+	var objs []models.Object
+	if msg.GetAsOf() != "" {
+		asOf, err := time.Parse(time.RFC3339, msg.GetAsOf())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid as of timestamp: %v", err)
+		}
+
+		objs, err = s.qs.RunQueryAsOf(ctx, q, msg, asOf)
+		if err != nil {
+			return nil, fmt.Errorf("failed to run query as of %s: %w", msg.GetAsOf(), err)
+		}
+	} else {
+		objs, err = s.qs.RunQuery(ctx, q, msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to run query: %w", err)
+		}
 	}
 
 	return &pb.DoQueryResponse{
@@ -86,6 +158,104 @@ func (s *server) DoQuery(ctx context.Context, msg *pb.DoQueryRequest) (*pb.DoQue
 	}, nil
 }
 
+// structuredQuery adds the filter groups of a request, in the store model, to its query.
+type structuredQuery struct {
+	store.Query
+	filterGroups []store.FilterGroup
+}
+
+func (q structuredQuery) GetFilterGroups() []store.FilterGroup {
+	return q.filterGroups
+}
+
+// filterGroupsRequest is a request whose query can be refined with filter groups.
+type filterGroupsRequest interface {
+	store.Query
+	GetFilterGroups() []*pb.FilterGroup
+}
+
+func newStructuredQuery(msg filterGroupsRequest) (store.Query, error) {
+	if len(msg.GetFilterGroups()) == 0 {
+		return msg, nil
+	}
+
+	groups := convertFromPbFilterGroups(msg.GetFilterGroups())
+	if err := store.ValidateFilterGroups(groups); err != nil {
+		return nil, err
+	}
+
+	return structuredQuery{Query: msg, filterGroups: groups}, nil
+}
+
+func (s *server) GetObjectHistory(ctx context.Context, msg *pb.GetObjectHistoryRequest) (*pb.GetObjectHistoryResponse, error) {
+	transitions, err := s.qs.GetObjectHistory(ctx, msg.GetId())
+	if err != nil {
+		if errors.Is(err, query.ErrObjectNotFound) {
+			return nil, status.Errorf(codes.NotFound, "object %s not found", msg.GetId())
+		}
+		return nil, fmt.Errorf("failed to get object history: %w", err)
+	}
+
+	return &pb.GetObjectHistoryResponse{
+		Transitions: convertToPbObjectTransition(transitions),
+	}, nil
+}
+
+func (s *server) GetObjectRelations(ctx context.Context, msg *pb.GetObjectRelationsRequest) (*pb.GetObjectRelationsResponse, error) {
+	if msg.GetDepth() < 0 || msg.GetDepth() > query.MaxRelationsDepth {
+		return nil, status.Errorf(codes.InvalidArgument, "depth must be between 1 and %d, or 0 for the default of 1", query.MaxRelationsDepth)
+	}
+
+	relations, err := s.qs.GetObjectRelations(ctx, msg.GetId(), int(msg.GetDepth()))
+	if err != nil {
+		if errors.Is(err, query.ErrObjectNotFound) {
+			return nil, status.Errorf(codes.NotFound, "object %s not found", msg.GetId())
+		}
+		return nil, fmt.Errorf("failed to get object relations: %w", err)
+	}
+
+	return convertToPbObjectRelations(relations), nil
+}
+
+func (s *server) CompareObjects(ctx context.Context, msg *pb.CompareObjectsRequest) (*pb.CompareObjectsResponse, error) {
+	if len(msg.GetIds()) == 0 && (msg.GetKind() == "" || msg.GetName() == "") {
+		return nil, status.Errorf(codes.InvalidArgument, "ids or kind and name are required")
+	}
+
+	comparison, err := s.qs.CompareObjects(ctx, query.CompareRequest{
+		Kind:            msg.GetKind(),
+		Namespace:       msg.GetNamespace(),
+		Name:            msg.GetName(),
+		IDs:             msg.GetIds(),
+		BaselineCluster: msg.GetBaselineCluster(),
+	})
+	if err != nil {
+		if errors.Is(err, query.ErrObjectNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no objects found to compare")
+		}
+		if errors.Is(err, query.ErrBaselineNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no object found in baseline cluster %s", msg.GetBaselineCluster())
+		}
+		return nil, fmt.Errorf("failed to compare objects: %w", err)
+	}
+
+	return convertToPbComparison(comparison)
+}
+
+func (s *server) DoAggregate(ctx context.Context, msg *pb.DoAggregateRequest) (*pb.DoAggregateResponse, error) {
+	q, err := newStructuredQuery(msg)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid query: %v", err)
+	}
+
+	buckets, err := s.qs.RunAggregate(ctx, q, msg.GetGroupBy())
+	if err != nil {
+		return nil, fmt.Errorf("failed to run aggregate: %w", err)
+	}
+
+	return convertToPbAggregate(buckets), nil
+}
+
 func (s *server) DebugGetAccessRules(ctx context.Context, msg *pb.DebugGetAccessRulesRequest) (*pb.DebugGetAccessRulesResponse, error) {
 	rules, err := s.qs.GetAccessRules(ctx)
 	if err != nil {
@@ -100,6 +270,115 @@ func (s *server) DebugGetAccessRules(ctx context.Context, msg *pb.DebugGetAccess
 	}, nil
 }
 
+func (s *server) ExplainAccess(ctx context.Context, msg *pb.ExplainAccessRequest) (*pb.ExplainAccessResponse, error) {
+	if msg.GetUser() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user is required")
+	}
+
+	ref := msg.GetReference()
+	if msg.GetId() == "" && (ref.GetCluster() == "" || ref.GetKind() == "" || ref.GetName() == "") {
+		return nil, status.Errorf(codes.InvalidArgument, "id or reference with cluster, kind and name is required")
+	}
+
+	principal := auth.NewUserPrincipal(auth.ID(msg.GetUser()), auth.Groups(msg.GetGroups()))
+	node := models.ObjectNode{
+		Cluster:   ref.GetCluster(),
+		Namespace: ref.GetNamespace(),
+		APIGroup:  ref.GetApiGroup(),
+		Kind:      ref.GetKind(),
+		Name:      ref.GetName(),
+	}
+
+	explanation, err := s.qs.ExplainAccess(ctx, principal, msg.GetId(), node)
+	if err != nil {
+		if errors.Is(err, query.ErrAdminRequired) {
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		}
+		if errors.Is(err, query.ErrObjectNotFound) {
+			return nil, status.Errorf(codes.NotFound, "object %s not found", msg.GetId())
+		}
+		return nil, fmt.Errorf("failed to explain access: %w", err)
+	}
+
+	return convertToPbAccessExplanation(explanation), nil
+}
+
+func (s *server) RebuildIndex(ctx context.Context, msg *pb.RebuildIndexRequest) (*pb.RebuildIndexResponse, error) {
+	count, err := s.qs.RebuildIndex(ctx)
+	if err != nil {
+		if errors.Is(err, query.ErrAdminRequired) {
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		}
+		if errors.Is(err, store.ErrReindexInProgress) {
+			return nil, status.Errorf(codes.Aborted, "%v", err)
+		}
+		return nil, fmt.Errorf("failed to rebuild index: %w", err)
+	}
+
+	return &pb.RebuildIndexResponse{Count: int32(count)}, nil
+}
+
+func (s *server) CheckIndex(ctx context.Context, msg *pb.CheckIndexRequest) (*pb.CheckIndexResponse, error) {
+	consistency, err := s.qs.CheckIndex(ctx)
+	if err != nil {
+		if errors.Is(err, query.ErrAdminRequired) {
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		}
+		return nil, fmt.Errorf("failed to check index: %w", err)
+	}
+
+	return &pb.CheckIndexResponse{
+		Consistent:       consistency.Consistent(),
+		StoreCount:       int32(consistency.StoreCount),
+		IndexCount:       int32(consistency.IndexCount),
+		MissingFromIndex: consistency.MissingFromIndex,
+		MissingFromStore: consistency.MissingFromStore,
+	}, nil
+}
+
+func (s *server) ListCollectorStatus(ctx context.Context, msg *pb.ListCollectorStatusRequest) (*pb.ListCollectorStatusResponse, error) {
+	// the statuses tell the clusters and their errors, whatever the objects the principal can see
+	if err := s.qs.RequireAdmin(ctx); err != nil {
+		if errors.Is(err, query.ErrAdminRequired) {
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		}
+		return nil, fmt.Errorf("failed to list collector status: %w", err)
+	}
+
+	statuses := []*pb.CollectorStatus{}
+	for _, c := range s.collectors {
+		clusterStatuses, err := c.ClusterStatuses(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get collector status: %w", err)
+		}
+		statuses = append(statuses, convertToPbCollectorStatus(clusterStatuses)...)
+	}
+
+	if s.shards != nil {
+		// the state of the clusters is only known by the replica collecting them, so the clusters
+		// of the other replicas are listed with the replica to ask
+		identity := s.shards.Identity()
+		for _, st := range statuses {
+			st.Replica = identity
+		}
+
+		owners := s.shards.Owners()
+		clusterNames := make([]string, 0, len(owners))
+		for clusterName := range owners {
+			clusterNames = append(clusterNames, clusterName)
+		}
+		sort.Strings(clusterNames)
+
+		for _, clusterName := range clusterNames {
+			if owner := owners[clusterName]; owner != identity {
+				statuses = append(statuses, &pb.CollectorStatus{Cluster: clusterName, Replica: owner})
+			}
+		}
+	}
+
+	return &pb.ListCollectorStatusResponse{Statuses: statuses}, nil
+}
+
 func (s *server) ListFacets(ctx context.Context, msg *pb.ListFacetsRequest) (*pb.ListFacetsResponse, error) {
 	facets, err := s.qs.ListFacets(ctx, configuration.ObjectCategory(msg.Category))
 	if err != nil {
@@ -108,7 +387,7 @@ func (s *server) ListFacets(ctx context.Context, msg *pb.ListFacetsRequest) (*pb
 
 	humanReadableLabelKeys := map[string]string{}
 
-	for _, objectKind := range configuration.SupportedObjectKinds {
+	for _, objectKind := range s.objectKinds {
 		for _, label := range objectKind.Labels {
 			if objectKind.HumanReadableLabelKeys != nil {
 				// Substitute human readable label keys for label with dot notation: labels.<label>
@@ -183,6 +462,20 @@ func (so *ServerOpts) Validate() error {
 	if so.ServiceAccount.Namespace == "" {
 		return fmt.Errorf("service account namespace cannot be empty")
 	}
+	if so.StoreType == string(store.StorageBackendPostgres) && so.StoreURI == "" {
+		return fmt.Errorf("store uri cannot be empty for postgres store")
+	}
+	if so.EnableSharding {
+		if so.StoreType != string(store.StorageBackendPostgres) {
+			return fmt.Errorf("sharding requires a postgres store shared by the replicas")
+		}
+		if so.ShardingNamespace == "" {
+			return fmt.Errorf("sharding namespace cannot be empty")
+		}
+		if so.LeasesClient == nil {
+			return fmt.Errorf("leases client cannot be nil for sharding")
+		}
+	}
 	return nil
 }
 
@@ -193,38 +486,75 @@ func NewServer(opts ServerOpts) (_ pb.QueryServer, _ func() error, retErr error)
 
 	debug := opts.Logger.WithName("query-server").V(logger.LogLevelDebug)
 
-	dbDir, err := os.MkdirTemp("", "db")
-	if err != nil {
-		return nil, nil, err
+	storeType := store.StorageBackend(opts.StoreType)
+	storeURI := opts.StoreURI
+
+	if storeType == "" || storeType == store.StorageBackendSQLite {
+		storeType = store.StorageBackendSQLite
+
+		dbDir, err := os.MkdirTemp("", "db")
+		if err != nil {
+			return nil, nil, err
+		}
+		storeURI = dbDir
 	}
 
-	s, err := store.NewStore(store.StorageBackendSQLite, dbDir, opts.Logger)
+	s, err := store.NewStore(storeType, storeURI, opts.Logger)
 	if err != nil {
 		return nil, nil, fmt.Errorf("cannot create store:%w", err)
 	}
 
+	// the rules resolved for queries are cached until the roles or role bindings of their cluster are written,
+	// or for a shorter time when other replicas write them too
+	ruleCacheMaxAge := rbac.DefaultRuleCacheMaxAge
+	if opts.EnableSharding {
+		ruleCacheMaxAge = shardedRuleCacheMaxAge
+	}
+	ruleCache := rbac.NewRuleCache(s, ruleCacheMaxAge)
+	s = store.NewInvalidatingStore(s, ruleCache)
+
+	// changes written through the store and the index are broadcast to watched queries
+	broadcaster := store.NewBroadcaster()
+	s = store.NewWatchableStore(s, broadcaster)
+
 	kindToResourceMap, err := createKindToResourceMap(opts.DiscoveryClient)
 	if err != nil {
 		return nil, nil, fmt.Errorf("cannot create resources map:%w", err)
 	}
 
-	authz := rbac.NewAuthorizer(kindToResourceMap)
+	authz := rbac.NewCachingAuthorizer(kindToResourceMap, ruleCache)
 
 	idxDir, err := os.MkdirTemp("", "index")
 	if err != nil {
 		return nil, nil, fmt.Errorf("cannot create index dir: %w", err)
 	}
 
-	idx, err := store.NewIndexer(s, idxDir, opts.Logger.WithName("indexer"))
+	idx, err := store.NewIndexerWithObjectKinds(s, idxDir, opts.ObjectKinds, opts.Logger.WithName("indexer"))
 	if err != nil {
 		return nil, nil, fmt.Errorf("cannot create indexer: %w", err)
 	}
+	idx = store.NewWatchableIndexer(idx, broadcaster)
+
+	// The store may have outlived the index, or the index may have been created again because it was corrupt.
+	if err := store.EnsureIndex(context.Background(), idx, opts.Logger.WithName("indexer")); err != nil {
+		return nil, nil, fmt.Errorf("cannot rebuild index: %w", err)
+	}
 
 	qs, err := query.NewQueryService(query.QueryServiceOpts{
 		Log:         opts.Logger,
 		StoreReader: s,
 		IndexReader: idx,
 		Authorizer:  authz,
+		Watcher:     broadcaster,
+		StoreWriter: s,
+
+		CuratedQueries: opts.CuratedQueries,
+		// the sqlite store is created again on every start, so the saved queries and alert rules would be lost
+		SavedQueriesReadOnly: opts.SavedQueriesReadOnly || storeType != store.StorageBackendPostgres,
+		AlertRulesReadOnly:   storeType != store.StorageBackendPostgres,
+		AccessExplainer:      authz,
+		ManagementCluster:    opts.ManagementCluster,
+		IndexMaintainer:      idx,
 	})
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create query service: %w", err)
@@ -235,6 +565,7 @@ func NewServer(opts ServerOpts) (_ pb.QueryServer, _ func() error, retErr error)
 		qs:              qs,
 		enabledFor:      opts.EnabledFor,
 		clustersManager: opts.ClustersManager,
+		objectKinds:     opts.ObjectKinds,
 	}
 
 	if !opts.SkipCollection {
@@ -249,17 +580,60 @@ func NewServer(opts ServerOpts) (_ pb.QueryServer, _ func() error, retErr error)
 			}
 		}()
 
-		rulesCollector, err := rolecollector.NewRoleCollector(s, clusters.MakeSubscriber(opts.ClustersManager), opts.ServiceAccount, opts.Logger)
+		var subscriber clusters.Subscriber = clusters.MakeSubscriber(opts.ClustersManager)
+		var alertingLeader func() bool
+		var inventoryOwner func(string) bool
+		if opts.EnableSharding {
+			sharder, err := sharding.NewSharder(sharding.SharderOpts{
+				Log:       opts.Logger,
+				Clusters:  subscriber,
+				Leases:    opts.LeasesClient,
+				Namespace: opts.ShardingNamespace,
+			})
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to create sharder: %w", err)
+			}
+			subscriber = sharder
+			serv.shards = sharder
+			alertingLeader = func() bool { return sharder.Owns(alertingShardKey) }
+			// each replica exports the inventory of the clusters it collects
+			inventoryOwner = sharder.Owns
+
+			go func() {
+				if err := sharder.Start(ctx); err != nil {
+					opts.Logger.Error(err, "sharder failed")
+				}
+			}()
+
+			// the index of each replica is kept up to date with the objects collected by the others
+			go store.KeepIndexed(ctx, idx, shardedIndexChangesPeriod, shardedIndexRepairPeriod, opts.Logger.WithName("indexer"))
+		}
+
+		// objects and tenants are counted as they are written, for the inventory metrics
+		inventory := metrics.NewInventory(inventoryOwner)
+		if err := store.LoadInventory(ctx, s, inventory); err != nil {
+			return nil, nil, fmt.Errorf("cannot load inventory: %w", err)
+		}
+		if err := metrics.RegisterInventory(inventory); err != nil {
+			return nil, nil, fmt.Errorf("cannot register inventory metrics: %w", err)
+		}
+		s = store.NewInventoryStore(s, inventory)
+		if opts.EnableSharding {
+			// the inventory is kept up to date with the clusters taken over from other replicas
+			go store.KeepInventory(ctx, s, inventory, shardedRefreshPeriod, opts.Logger.WithName("inventory"))
+		}
+
+		rulesCollector, err := rolecollector.NewRoleCollector(s, subscriber, opts.ServiceAccount, opts.Logger)
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to create access rules collector: %w", err)
 		}
 
-		objsCollector, err := objectscollector.NewObjectsCollector(s, idx, clusters.MakeSubscriber(opts.ClustersManager), opts.ServiceAccount, opts.ObjectKinds, opts.Logger)
+		objsCollector, err := objectscollector.NewObjectsCollector(s, idx, subscriber, opts.ServiceAccount, opts.ObjectKinds, opts.Logger)
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to create applications collector: %w", err)
 		}
 
-		tenantsCollector, err := tenantscollector.NewTenantsCollector(s, clusters.MakeSubscriber(opts.ClustersManager), opts.ServiceAccount, opts.Logger)
+		tenantsCollector, err := tenantscollector.NewTenantsCollector(s, subscriber, opts.ServiceAccount, opts.Logger)
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to create tenants collector: %w", err)
 		}
@@ -282,6 +656,21 @@ func NewServer(opts ServerOpts) (_ pb.QueryServer, _ func() error, retErr error)
 			}
 		}()
 
+		evaluator, err := alerting.NewEvaluator(alerting.EvaluatorOpts{
+			Log:      opts.Logger,
+			Store:    s,
+			Querier:  qs,
+			Notifier: alerting.NewWebhookNotifier(),
+			Watcher:  broadcaster,
+			Leader:   alertingLeader,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create alert evaluator: %w", err)
+		}
+
+		// alert rules are evaluated on each write to the store and on schedule
+		go evaluator.Start(ctx)
+
 		if opts.EnableObjectCleaner {
 			oc, err := cleaner.NewObjectCleaner(cleaner.CleanerOpts{
 				Store:    s,
@@ -304,6 +693,7 @@ func NewServer(opts ServerOpts) (_ pb.QueryServer, _ func() error, retErr error)
 
 		serv.arc = rulesCollector
 		serv.objs = objsCollector
+		serv.collectors = []collector.Collector{rulesCollector, objsCollector, tenantsCollector}
 		serv.cancelCollection = cancel
 
 		debug.Info("collectors started")
@@ -318,7 +708,27 @@ func Hydrate(ctx context.Context, mux *runtime.ServeMux, opts ServerOpts) (func(
 		return nil, err
 	}
 
-	return stop, pb.RegisterQueryHandlerServer(ctx, mux, s)
+	if err := pb.RegisterQueryHandlerServer(ctx, mux, s); err != nil {
+		return nil, err
+	}
+
+	// Registered after the generated handlers so it takes precedence over the in-process
+	// WatchQuery handler, which cannot stream.
+	if err := mux.HandlePath(http.MethodGet, watchQueryPath, newWatchQueryHandler(mux, s)); err != nil {
+		return nil, fmt.Errorf("failed to register watch query handler: %w", err)
+	}
+
+	exporter, ok := s.(queryExporter)
+	if !ok {
+		return nil, fmt.Errorf("query server does not export queries")
+	}
+	for _, method := range []string{http.MethodGet, http.MethodPost} {
+		if err := mux.HandlePath(method, exportQueryPath, newExportQueryHandler(mux, exporter)); err != nil {
+			return nil, fmt.Errorf("failed to register export query handler: %w", err)
+		}
+	}
+
+	return stop, nil
 }
 
 func convertToPbObject(obj []models.Object) []*pb.Object {
@@ -345,6 +755,188 @@ func convertToPbObject(obj []models.Object) []*pb.Object {
 	return pbObjects
 }
 
+func convertToPbObjectTransition(transitions []models.ObjectTransition) []*pb.ObjectTransition {
+	pbTransitions := []*pb.ObjectTransition{}
+
+	for _, t := range transitions {
+		pbTransitions = append(pbTransitions, &pb.ObjectTransition{
+			Status:    t.Status,
+			Message:   t.Message,
+			Deleted:   t.Deleted,
+			Timestamp: t.Timestamp.Format(time.RFC3339),
+		})
+	}
+
+	return pbTransitions
+}
+
+func convertToPbCollectorStatus(statuses []collector.ClusterStatus) []*pb.CollectorStatus {
+	pbStatuses := []*pb.CollectorStatus{}
+
+	for _, s := range statuses {
+		pbStatuses = append(pbStatuses, &pb.CollectorStatus{
+			Collector:    s.Collector,
+			Cluster:      s.ClusterName,
+			State:        s.Status,
+			LastSyncedAt: formatTime(s.LastSyncedAt),
+			Error:        s.Error,
+			ObjectCount:  int32(s.ObjectCount),
+			Retries:      int32(s.Retries),
+			NextRetryAt:  formatTime(s.NextRetryAt),
+		})
+	}
+
+	return pbStatuses
+}
+
+func convertToPbObjectReference(node models.ObjectNode) *pb.ObjectReference {
+	return &pb.ObjectReference{
+		Cluster:   node.Cluster,
+		Namespace: node.Namespace,
+		ApiGroup:  node.APIGroup,
+		Kind:      node.Kind,
+		Name:      node.Name,
+	}
+}
+
+func convertToPbObjectRelations(relations query.ObjectRelations) *pb.GetObjectRelationsResponse {
+	response := &pb.GetObjectRelationsResponse{
+		Objects:   []*pb.RelatedObject{},
+		Relations: []*pb.ObjectRelation{},
+	}
+
+	for _, o := range relations.Objects {
+		related := &pb.RelatedObject{
+			Reference: convertToPbObjectReference(o.ObjectNode),
+			Direction: string(o.Direction),
+			Depth:     int32(o.Depth),
+		}
+		if o.Object != nil {
+			related.Object = convertToPbObject([]models.Object{*o.Object})[0]
+		}
+		response.Objects = append(response.Objects, related)
+	}
+
+	for _, r := range relations.Relations {
+		response.Relations = append(response.Relations, &pb.ObjectRelation{
+			From: convertToPbObjectReference(r.From),
+			To:   convertToPbObjectReference(r.To),
+		})
+	}
+
+	return response
+}
+
+func convertToPbComparison(comparison query.Comparison) (*pb.CompareObjectsResponse, error) {
+	response := &pb.CompareObjectsResponse{
+		Baseline:    convertToPbObject([]models.Object{comparison.Baseline})[0],
+		Comparisons: []*pb.ObjectComparison{},
+	}
+
+	for _, c := range comparison.Objects {
+		spec, err := convertToPbFieldDifferences(c.Spec)
+		if err != nil {
+			return nil, err
+		}
+		statusDiffs, err := convertToPbFieldDifferences(c.Status)
+		if err != nil {
+			return nil, err
+		}
+		labels, err := convertToPbFieldDifferences(c.Labels)
+		if err != nil {
+			return nil, err
+		}
+
+		response.Comparisons = append(response.Comparisons, &pb.ObjectComparison{
+			Object: convertToPbObject([]models.Object{c.Object})[0],
+			Spec:   spec,
+			Status: statusDiffs,
+			Labels: labels,
+		})
+	}
+
+	return response, nil
+}
+
+func convertToPbFieldDifferences(diffs []query.FieldDifference) ([]*pb.FieldDifference, error) {
+	result := []*pb.FieldDifference{}
+
+	for _, d := range diffs {
+		baseline, err := encodeFieldValue(d.Baseline)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode field %s: %w", d.Path, err)
+		}
+		value, err := encodeFieldValue(d.Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode field %s: %w", d.Path, err)
+		}
+
+		result = append(result, &pb.FieldDifference{
+			Path:          d.Path,
+			Change:        string(d.Change),
+			BaselineValue: baseline,
+			Value:         value,
+		})
+	}
+
+	return result, nil
+}
+
+// encodeFieldValue encodes a field value as JSON, or as an empty string when the field is not set.
+func encodeFieldValue(v interface{}) (string, error) {
+	if v == nil {
+		return "", nil
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func convertFromPbFilterGroups(pbGroups []*pb.FilterGroup) []store.FilterGroup {
+	groups := []store.FilterGroup{}
+
+	for _, g := range pbGroups {
+		group := store.FilterGroup{
+			Operand: store.GlobalOperand(g.Operand),
+			Filters: []store.Filter{},
+		}
+
+		for _, f := range g.Filters {
+			group.Filters = append(group.Filters, store.Filter{
+				Field:   f.Field,
+				Operand: store.QueryOperand(f.Operand),
+				Values:  f.Values,
+				Min:     f.Min,
+				Max:     f.Max,
+			})
+		}
+
+		groups = append(groups, group)
+	}
+
+	return groups
+}
+
+func convertToPbAggregate(buckets []query.AggregateBucket) *pb.DoAggregateResponse {
+	resp := &pb.DoAggregateResponse{
+		Buckets: []*pb.AggregateBucket{},
+	}
+
+	for _, b := range buckets {
+		resp.Buckets = append(resp.Buckets, &pb.AggregateBucket{
+			Group: b.Group,
+			Count: b.Count,
+		})
+		resp.Total += b.Count
+	}
+
+	return resp
+}
+
 func convertToPbAccessRule(rules []models.AccessRule) []*pb.AccessRule {
 	pbRules := []*pb.AccessRule{}
 
@@ -373,6 +965,36 @@ func convertToPbAccessRule(rules []models.AccessRule) []*pb.AccessRule {
 	return pbRules
 }
 
+func convertToPbAccessExplanation(explanation models.AccessExplanation) *pb.ExplainAccessResponse {
+	convert := func(grants []models.AccessGrant) []*pb.AccessGrant {
+		pbGrants := []*pb.AccessGrant{}
+		for _, g := range grants {
+			pbGrants = append(pbGrants, &pb.AccessGrant{
+				Cluster:          g.RoleBinding.Cluster,
+				RoleKind:         g.Role.Kind,
+				RoleNamespace:    g.Role.Namespace,
+				RoleName:         g.Role.Name,
+				BindingKind:      g.RoleBinding.Kind,
+				BindingNamespace: g.RoleBinding.Namespace,
+				BindingName:      g.RoleBinding.Name,
+				ApiGroups:        models.SplitRuleData(g.Rule.APIGroups),
+				Resources:        models.SplitRuleData(g.Rule.Resources),
+				Verbs:            models.SplitRuleData(g.Rule.Verbs),
+				ResourceNames:    models.SplitRuleData(g.Rule.ResourceNames),
+				Mismatches:       g.Mismatches,
+			})
+		}
+		return pbGrants
+	}
+
+	return &pb.ExplainAccessResponse{
+		Allowed:    explanation.Allowed,
+		Grants:     convert(explanation.Grants),
+		NearMisses: convert(explanation.NearMisses),
+		Error:      explanation.Error,
+	}
+}
+
 func convertToPbFacet(facets store.Facets) []*pb.Facet {
 	pbFacets := []*pb.Facet{}
 
@@ -385,3 +1007,11 @@ func convertToPbFacet(facets store.Facets) []*pb.Facet {
 
 	return pbFacets
 }
+
+// formatTime formats a time as RFC3339, leaving zero times empty.
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
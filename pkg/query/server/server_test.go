@@ -3,16 +3,22 @@ package server
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/gomega"
 	"github.com/weaveworks/weave-gitops-enterprise/internal/grpctesting"
 	pb "github.com/weaveworks/weave-gitops-enterprise/pkg/api/query"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/collector"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/collector/collectorfakes"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/configuration"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
 	"github.com/weaveworks/weave-gitops/core/clustersmngr"
 	"github.com/weaveworks/weave-gitops/core/clustersmngr/clustersmngrfakes"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	fakediscovery "k8s.io/client-go/discovery/fake"
 	fakeclientset "k8s.io/client-go/kubernetes/fake"
 )
@@ -172,3 +178,188 @@ func TestListEnabledComponents_Invalid(t *testing.T) {
 
 	g.Expect(res.Components).NotTo(ContainElement(pb.EnabledComponent_applications))
 }
+
+func TestListCollectorStatus(t *testing.T) {
+	g := NewWithT(t)
+
+	syncedAt := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	objects := &collectorfakes.FakeCollector{}
+	objects.ClusterStatusesReturns([]collector.ClusterStatus{
+		{Collector: "objects", ClusterName: "management", Status: collector.ClusterWatchingStarted, LastSyncedAt: syncedAt, ObjectCount: 12},
+		{Collector: "objects", ClusterName: "leaf", Status: collector.ClusterWatchingBackoff, Error: "cluster unreachable", Retries: 3, NextRetryAt: syncedAt},
+	}, nil)
+	rbac := &collectorfakes.FakeCollector{}
+	rbac.ClusterStatusesReturns([]collector.ClusterStatus{
+		{Collector: "rbac", ClusterName: "management", Status: collector.ClusterWatchingStarting},
+	}, nil)
+
+	srv := &server{qs: adminQueryService{}, collectors: []collector.Collector{objects, rbac}}
+
+	res, err := srv.ListCollectorStatus(context.Background(), &pb.ListCollectorStatusRequest{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Statuses).To(HaveLen(3))
+
+	g.Expect(res.Statuses[0].Cluster).To(Equal("management"))
+	g.Expect(res.Statuses[0].LastSyncedAt).To(Equal("2023-10-01T12:00:00Z"))
+	g.Expect(res.Statuses[0].ObjectCount).To(BeEquivalentTo(12))
+	g.Expect(res.Statuses[0].NextRetryAt).To(BeEmpty())
+
+	g.Expect(res.Statuses[1].State).To(Equal(collector.ClusterWatchingBackoff))
+	g.Expect(res.Statuses[1].Error).To(Equal("cluster unreachable"))
+	g.Expect(res.Statuses[1].Retries).To(BeEquivalentTo(3))
+	g.Expect(res.Statuses[1].NextRetryAt).To(Equal("2023-10-01T12:00:00Z"))
+	g.Expect(res.Statuses[1].LastSyncedAt).To(BeEmpty())
+
+	g.Expect(res.Statuses[2].Collector).To(Equal("rbac"))
+
+	t.Run("lists the clusters of the other replicas when sharded", func(t *testing.T) {
+		shards := fakeShards{identity: "replica-a", owners: map[string]string{
+			"management": "replica-a",
+			"leaf":       "replica-a",
+			"staging":    "replica-b",
+			"prod":       "replica-c",
+		}}
+		srv := &server{qs: adminQueryService{}, collectors: []collector.Collector{objects, rbac}, shards: shards}
+
+		res, err := srv.ListCollectorStatus(context.Background(), &pb.ListCollectorStatusRequest{})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(res.Statuses).To(HaveLen(5))
+
+		for _, st := range res.Statuses[:3] {
+			g.Expect(st.Replica).To(Equal("replica-a"))
+			g.Expect(st.State).NotTo(BeEmpty())
+		}
+		g.Expect(res.Statuses[3].Cluster).To(Equal("prod"))
+		g.Expect(res.Statuses[3].Replica).To(Equal("replica-c"))
+		g.Expect(res.Statuses[3].State).To(BeEmpty())
+		g.Expect(res.Statuses[4].Cluster).To(Equal("staging"))
+		g.Expect(res.Statuses[4].Replica).To(Equal("replica-b"))
+	})
+
+	t.Run("only admins list the statuses", func(t *testing.T) {
+		srv := &server{qs: adminQueryService{err: query.ErrAdminRequired}, collectors: []collector.Collector{objects, rbac}}
+
+		_, err := srv.ListCollectorStatus(context.Background(), &pb.ListCollectorStatusRequest{})
+		g.Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+	})
+}
+
+type fakeShards struct {
+	identity string
+	owners   map[string]string
+}
+
+func (f fakeShards) Identity() string {
+	return f.identity
+}
+
+func (f fakeShards) Owners() map[string]string {
+	return f.owners
+}
+
+// adminQueryService tells whether the principal is an admin, for the operations that are served apart
+// from the query service.
+type adminQueryService struct {
+	query.QueryService
+	err error
+}
+
+func (q adminQueryService) RequireAdmin(ctx context.Context) error {
+	return q.err
+}
+
+func TestCreateAlertRule_InvalidFor(t *testing.T) {
+	g := NewWithT(t)
+
+	srv := &server{}
+
+	_, err := srv.CreateAlertRule(context.Background(), &pb.CreateAlertRuleRequest{
+		Name:          "Failing releases",
+		Filters:       []string{"status:Failed"},
+		For:           "ten minutes",
+		WebhookUrl:    "http://alertmanager:9093/api/v2/alerts",
+		WebhookFormat: "alertmanager",
+	})
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+}
+
+func TestNewStructuredQuery(t *testing.T) {
+	g := NewWithT(t)
+
+	groups := []*pb.FilterGroup{
+		{Filters: []*pb.QueryFilter{{Field: "kind", Operand: "equal", Values: []string{"HelmRelease"}}}},
+	}
+
+	for _, msg := range []filterGroupsRequest{
+		&pb.DoQueryRequest{FilterGroups: groups},
+		&pb.DoAggregateRequest{FilterGroups: groups},
+		&pb.WatchQueryRequest{FilterGroups: groups},
+	} {
+		q, err := newStructuredQuery(msg)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(q).To(BeAssignableToTypeOf(structuredQuery{}))
+		g.Expect(q.(structuredQuery).GetFilterGroups()).To(HaveLen(1))
+	}
+
+	_, err := newStructuredQuery(&pb.DoAggregateRequest{
+		FilterGroups: []*pb.FilterGroup{{Filters: []*pb.QueryFilter{{Field: "kind", Operand: "near"}}}},
+	})
+	g.Expect(err).To(HaveOccurred())
+
+	_, err = (&server{}).DoAggregate(context.Background(), &pb.DoAggregateRequest{
+		FilterGroups: []*pb.FilterGroup{{Filters: []*pb.QueryFilter{{Operand: "equal"}}}},
+	})
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+}
+
+func TestConvertToPbAlertRule(t *testing.T) {
+	g := NewWithT(t)
+
+	since := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	rule := convertToPbAlertRule(models.AlertRule{
+		ID:            "failing-releases",
+		Name:          "Failing releases",
+		For:           10 * time.Minute,
+		WebhookFormat: models.AlertWebhookJSON,
+		State: models.AlertRuleState{
+			State:       models.AlertFiring,
+			Matching:    map[string]time.Time{"prod/default/podinfo/HelmRelease": since},
+			Firing:      []models.AlertObject{{ID: "prod/default/podinfo/HelmRelease", Name: "podinfo", Since: since}},
+			FiringSince: since.Add(10 * time.Minute),
+		},
+	})
+
+	g.Expect(rule.For).To(Equal("10m0s"))
+	g.Expect(rule.State.State).To(Equal("firing"))
+	g.Expect(rule.State.MatchingCount).To(BeEquivalentTo(1))
+	g.Expect(rule.State.Firing[0].Since).To(Equal("2023-10-01T12:00:00Z"))
+	g.Expect(rule.State.FiringSince).To(Equal("2023-10-01T12:10:00Z"))
+	g.Expect(rule.State.ResolvedAt).To(BeEmpty())
+
+	g.Expect(convertToPbAlertRule(models.AlertRule{}).State.State).To(Equal("inactive"))
+}
+
+func TestConvertToPbComparison(t *testing.T) {
+	g := NewWithT(t)
+
+	response, err := convertToPbComparison(query.Comparison{
+		Baseline: models.Object{Cluster: "prod", Kind: "Kustomization", Name: "apps"},
+		Objects: []query.ObjectComparison{{
+			Object: models.Object{Cluster: "staging", Kind: "Kustomization", Name: "apps"},
+			Spec: []query.FieldDifference{
+				{Path: "interval", Change: query.DiffChanged, Baseline: "10m", Value: "1h"},
+				{Path: "patches", Change: query.DiffAdded, Value: []interface{}{map[string]interface{}{"patch": "..."}}},
+			},
+		}},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(response.Baseline.Cluster).To(Equal("prod"))
+	g.Expect(response.Comparisons).To(HaveLen(1))
+	g.Expect(response.Comparisons[0].Spec[0].BaselineValue).To(Equal(`"10m"`))
+	g.Expect(response.Comparisons[0].Spec[0].Value).To(Equal(`"1h"`))
+	// fields that are not set have no value
+	g.Expect(response.Comparisons[0].Spec[1].BaselineValue).To(BeEmpty())
+	g.Expect(response.Comparisons[0].Spec[1].Value).To(Equal(`[{"patch":"..."}]`))
+	g.Expect(response.Comparisons[0].Labels).To(BeEmpty())
+}
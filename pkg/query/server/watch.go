@@ -0,0 +1,175 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	pb "github.com/weaveworks/weave-gitops-enterprise/pkg/api/query"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// watchQueryPath is the HTTP route of WatchQuery, as annotated in the proto definition.
+const watchQueryPath = "/v1/query/watch"
+
+func (s *server) WatchQuery(msg *pb.WatchQueryRequest, stream pb.Query_WatchQueryServer) error {
+	q, err := newStructuredQuery(msg)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid query: %v", err)
+	}
+
+	err = s.qs.WatchQuery(stream.Context(), q, msg, func(e query.WatchEvent) error {
+		return stream.Send(&pb.WatchQueryResponse{
+			Type:    pb.WatchEventType(pb.WatchEventType_value[string(e.Type)]),
+			Objects: convertToPbObject(e.Objects),
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("failed to watch query: %w", err)
+	}
+
+	return nil
+}
+
+// newWatchQueryHandler serves WatchQuery over HTTP. The in-process gateway does not support
+// streaming, so the stream is written here: as server-sent events when the client accepts them,
+// like an EventSource does, or as newline delimited JSON like the gateway does otherwise.
+func newWatchQueryHandler(mux *runtime.ServeMux, srv pb.QueryServer) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+
+		params := r.URL.Query()
+		msg := &pb.WatchQueryRequest{}
+		filter := utilities.NewDoubleArray([][]string{{"filter_groups"}, {"filterGroups"}})
+		if err := runtime.PopulateQueryParameters(msg, params, filter); err != nil {
+			runtime.HTTPError(r.Context(), mux, outbound, w, r, status.Errorf(codes.InvalidArgument, "invalid watch query: %v", err))
+			return
+		}
+
+		// Filter groups are messages, which cannot be query parameters, so they are passed as JSON.
+		for _, key := range []string{"filter_groups", "filterGroups"} {
+			if value := params.Get(key); value != "" {
+				groups := &pb.WatchQueryRequest{}
+				if err := protojson.Unmarshal([]byte(fmt.Sprintf(`{"filterGroups":%s}`, value)), groups); err != nil {
+					runtime.HTTPError(r.Context(), mux, outbound, w, r, status.Errorf(codes.InvalidArgument, "invalid watch query filter groups: %v", err))
+					return
+				}
+				msg.FilterGroups = groups.GetFilterGroups()
+			}
+		}
+
+		stream := &httpWatchQueryServer{
+			ctx:        r.Context(),
+			w:          w,
+			controller: http.NewResponseController(w),
+			marshaler:  outbound,
+			sse:        strings.Contains(r.Header.Get("Accept"), "text/event-stream"),
+		}
+
+		if err := stream.writeHeader(); err != nil {
+			// the status is already written, so the error can only be reported in the stream
+			stream.writeError(status.Errorf(codes.Unimplemented, "streaming is not supported: %v", err))
+			return
+		}
+
+		if err := srv.WatchQuery(msg, stream); err != nil {
+			stream.writeError(err)
+		}
+	}
+}
+
+// httpWatchQueryServer implements the server side of the WatchQuery stream on top of an HTTP response.
+type httpWatchQueryServer struct {
+	ctx        context.Context
+	w          http.ResponseWriter
+	controller *http.ResponseController
+	marshaler  runtime.Marshaler
+	sse        bool
+	mu         sync.Mutex
+}
+
+func (s *httpWatchQueryServer) writeHeader() error {
+	if s.sse {
+		s.w.Header().Set("Content-Type", "text/event-stream")
+	} else {
+		s.w.Header().Set("Content-Type", s.marshaler.ContentType(&pb.WatchQueryResponse{}))
+	}
+	s.w.Header().Set("Cache-Control", "no-cache")
+	s.w.Header().Set("X-Accel-Buffering", "no")
+
+	s.w.WriteHeader(http.StatusOK)
+
+	return s.controller.Flush()
+}
+
+func (s *httpWatchQueryServer) Send(resp *pb.WatchQueryResponse) error {
+	if s.sse {
+		return s.write(resp.GetType().String(), resp)
+	}
+	return s.write("", map[string]proto.Message{"result": resp})
+}
+
+func (s *httpWatchQueryServer) writeError(err error) {
+	st := status.Convert(err).Proto()
+	if s.sse {
+		_ = s.write("error", st)
+		return
+	}
+	_ = s.write("", map[string]proto.Message{"error": st})
+}
+
+func (s *httpWatchQueryServer) write(event string, v interface{}) error {
+	data, err := s.marshaler.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.sse {
+		_, err = fmt.Fprintf(s.w, "event: %s\ndata: %s\n\n", event, data)
+	} else {
+		_, err = fmt.Fprintf(s.w, "%s\n", data)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write response: %w", err)
+	}
+
+	return s.controller.Flush()
+}
+
+func (s *httpWatchQueryServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *httpWatchQueryServer) SetHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *httpWatchQueryServer) SendHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *httpWatchQueryServer) SetTrailer(metadata.MD) {}
+
+func (s *httpWatchQueryServer) SendMsg(m interface{}) error {
+	resp, ok := m.(*pb.WatchQueryResponse)
+	if !ok {
+		return fmt.Errorf("unexpected message %T", m)
+	}
+	return s.Send(resp)
+}
+
+func (s *httpWatchQueryServer) RecvMsg(interface{}) error {
+	return fmt.Errorf("watch query does not receive messages")
+}
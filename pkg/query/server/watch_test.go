@@ -0,0 +1,148 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	. "github.com/onsi/gomega"
+	pb "github.com/weaveworks/weave-gitops-enterprise/pkg/api/query"
+)
+
+type watchQueryServer struct {
+	pb.UnimplementedQueryServer
+	received *pb.WatchQueryRequest
+	err      error
+}
+
+func (s *watchQueryServer) WatchQuery(msg *pb.WatchQueryRequest, stream pb.Query_WatchQueryServer) error {
+	s.received = msg
+
+	if err := stream.Send(&pb.WatchQueryResponse{
+		Type:    pb.WatchEventType_snapshot,
+		Objects: []*pb.Object{{Name: "podinfo"}},
+	}); err != nil {
+		return err
+	}
+
+	if err := stream.Send(&pb.WatchQueryResponse{
+		Type:    pb.WatchEventType_deleted,
+		Objects: []*pb.Object{{Name: "podinfo"}},
+	}); err != nil {
+		return err
+	}
+
+	return s.err
+}
+
+func TestWatchQueryHandler(t *testing.T) {
+	g := NewWithT(t)
+
+	tests := []struct {
+		name     string
+		accept   string
+		err      error
+		expected []string
+	}{
+		{
+			name:   "writes server-sent events",
+			accept: "text/event-stream",
+			expected: []string{
+				`event: snapshot`,
+				`data: {"objects":[{"name":"podinfo"}]}`,
+				``,
+				`event: deleted`,
+				`data: {"type":"deleted","objects":[{"name":"podinfo"}]}`,
+				``,
+			},
+		},
+		{
+			name:   "writes server-sent error events",
+			accept: "text/event-stream",
+			err:    fmt.Errorf("boom"),
+			expected: []string{
+				`event: snapshot`,
+				`data: {"objects":[{"name":"podinfo"}]}`,
+				``,
+				`event: deleted`,
+				`data: {"type":"deleted","objects":[{"name":"podinfo"}]}`,
+				``,
+				`event: error`,
+				`data: {"code":2,"message":"boom"}`,
+				``,
+			},
+		},
+		{
+			name: "writes newline delimited json",
+			expected: []string{
+				`{"result":{"objects":[{"name":"podinfo"}]}}`,
+				`{"result":{"type":"deleted","objects":[{"name":"podinfo"}]}}`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux := runtime.NewServeMux(runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{}))
+			srv := &watchQueryServer{err: tt.err}
+			g.Expect(mux.HandlePath(http.MethodGet, watchQueryPath, newWatchQueryHandler(mux, srv))).To(Succeed())
+
+			req := httptest.NewRequest(http.MethodGet, watchQueryPath+"?terms=podinfo&filters=kind:HelmRelease&filters=cluster:management&orderBy=name&descending=true", nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			w := httptest.NewRecorder()
+
+			mux.ServeHTTP(w, req)
+
+			g.Expect(w.Code).To(Equal(http.StatusOK))
+
+			// protojson randomly adds spaces to its output to keep it from being relied upon
+			lines := strings.Split(strings.TrimSuffix(w.Body.String(), "\n"), "\n")
+			g.Expect(lines).To(HaveLen(len(tt.expected)))
+			for i, line := range lines {
+				g.Expect(strings.ReplaceAll(line, " ", "")).To(Equal(strings.ReplaceAll(tt.expected[i], " ", "")))
+			}
+
+			g.Expect(srv.received.GetTerms()).To(Equal("podinfo"))
+			g.Expect(srv.received.GetFilters()).To(Equal([]string{"kind:HelmRelease", "cluster:management"}))
+			g.Expect(srv.received.GetOrderBy()).To(Equal("name"))
+			g.Expect(srv.received.GetDescending()).To(BeTrue())
+		})
+	}
+
+	t.Run("reads filter groups as json", func(t *testing.T) {
+		mux := runtime.NewServeMux(runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{}))
+		srv := &watchQueryServer{}
+		g.Expect(mux.HandlePath(http.MethodGet, watchQueryPath, newWatchQueryHandler(mux, srv))).To(Succeed())
+
+		groups := url.QueryEscape(`[{"operand":"or","filters":[{"field":"kind","operand":"equal","values":["HelmRelease"]}]}]`)
+		req := httptest.NewRequest(http.MethodGet, watchQueryPath+"?filterGroups="+groups, nil)
+		w := httptest.NewRecorder()
+
+		mux.ServeHTTP(w, req)
+
+		g.Expect(w.Code).To(Equal(http.StatusOK))
+		g.Expect(srv.received.GetFilterGroups()).To(HaveLen(1))
+		g.Expect(srv.received.GetFilterGroups()[0].GetOperand()).To(Equal("or"))
+		g.Expect(srv.received.GetFilterGroups()[0].GetFilters()[0].GetValues()).To(Equal([]string{"HelmRelease"}))
+	})
+
+	t.Run("rejects invalid filter groups", func(t *testing.T) {
+		mux := runtime.NewServeMux(runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{}))
+		srv := &watchQueryServer{}
+		g.Expect(mux.HandlePath(http.MethodGet, watchQueryPath, newWatchQueryHandler(mux, srv))).To(Succeed())
+
+		req := httptest.NewRequest(http.MethodGet, watchQueryPath+"?filterGroups=nope", nil)
+		w := httptest.NewRecorder()
+
+		mux.ServeHTTP(w, req)
+
+		g.Expect(w.Code).To(Equal(http.StatusBadRequest))
+		g.Expect(srv.received).To(BeNil())
+	})
+}
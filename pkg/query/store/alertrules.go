@@ -0,0 +1,126 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ErrAlertRuleNotFound is returned when getting an alert rule that is not stored.
+var ErrAlertRuleNotFound = errors.New("alert rule not found")
+
+func (i *SQLiteStore) StoreAlertRule(ctx context.Context, rule models.AlertRule) error {
+	if err := rule.Validate(); err != nil {
+		return fmt.Errorf("invalid alert rule: %w", err)
+	}
+
+	clauses := i.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{
+			{Name: "id"},
+		},
+		// the creation time is kept when the rule is updated
+		DoUpdates: clause.AssignmentColumns([]string{"name", "terms", "filters", "for_duration", "webhook_url", "webhook_format", "owner", "owner_groups", "updated_at"}),
+	})
+
+	if result := clauses.Create(&rule); result.Error != nil {
+		return fmt.Errorf("failed to store alert rule: %w", result.Error)
+	}
+	i.debug.Info("alert rule stored", "alertRule", rule.ID)
+
+	return nil
+}
+
+func (i *SQLiteStore) DeleteAlertRule(ctx context.Context, id string) error {
+	err := i.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if result := tx.Where("rule_id = ?", id).Delete(&models.AlertRuleState{}); result.Error != nil {
+			return fmt.Errorf("failed to delete alert rule state: %w", result.Error)
+		}
+		if result := tx.Where("id = ?", id).Delete(&models.AlertRule{}); result.Error != nil {
+			return fmt.Errorf("failed to delete alert rule: %w", result.Error)
+		}
+		return nil
+	})
+
+	return err
+}
+
+func (i *SQLiteStore) StoreAlertRuleState(ctx context.Context, state models.AlertRuleState) error {
+	clauses := i.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{
+			{Name: "rule_id"},
+		},
+		UpdateAll: true,
+	})
+
+	if result := clauses.Create(&state); result.Error != nil {
+		return fmt.Errorf("failed to store alert rule state: %w", result.Error)
+	}
+
+	return nil
+}
+
+func (i *SQLiteStore) GetAlertRule(ctx context.Context, id string) (models.AlertRule, error) {
+	rule := models.AlertRule{}
+
+	result := i.db.WithContext(ctx).Where("id = ?", id).First(&rule)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return models.AlertRule{}, fmt.Errorf("%w: %s", ErrAlertRuleNotFound, id)
+	}
+	if result.Error != nil {
+		return models.AlertRule{}, fmt.Errorf("failed to get alert rule: %s with error: %w", id, result.Error)
+	}
+
+	states, err := i.getAlertRuleStates(ctx, []string{id})
+	if err != nil {
+		return models.AlertRule{}, err
+	}
+	rule.State = states[id]
+
+	return rule, nil
+}
+
+func (i *SQLiteStore) GetAlertRules(ctx context.Context) ([]models.AlertRule, error) {
+	rules := []models.AlertRule{}
+
+	if result := i.db.WithContext(ctx).Order("name").Find(&rules); result.Error != nil {
+		return nil, fmt.Errorf("failed to get alert rules: %w", result.Error)
+	}
+
+	ids := []string{}
+	for _, r := range rules {
+		ids = append(ids, r.ID)
+	}
+
+	states, err := i.getAlertRuleStates(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for n := range rules {
+		rules[n].State = states[rules[n].ID]
+	}
+
+	return rules, nil
+}
+
+// getAlertRuleStates returns the states of the given rules by rule id. Rules that were
+// never evaluated have no state.
+func (i *SQLiteStore) getAlertRuleStates(ctx context.Context, ids []string) (map[string]models.AlertRuleState, error) {
+	states := []models.AlertRuleState{}
+
+	if len(ids) > 0 {
+		if result := i.db.WithContext(ctx).Where("rule_id IN ?", ids).Find(&states); result.Error != nil {
+			return nil, fmt.Errorf("failed to get alert rule states: %w", result.Error)
+		}
+	}
+
+	byRule := map[string]models.AlertRuleState{}
+	for _, s := range states {
+		byRule[s.RuleID] = s
+	}
+
+	return byRule, nil
+}
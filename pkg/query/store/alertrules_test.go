@@ -0,0 +1,97 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+)
+
+func TestSQLiteStore_AlertRules(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+	store, db := createStore(t)
+
+	created := time.Date(2023, 9, 1, 10, 0, 0, 0, time.UTC)
+	rule := models.AlertRule{
+		ID:            "0f1e2d3c-4b5a-4968-8776-a5b4c3d2e1f0",
+		Name:          "Failing releases in prod",
+		Filters:       []string{"kind:HelmRelease", "status:Failed"},
+		For:           10 * time.Minute,
+		WebhookURL:    "http://alertmanager:9093/api/v2/alerts",
+		WebhookFormat: models.AlertWebhookAlertmanager,
+		Owner:         "alice",
+		OwnerGroups:   []string{"admins"},
+		CreatedAt:     created,
+		UpdatedAt:     created,
+	}
+
+	t.Run("stores an alert rule", func(t *testing.T) {
+		g.Expect(store.StoreAlertRule(ctx, rule)).To(Succeed())
+
+		stored, err := store.GetAlertRule(ctx, rule.ID)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(stored.Name).To(Equal("Failing releases in prod"))
+		g.Expect(stored.Filters).To(Equal([]string{"kind:HelmRelease", "status:Failed"}))
+		g.Expect(stored.For).To(Equal(10 * time.Minute))
+		g.Expect(stored.OwnerGroups).To(Equal([]string{"admins"}))
+		g.Expect(stored.State.State).To(BeEmpty())
+	})
+
+	t.Run("updates an alert rule and keeps its creation time", func(t *testing.T) {
+		updated := rule
+		updated.For = 5 * time.Minute
+		updated.WebhookFormat = models.AlertWebhookJSON
+		updated.CreatedAt = created.Add(time.Hour)
+		updated.UpdatedAt = created.Add(time.Hour)
+		g.Expect(store.StoreAlertRule(ctx, updated)).To(Succeed())
+
+		rules, err := store.GetAlertRules(ctx)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(rules).To(HaveLen(1))
+		g.Expect(rules[0].For).To(Equal(5 * time.Minute))
+		g.Expect(rules[0].WebhookFormat).To(Equal(models.AlertWebhookJSON))
+		g.Expect(rules[0].CreatedAt).To(BeTemporally("==", created))
+	})
+
+	t.Run("rejects invalid alert rules", func(t *testing.T) {
+		invalid := rule
+		invalid.WebhookURL = "file:///etc/passwd"
+		g.Expect(store.StoreAlertRule(ctx, invalid)).To(MatchError(ContainSubstring("invalid webhook url")))
+	})
+
+	t.Run("stores the state of an alert rule", func(t *testing.T) {
+		since := created.Add(time.Minute)
+		state := models.AlertRuleState{
+			RuleID:   rule.ID,
+			State:    models.AlertPending,
+			Matching: map[string]time.Time{"management/default/podinfo/HelmRelease": since},
+		}
+		g.Expect(store.StoreAlertRuleState(ctx, state)).To(Succeed())
+
+		state.State = models.AlertFiring
+		state.FiringSince = since.Add(10 * time.Minute)
+		state.Firing = []models.AlertObject{{ID: "management/default/podinfo/HelmRelease", Name: "podinfo", Since: since}}
+		g.Expect(store.StoreAlertRuleState(ctx, state)).To(Succeed())
+
+		stored, err := store.GetAlertRule(ctx, rule.ID)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(stored.State.State).To(Equal(models.AlertFiring))
+		g.Expect(stored.State.Matching).To(HaveKey("management/default/podinfo/HelmRelease"))
+		g.Expect(stored.State.Firing).To(HaveLen(1))
+		g.Expect(stored.State.FiringSince).To(BeTemporally("==", since.Add(10*time.Minute)))
+	})
+
+	t.Run("deletes an alert rule and its state", func(t *testing.T) {
+		g.Expect(store.DeleteAlertRule(ctx, rule.ID)).To(Succeed())
+
+		_, err := store.GetAlertRule(ctx, rule.ID)
+		g.Expect(err).To(MatchError(ErrAlertRuleNotFound))
+
+		var states int64
+		g.Expect(db.Model(&models.AlertRuleState{}).Count(&states).Error).To(Succeed())
+		g.Expect(states).To(BeZero())
+	})
+}
@@ -0,0 +1,155 @@
+package store
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+)
+
+// defaultAsOfIndexes is how many indexes of past objects are kept by default.
+const defaultAsOfIndexes = 4
+
+// AsOfIndexes caches the in-memory indexes of the objects as they were at a time. An index is keyed by
+// the transitions it was built from, so the pages of an as-of query, and the queries of any time at which
+// the objects were the same, reuse it rather than indexing the transitions again.
+type AsOfIndexes struct {
+	r    StoreReader
+	log  logr.Logger
+	size int
+
+	mu sync.Mutex
+	// indexes are ordered from the least to the most recently used
+	indexes []*asOfIndex
+}
+
+type asOfIndex struct {
+	key     uint64
+	index   IndexReader
+	close   func() error
+	users   int
+	evicted bool
+}
+
+// NewAsOfIndexes keeps up to size indexes, or a default number of them when size is not positive.
+func NewAsOfIndexes(r StoreReader, size int, log logr.Logger) *AsOfIndexes {
+	if size <= 0 {
+		size = defaultAsOfIndexes
+	}
+
+	return &AsOfIndexes{
+		r:    r,
+		log:  log.WithName("as-of-indexes"),
+		size: size,
+	}
+}
+
+// Get returns the index of the objects as they were at the given time. The returned function must be
+// called once the index is no longer used, so that it is closed when evicted.
+func (c *AsOfIndexes) Get(ctx context.Context, asOf time.Time) (IndexReader, func(), error) {
+	transitions, err := c.r.GetObjectTransitionsAsOf(ctx, asOf)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get object transitions: %w", err)
+	}
+
+	key := transitionsKey(transitions)
+
+	if cached := c.acquire(key); cached != nil {
+		return cached.index, c.releaseFunc(cached), nil
+	}
+
+	index, closeIndex, err := newIndexerFromTransitions(c.r, transitions, c.log)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	entry := c.add(&asOfIndex{key: key, index: index, close: closeIndex, users: 1})
+	return entry.index, c.releaseFunc(entry), nil
+}
+
+func (c *AsOfIndexes) acquire(key uint64) *asOfIndex {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, entry := range c.indexes {
+		if entry.key == key {
+			c.indexes = append(append(c.indexes[:i:i], c.indexes[i+1:]...), entry)
+			entry.users++
+			return entry
+		}
+	}
+
+	return nil
+}
+
+// add caches a new index, unless the same one was cached in the meantime, and evicts the least
+// recently used indexes above the size.
+func (c *AsOfIndexes) add(entry *asOfIndex) *asOfIndex {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, cached := range c.indexes {
+		if cached.key == entry.key {
+			c.closeIndex(entry)
+			cached.users++
+			return cached
+		}
+	}
+
+	c.indexes = append(c.indexes, entry)
+	for len(c.indexes) > c.size {
+		evicted := c.indexes[0]
+		c.indexes = c.indexes[1:]
+		evicted.evicted = true
+		if evicted.users == 0 {
+			c.closeIndex(evicted)
+		}
+	}
+
+	return entry
+}
+
+func (c *AsOfIndexes) releaseFunc(entry *asOfIndex) func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+
+			entry.users--
+			if entry.evicted && entry.users == 0 {
+				c.closeIndex(entry)
+			}
+		})
+	}
+}
+
+func (c *AsOfIndexes) closeIndex(entry *asOfIndex) {
+	if err := entry.close(); err != nil {
+		c.log.Error(err, "failed to close index")
+	}
+}
+
+// transitionsKey identifies a set of transitions by their ids, that are never reused.
+func transitionsKey(transitions []models.ObjectTransition) uint64 {
+	ids := make([]uint, 0, len(transitions))
+	for _, t := range transitions {
+		ids = append(ids, t.ID)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	h := fnv.New64a()
+	buf := make([]byte, 8)
+	for _, id := range ids {
+		binary.LittleEndian.PutUint64(buf, uint64(id))
+		h.Write(buf)
+	}
+
+	return h.Sum64()
+}
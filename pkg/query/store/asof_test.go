@@ -0,0 +1,75 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/configuration"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+)
+
+func TestAsOfIndexes(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+	store, _ := createStore(t)
+
+	newObject := func(name, status string) models.Object {
+		return models.Object{
+			Cluster:    "test-cluster",
+			Name:       name,
+			Namespace:  "namespace",
+			Kind:       "Kustomization",
+			APIGroup:   "kustomize.toolkit.fluxcd.io",
+			APIVersion: "v1",
+			Status:     status,
+			Category:   configuration.CategoryAutomation,
+		}
+	}
+
+	indexes := NewAsOfIndexes(store, 1, logr.Discard())
+
+	search := func(index IndexReader) error {
+		it, err := index.Search(ctx, &query{}, nil)
+		if err != nil {
+			return err
+		}
+		return it.Close()
+	}
+
+	g.Expect(store.StoreObjects(ctx, []models.Object{newObject("podinfo", "Success")})).To(Succeed())
+	beforeFailure := time.Now()
+
+	first, releaseFirst, err := indexes.Get(ctx, beforeFailure)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	t.Run("reuses the index of the same objects", func(t *testing.T) {
+		// nothing changed since, so the objects are the same
+		same, release, err := indexes.Get(ctx, time.Now())
+		g.Expect(err).NotTo(HaveOccurred())
+		defer release()
+
+		g.Expect(same).To(BeIdenticalTo(first))
+	})
+
+	t.Run("indexes other objects again", func(t *testing.T) {
+		g.Expect(store.StoreObjects(ctx, []models.Object{newObject("podinfo", "Failed")})).To(Succeed())
+
+		other, release, err := indexes.Get(ctx, time.Now())
+		g.Expect(err).NotTo(HaveOccurred())
+		defer release()
+
+		g.Expect(other).NotTo(BeIdenticalTo(first))
+		g.Expect(search(other)).To(Succeed())
+	})
+
+	t.Run("closes evicted indexes once released", func(t *testing.T) {
+		// the first index was evicted, but is still in use
+		g.Expect(search(first)).To(Succeed())
+
+		releaseFirst()
+		g.Expect(search(first)).NotTo(Succeed())
+	})
+}
@@ -0,0 +1,261 @@
+package store
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	bleve "github.com/blevesearch/bleve/v2"
+	blevequery "github.com/blevesearch/bleve/v2/search/query"
+)
+
+const (
+	OperandIn       QueryOperand = "in"
+	OperandNotIn    QueryOperand = "not_in"
+	OperandPrefix   QueryOperand = "prefix"
+	OperandWildcard QueryOperand = "wildcard"
+	OperandRegex    QueryOperand = "regex"
+	OperandRange    QueryOperand = "range"
+)
+
+// updatedAtField is the index field holding the last time an object was indexed.
+const updatedAtField = "UpdatedAt"
+
+// filterFieldAliases maps the filter fields that differ from their index field.
+var filterFieldAliases = map[string]string{
+	"updatedAt": updatedAtField,
+}
+
+// Filter matches the objects whose field satisfies the operand.
+// Prefix, wildcard and regex filters match the indexed terms of a field, that
+// are the exact values for cluster, namespace, kind and name.
+type Filter struct {
+	Field   string
+	Operand QueryOperand
+	// Values to match. Equal, not equal, prefix, wildcard and regex filters take exactly one value.
+	Values []string
+	// Min and Max bound a range filter, inclusively. They are either numbers or RFC3339 dates.
+	Min string
+	Max string
+}
+
+// FilterGroup combines its filters with its operand, which defaults to "and".
+type FilterGroup struct {
+	Operand GlobalOperand
+	Filters []Filter
+}
+
+// StructuredQuery is a Query that also filters by a set of filter groups.
+// Groups are ANDed together and with the query filters.
+type StructuredQuery interface {
+	Query
+	GetFilterGroups() []FilterGroup
+}
+
+func (f Filter) Validate() error {
+	if f.Field == "" {
+		return fmt.Errorf("missing field")
+	}
+
+	switch f.Operand {
+	case OperandEqual, OperandNotEqual, OperandPrefix, OperandWildcard:
+		if len(f.Values) != 1 {
+			return fmt.Errorf("operand %s on field %s requires exactly one value", f.Operand, f.Field)
+		}
+	case OperandRegex:
+		if len(f.Values) != 1 {
+			return fmt.Errorf("operand %s on field %s requires exactly one value", f.Operand, f.Field)
+		}
+		if _, err := regexp.Compile(f.Values[0]); err != nil {
+			return fmt.Errorf("invalid regex on field %s: %w", f.Field, err)
+		}
+	case OperandIn, OperandNotIn:
+		if len(f.Values) == 0 {
+			return fmt.Errorf("operand %s on field %s requires at least one value", f.Operand, f.Field)
+		}
+	case OperandRange:
+		if len(f.Values) > 0 {
+			return fmt.Errorf("operand %s on field %s takes min and max instead of values", f.Operand, f.Field)
+		}
+		if _, err := f.rangeQuery(); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unsupported operand %q on field %s", f.Operand, f.Field)
+	}
+
+	return nil
+}
+
+func (g FilterGroup) Validate() error {
+	switch g.Operand {
+	case "", GlobalOperandAnd, GlobalOperandOr:
+	default:
+		return fmt.Errorf("unsupported group operand %q", g.Operand)
+	}
+
+	if len(g.Filters) == 0 {
+		return fmt.Errorf("filter group cannot be empty")
+	}
+
+	for _, f := range g.Filters {
+		if err := f.Validate(); err != nil {
+			return fmt.Errorf("invalid filter: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateFilterGroups validates every group, and reports the position of the first invalid one.
+func ValidateFilterGroups(groups []FilterGroup) error {
+	for i, g := range groups {
+		if err := g.Validate(); err != nil {
+			return fmt.Errorf("invalid filter group %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (g FilterGroup) query() (blevequery.Query, error) {
+	if err := g.Validate(); err != nil {
+		return nil, err
+	}
+
+	queries := []blevequery.Query{}
+	for _, f := range g.Filters {
+		q, err := f.query()
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, q)
+	}
+
+	if g.Operand == GlobalOperandOr {
+		return bleve.NewDisjunctionQuery(queries...), nil
+	}
+
+	return bleve.NewConjunctionQuery(queries...), nil
+}
+
+func (f Filter) query() (blevequery.Query, error) {
+	field := f.indexField()
+
+	switch f.Operand {
+	case OperandEqual:
+		return matchQuery(field, f.Values[0]), nil
+	case OperandNotEqual:
+		return notQuery(matchQuery(field, f.Values[0])), nil
+	case OperandIn:
+		return inQuery(field, f.Values), nil
+	case OperandNotIn:
+		return notQuery(inQuery(field, f.Values)), nil
+	case OperandPrefix:
+		q := bleve.NewPrefixQuery(f.Values[0])
+		q.SetField(field)
+		return q, nil
+	case OperandWildcard:
+		q := bleve.NewWildcardQuery(f.Values[0])
+		q.SetField(field)
+		return q, nil
+	case OperandRegex:
+		q := bleve.NewRegexpQuery(f.Values[0])
+		q.SetField(field)
+		return q, nil
+	case OperandRange:
+		return f.rangeQuery()
+	}
+
+	return nil, fmt.Errorf("unsupported operand %q on field %s", f.Operand, f.Field)
+}
+
+// rangeQuery creates a numeric range query when the bounds are numbers,
+// or a date range query when they are RFC3339 dates.
+func (f Filter) rangeQuery() (blevequery.Query, error) {
+	if f.Min == "" && f.Max == "" {
+		return nil, fmt.Errorf("range on field %s requires min or max", f.Field)
+	}
+
+	inclusive := true
+
+	minNum, minIsNum := parseNumber(f.Min)
+	maxNum, maxIsNum := parseNumber(f.Max)
+	if minIsNum && maxIsNum {
+		q := bleve.NewNumericRangeInclusiveQuery(minNum, maxNum, &inclusive, &inclusive)
+		q.SetField(f.indexField())
+		return q, nil
+	}
+
+	minDate, minIsDate := parseDate(f.Min)
+	maxDate, maxIsDate := parseDate(f.Max)
+	if minIsDate && maxIsDate {
+		q := bleve.NewDateRangeInclusiveQuery(minDate, maxDate, &inclusive, &inclusive)
+		q.SetField(f.indexField())
+		return q, nil
+	}
+
+	return nil, fmt.Errorf("range on field %s requires numbers or RFC3339 dates, got min %q and max %q", f.Field, f.Min, f.Max)
+}
+
+func (f Filter) indexField() string {
+	if field, ok := filterFieldAliases[f.Field]; ok {
+		return field
+	}
+	return f.Field
+}
+
+// parseNumber parses an optional bound. Missing bounds are nil and valid.
+func parseNumber(s string) (*float64, bool) {
+	if s == "" {
+		return nil, true
+	}
+	n, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil, false
+	}
+	return &n, true
+}
+
+// parseDate parses an optional bound. Missing bounds are the zero time, which bleve leaves open.
+func parseDate(s string) (time.Time, bool) {
+	if s == "" {
+		return time.Time{}, true
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+func matchQuery(field, value string) blevequery.Query {
+	q := bleve.NewMatchQuery(value)
+	q.SetField(field)
+	q.SetOperator(blevequery.MatchQueryOperatorAnd)
+	return q
+}
+
+func inQuery(field string, values []string) blevequery.Query {
+	queries := []blevequery.Query{}
+	for _, v := range values {
+		queries = append(queries, matchQuery(field, v))
+	}
+	return bleve.NewDisjunctionQuery(queries...)
+}
+
+// notQuery matches the objects that do not match the query. The unstructured document of
+// an object has none of its fields, so it is left out for the object not to match anyway.
+func notQuery(q blevequery.Query) blevequery.Query {
+	b := bleve.NewBooleanQuery()
+	b.AddMust(objectsQuery())
+	b.AddMustNot(q)
+	return b
+}
+
+// objectsQuery matches object documents, that are the only ones with a cluster.
+func objectsQuery() blevequery.Query {
+	q := bleve.NewWildcardQuery("*")
+	q.SetField("cluster")
+	return q
+}
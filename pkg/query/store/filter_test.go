@@ -0,0 +1,239 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+)
+
+type structuredQuery struct {
+	filters []string
+	groups  []FilterGroup
+}
+
+func (q structuredQuery) GetTerms() string {
+	return ""
+}
+
+func (q structuredQuery) GetFilters() []string {
+	return q.filters
+}
+
+func (q structuredQuery) GetFilterGroups() []FilterGroup {
+	return q.groups
+}
+
+func TestFilterGroup_Validate(t *testing.T) {
+	g := NewWithT(t)
+
+	tests := []struct {
+		name       string
+		group      FilterGroup
+		errPattern string
+	}{
+		{
+			name: "valid filters",
+			group: FilterGroup{Operand: GlobalOperandOr, Filters: []Filter{
+				{Field: "cluster", Operand: OperandEqual, Values: []string{"management"}},
+				{Field: "kind", Operand: OperandIn, Values: []string{"HelmRelease", "Kustomization"}},
+				{Field: "name", Operand: OperandRegex, Values: []string{"pod.*"}},
+				{Field: "updatedAt", Operand: OperandRange, Min: "2023-01-01T00:00:00Z"},
+				{Field: "count", Operand: OperandRange, Min: "1", Max: "10"},
+			}},
+		},
+		{
+			name:       "empty group",
+			group:      FilterGroup{},
+			errPattern: "filter group cannot be empty",
+		},
+		{
+			name:       "unsupported group operand",
+			group:      FilterGroup{Operand: "xor", Filters: []Filter{{Field: "kind", Operand: OperandEqual, Values: []string{"HelmRelease"}}}},
+			errPattern: `unsupported group operand "xor"`,
+		},
+		{
+			name:       "missing field",
+			group:      FilterGroup{Filters: []Filter{{Operand: OperandEqual, Values: []string{"HelmRelease"}}}},
+			errPattern: "missing field",
+		},
+		{
+			name:       "unsupported operand",
+			group:      FilterGroup{Filters: []Filter{{Field: "kind", Operand: "like", Values: []string{"Helm"}}}},
+			errPattern: `unsupported operand "like" on field kind`,
+		},
+		{
+			name:       "too many values",
+			group:      FilterGroup{Filters: []Filter{{Field: "kind", Operand: OperandPrefix, Values: []string{"Helm", "Kust"}}}},
+			errPattern: "operand prefix on field kind requires exactly one value",
+		},
+		{
+			name:       "missing values",
+			group:      FilterGroup{Filters: []Filter{{Field: "kind", Operand: OperandNotIn}}},
+			errPattern: "operand not_in on field kind requires at least one value",
+		},
+		{
+			name:       "invalid regex",
+			group:      FilterGroup{Filters: []Filter{{Field: "name", Operand: OperandRegex, Values: []string{"pod[.*"}}}},
+			errPattern: "invalid regex on field name",
+		},
+		{
+			name:       "range without bounds",
+			group:      FilterGroup{Filters: []Filter{{Field: "updatedAt", Operand: OperandRange}}},
+			errPattern: "range on field updatedAt requires min or max",
+		},
+		{
+			name:       "range with invalid bounds",
+			group:      FilterGroup{Filters: []Filter{{Field: "updatedAt", Operand: OperandRange, Min: "yesterday"}}},
+			errPattern: "range on field updatedAt requires numbers or RFC3339 dates",
+		},
+		{
+			name:       "range with values",
+			group:      FilterGroup{Filters: []Filter{{Field: "updatedAt", Operand: OperandRange, Values: []string{"1"}}}},
+			errPattern: "takes min and max instead of values",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.group.Validate()
+			if tt.errPattern == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+				return
+			}
+			g.Expect(err).To(MatchError(MatchRegexp(tt.errPattern)))
+		})
+	}
+}
+
+func TestIndexer_SearchFilterGroups(t *testing.T) {
+	g := NewWithT(t)
+
+	s, err := NewStore(StorageBackendSQLite, t.TempDir(), logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	idx, err := NewIndexer(s, t.TempDir(), logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	newObject := func(cluster, kind, name string) models.Object {
+		return models.Object{
+			Cluster:    cluster,
+			Kind:       kind,
+			Name:       name,
+			Namespace:  "default",
+			APIGroup:   "anyGroup",
+			APIVersion: "anyVersion",
+			Category:   "automation",
+			// the unstructured document has none of the object fields, and must not match negated filters
+			Unstructured: json.RawMessage(fmt.Sprintf(`{"kind":%q,"metadata":{"name":%q}}`, kind, name)),
+		}
+	}
+
+	objects := []models.Object{
+		newObject("management", "HelmRelease", "podinfo"),
+		newObject("management", "Kustomization", "flux-system"),
+		newObject("dev", "HelmRelease", "podinfo-dev"),
+		newObject("prod", "GitRepository", "flux-system"),
+	}
+
+	g.Expect(s.StoreObjects(context.Background(), objects)).To(Succeed())
+	g.Expect(idx.Add(context.Background(), objects)).To(Succeed())
+
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		query    structuredQuery
+		expected []string
+	}{
+		{
+			name:     "in",
+			query:    structuredQuery{groups: []FilterGroup{{Filters: []Filter{{Field: "kind", Operand: OperandIn, Values: []string{"HelmRelease", "Kustomization"}}}}}},
+			expected: []string{"management/podinfo", "management/flux-system", "dev/podinfo-dev"},
+		},
+		{
+			name:     "not in",
+			query:    structuredQuery{groups: []FilterGroup{{Filters: []Filter{{Field: "cluster", Operand: OperandNotIn, Values: []string{"management", "dev"}}}}}},
+			expected: []string{"prod/flux-system"},
+		},
+		{
+			name:     "not equal",
+			query:    structuredQuery{groups: []FilterGroup{{Filters: []Filter{{Field: "kind", Operand: OperandNotEqual, Values: []string{"HelmRelease"}}}}}},
+			expected: []string{"management/flux-system", "prod/flux-system"},
+		},
+		{
+			name:     "prefix",
+			query:    structuredQuery{groups: []FilterGroup{{Filters: []Filter{{Field: "name", Operand: OperandPrefix, Values: []string{"podinfo"}}}}}},
+			expected: []string{"management/podinfo", "dev/podinfo-dev"},
+		},
+		{
+			name:     "wildcard",
+			query:    structuredQuery{groups: []FilterGroup{{Filters: []Filter{{Field: "name", Operand: OperandWildcard, Values: []string{"*-dev"}}}}}},
+			expected: []string{"dev/podinfo-dev"},
+		},
+		{
+			name:     "regex",
+			query:    structuredQuery{groups: []FilterGroup{{Filters: []Filter{{Field: "cluster", Operand: OperandRegex, Values: []string{"(dev|prod)"}}}}}},
+			expected: []string{"dev/podinfo-dev", "prod/flux-system"},
+		},
+		{
+			name:     "date range",
+			query:    structuredQuery{groups: []FilterGroup{{Filters: []Filter{{Field: "updatedAt", Operand: OperandRange, Min: now.Add(-time.Hour).Format(time.RFC3339)}}}}},
+			expected: []string{"management/podinfo", "management/flux-system", "dev/podinfo-dev", "prod/flux-system"},
+		},
+		{
+			name:     "date range excluding every object",
+			query:    structuredQuery{groups: []FilterGroup{{Filters: []Filter{{Field: "updatedAt", Operand: OperandRange, Max: now.Add(-time.Hour).Format(time.RFC3339)}}}}},
+			expected: []string{},
+		},
+		{
+			name: "or group",
+			query: structuredQuery{groups: []FilterGroup{{Operand: GlobalOperandOr, Filters: []Filter{
+				{Field: "cluster", Operand: OperandEqual, Values: []string{"prod"}},
+				{Field: "kind", Operand: OperandEqual, Values: []string{"Kustomization"}},
+			}}}},
+			expected: []string{"management/flux-system", "prod/flux-system"},
+		},
+		{
+			name: "groups and string filters are anded",
+			query: structuredQuery{
+				filters: []string{"cluster:management"},
+				groups: []FilterGroup{
+					{Operand: GlobalOperandOr, Filters: []Filter{
+						{Field: "kind", Operand: OperandEqual, Values: []string{"HelmRelease"}},
+						{Field: "kind", Operand: OperandEqual, Values: []string{"GitRepository"}},
+					}},
+					{Filters: []Filter{{Field: "name", Operand: OperandPrefix, Values: []string{"pod"}}}},
+				},
+			},
+			expected: []string{"management/podinfo"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			iter, err := idx.Search(context.Background(), tt.query, nil)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			all, err := iter.All()
+			g.Expect(err).NotTo(HaveOccurred())
+
+			names := []string{}
+			for _, obj := range all {
+				names = append(names, obj.Cluster+"/"+obj.Name)
+			}
+
+			g.Expect(names).To(ConsistOf(tt.expected))
+		})
+	}
+
+	t.Run("fails for invalid groups", func(t *testing.T) {
+		_, err := idx.Search(context.Background(), structuredQuery{groups: []FilterGroup{{}}}, nil)
+		g.Expect(err).To(MatchError(MatchRegexp("invalid filter group: filter group cannot be empty")))
+	})
+}
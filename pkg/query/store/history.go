@@ -0,0 +1,122 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+	"gorm.io/gorm"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// changedObjectTransitions returns the transitions of the objects that are new, or whose status or
+// message differ from the stored ones. It must run before the objects are stored.
+func changedObjectTransitions(db *gorm.DB, objects []models.Object, ts time.Time) ([]models.ObjectTransition, error) {
+	ids := []string{}
+	for _, obj := range objects {
+		ids = append(ids, obj.GetID())
+	}
+
+	stored := []models.Object{}
+	result := db.Model(&models.Object{}).Select("id", "status", "message").Where("id IN ?", ids).Find(&stored)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to get stored objects: %w", result.Error)
+	}
+
+	previous := map[string]models.Object{}
+	for _, obj := range stored {
+		previous[obj.ID] = obj
+	}
+
+	transitions := []models.ObjectTransition{}
+	for _, obj := range objects {
+		prev, ok := previous[obj.GetID()]
+		if ok && prev.Status == obj.Status && prev.Message == obj.Message {
+			continue
+		}
+
+		transitions = append(transitions, models.NewObjectTransition(obj, false, ts))
+		// the same object could be twice in the batch
+		previous[obj.GetID()] = obj
+	}
+
+	return transitions, nil
+}
+
+// deletedObjectTransitions returns the transitions recording that the objects were deleted.
+func deletedObjectTransitions(objects []models.Object, ts time.Time) []models.ObjectTransition {
+	transitions := []models.ObjectTransition{}
+	for _, obj := range objects {
+		transitions = append(transitions, models.NewObjectTransition(obj, true, ts))
+	}
+	return transitions
+}
+
+// storeDeletedClusterTransitions records that the objects of a cluster were deleted. The transitions
+// are copied from the stored objects by the database, so that the objects, and their unstructured
+// content, are not loaded to delete a cluster. It must run before the objects are deleted.
+func storeDeletedClusterTransitions(db *gorm.DB, cluster string, ts time.Time) error {
+	result := db.Exec(`INSERT INTO object_transitions
+		(object_id, cluster, namespace, api_group, api_version, kind, name, status, message, category, deleted, timestamp)
+		SELECT id, cluster, namespace, api_group, api_version, kind, name, status, message, category, ?, ?
+		FROM objects WHERE cluster = ?`, true, ts, cluster)
+	if result.Error != nil {
+		return fmt.Errorf("failed to store object transitions: %w", result.Error)
+	}
+
+	return nil
+}
+
+func storeObjectTransitions(db *gorm.DB, transitions []models.ObjectTransition) error {
+	if len(transitions) == 0 {
+		return nil
+	}
+
+	if result := db.Create(&transitions); result.Error != nil {
+		return fmt.Errorf("failed to store object transitions: %w", result.Error)
+	}
+
+	return nil
+}
+
+func (i *SQLiteStore) GetObjectTransitions(ctx context.Context, id string) ([]models.ObjectTransition, error) {
+	transitions := []models.ObjectTransition{}
+
+	result := i.db.WithContext(ctx).Model(&models.ObjectTransition{}).Where("object_id = ?", id).Order("id").Find(&transitions)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to get object transitions: %w", result.Error)
+	}
+
+	return transitions, nil
+}
+
+func (i *SQLiteStore) GetObjectTransitionsAsOf(ctx context.Context, asOf time.Time) ([]models.ObjectTransition, error) {
+	transitions := []models.ObjectTransition{}
+
+	latest := i.db.Model(&models.ObjectTransition{}).Select("MAX(id)").Where("timestamp <= ?", asOf).Group("object_id")
+
+	result := i.db.WithContext(ctx).Model(&models.ObjectTransition{}).Where("id IN (?)", latest).Where("deleted = ?", false).Find(&transitions)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to get object transitions: %w", result.Error)
+	}
+
+	return transitions, nil
+}
+
+func (i *SQLiteStore) DeleteObjectTransitions(ctx context.Context, gvk schema.GroupVersionKind, before time.Time) error {
+	// The latest transition of an object is how it is now, so it is kept unless the object was deleted.
+	latest := i.db.Model(&models.ObjectTransition{}).Select("MAX(id)").Group("object_id")
+
+	result := i.db.WithContext(ctx).
+		Where("api_group = ? AND api_version = ? AND kind = ?", gvk.Group, gvk.Version, gvk.Kind).
+		Where("timestamp < ?", before).
+		Where(i.db.Where("deleted = ?", true).Or("id NOT IN (?)", latest)).
+		Delete(&models.ObjectTransition{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete object transitions: %w", result.Error)
+	}
+
+	i.debug.Info("object transitions deleted", "kind", gvk.String(), "rows-affected", result.RowsAffected)
+	return nil
+}
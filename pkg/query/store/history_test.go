@@ -0,0 +1,147 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/configuration"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestSQLiteStore_ObjectTransitions(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+	store, db := createStore(t)
+
+	newObject := func(name, status, message string) models.Object {
+		return models.Object{
+			Cluster:    "test-cluster",
+			Name:       name,
+			Namespace:  "namespace",
+			Kind:       "Kustomization",
+			APIGroup:   "kustomize.toolkit.fluxcd.io",
+			APIVersion: "v1",
+			Status:     status,
+			Message:    message,
+			Category:   configuration.CategoryAutomation,
+		}
+	}
+
+	statuses := func(transitions []models.ObjectTransition) []string {
+		result := []string{}
+		for _, t := range transitions {
+			if t.Deleted {
+				result = append(result, "deleted")
+				continue
+			}
+			result = append(result, t.Status)
+		}
+		return result
+	}
+
+	podinfo := newObject("podinfo", "Success", "applied")
+	flux := newObject("flux-system", "Success", "applied")
+
+	g.Expect(store.StoreObjects(ctx, []models.Object{podinfo, flux})).To(Succeed())
+	beforeFailure := time.Now()
+
+	t.Run("records a transition only when status or message change", func(t *testing.T) {
+		g.Expect(store.StoreObjects(ctx, []models.Object{podinfo})).To(Succeed())
+		g.Expect(store.StoreObjects(ctx, []models.Object{newObject("podinfo", "Failed", "health check failed")})).To(Succeed())
+		g.Expect(store.StoreObjects(ctx, []models.Object{newObject("podinfo", "Failed", "health check failed")})).To(Succeed())
+
+		transitions, err := store.GetObjectTransitions(ctx, podinfo.GetID())
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(statuses(transitions)).To(Equal([]string{"Success", "Failed"}))
+		g.Expect(transitions[1].Message).To(Equal("health check failed"))
+	})
+
+	t.Run("records deletions", func(t *testing.T) {
+		g.Expect(store.DeleteObjects(ctx, []models.Object{flux})).To(Succeed())
+
+		transitions, err := store.GetObjectTransitions(ctx, flux.GetID())
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(statuses(transitions)).To(Equal([]string{"Success", "deleted"}))
+	})
+
+	t.Run("gets the objects as they were", func(t *testing.T) {
+		transitions, err := store.GetObjectTransitionsAsOf(ctx, beforeFailure)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(transitions).To(HaveLen(2))
+		for _, tr := range transitions {
+			g.Expect(tr.Status).To(Equal("Success"))
+		}
+
+		transitions, err = store.GetObjectTransitionsAsOf(ctx, time.Now())
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(transitions).To(HaveLen(1))
+		failed := transitions[0].Object()
+		g.Expect(failed.GetID()).To(Equal(podinfo.GetID()))
+		g.Expect(transitions[0].Status).To(Equal("Failed"))
+	})
+
+	t.Run("records deletions of all objects in a cluster", func(t *testing.T) {
+		g.Expect(store.DeleteAllObjects(ctx, []string{"test-cluster"})).To(Succeed())
+
+		transitions, err := store.GetObjectTransitionsAsOf(ctx, time.Now())
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(transitions).To(BeEmpty())
+
+		transitions, err = store.GetObjectTransitions(ctx, podinfo.GetID())
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(statuses(transitions)).To(Equal([]string{"Success", "Failed", "deleted"}))
+		g.Expect(transitions[2].Object()).To(Equal(models.Object{
+			ID:         podinfo.GetID(),
+			Cluster:    "test-cluster",
+			Namespace:  "namespace",
+			APIGroup:   "kustomize.toolkit.fluxcd.io",
+			APIVersion: "v1",
+			Kind:       "Kustomization",
+			Name:       "podinfo",
+			Status:     "Failed",
+			Message:    "health check failed",
+			Category:   configuration.CategoryAutomation,
+		}))
+	})
+
+	t.Run("records the labels of the objects", func(t *testing.T) {
+		labelled := newObject("labelled", "Success", "")
+		labelled.Kind = "GitRepository"
+		labelled.Labels = map[string]string{"app.kubernetes.io/part-of": "podinfo"}
+		g.Expect(store.StoreObjects(ctx, []models.Object{labelled})).To(Succeed())
+
+		transitions, err := store.GetObjectTransitions(ctx, labelled.GetID())
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(transitions).To(HaveLen(1))
+		g.Expect(transitions[0].Object().Labels).To(Equal(labelled.Labels))
+	})
+
+	t.Run("deletes expired transitions", func(t *testing.T) {
+		other := newObject("other", "Success", "")
+		other.Kind = "HelmRelease"
+		g.Expect(store.StoreObjects(ctx, []models.Object{other})).To(Succeed())
+
+		kustomization := schema.GroupVersionKind{Group: "kustomize.toolkit.fluxcd.io", Version: "v1", Kind: "Kustomization"}
+		g.Expect(store.DeleteObjectTransitions(ctx, kustomization, time.Now())).To(Succeed())
+
+		var kustomizations, others int
+		g.Expect(db.Raw("SELECT COUNT(id) FROM object_transitions WHERE kind = ?", "Kustomization").Row().Scan(&kustomizations)).To(Succeed())
+		g.Expect(db.Raw("SELECT COUNT(id) FROM object_transitions WHERE kind = ?", "HelmRelease").Row().Scan(&others)).To(Succeed())
+		g.Expect(kustomizations).To(Equal(0))
+		g.Expect(others).To(Equal(1))
+	})
+
+	t.Run("keeps the latest transition of existing objects", func(t *testing.T) {
+		g.Expect(store.StoreObjects(ctx, []models.Object{podinfo})).To(Succeed())
+		g.Expect(store.StoreObjects(ctx, []models.Object{newObject("podinfo", "Failed", "")})).To(Succeed())
+
+		g.Expect(store.DeleteObjectTransitions(ctx, schema.GroupVersionKind{Group: "kustomize.toolkit.fluxcd.io", Version: "v1", Kind: "Kustomization"}, time.Now())).To(Succeed())
+
+		transitions, err := store.GetObjectTransitions(ctx, podinfo.GetID())
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(statuses(transitions)).To(Equal([]string{"Failed"}))
+	})
+}
@@ -3,7 +3,9 @@ package store
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
 	"path/filepath"
 	"strings"
 	"sync"
@@ -17,6 +19,7 @@ import (
 	"github.com/blevesearch/bleve/v2/search"
 	"github.com/go-logr/logr"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+	"github.com/weaveworks/weave-gitops/core/logger"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
@@ -25,6 +28,7 @@ import (
 type Indexer interface {
 	IndexReader
 	IndexWriter
+	IndexMaintainer
 }
 
 //counterfeiter:generate . IndexWriter
@@ -38,6 +42,31 @@ type IndexWriter interface {
 	RemoveByQuery(ctx context.Context, q string) error
 }
 
+//counterfeiter:generate . IndexMaintainer
+type IndexMaintainer interface {
+	// Reindex rebuilds the index from the objects of the store and swaps it in atomically,
+	// for example when the index has drifted from the store. It returns the number of indexed objects.
+	Reindex(ctx context.Context) (int, error)
+	// CheckConsistency returns the ids of the objects missing from the index or from the store.
+	CheckConsistency(ctx context.Context) (IndexConsistency, error)
+	// IndexChanges indexes the objects written to the store since a time, and removes the ones deleted since then,
+	// like the objects written by the other writers of a shared store. It returns the ids of the indexed and removed objects.
+	IndexChanges(ctx context.Context, since time.Time) (indexed []string, removed []string, err error)
+}
+
+// IndexConsistency compares the objects of the index with the ones of the store.
+type IndexConsistency struct {
+	StoreCount       int
+	IndexCount       int
+	MissingFromIndex []string
+	MissingFromStore []string
+}
+
+// Consistent returns whether the index has the same objects as the store.
+func (c IndexConsistency) Consistent() bool {
+	return len(c.MissingFromIndex) == 0 && len(c.MissingFromStore) == 0
+}
+
 type Facets map[string][]string
 
 //counterfeiter:generate . IndexReader
@@ -55,25 +84,217 @@ var indexFile = "index.db"
 var commonFields = []string{"cluster", "namespace", "kind"}
 
 func NewIndexer(s Store, path string, log logr.Logger) (Indexer, error) {
-	idxFileLocation := filepath.Join(path, indexFile)
-	mapping := bleve.NewIndexMapping()
+	return NewIndexerWithObjectKinds(s, path, configuration.SupportedObjectKinds, log)
+}
 
-	addFieldMappings(mapping, commonFields)
+// NewIndexerWithObjectKinds creates an indexer that facets the labels of the given object kinds,
+// for example when user-defined object kinds are collected on top of the supported ones.
+// An existing index is opened, unless it is corrupt, in which case it is created again.
+func NewIndexerWithObjectKinds(s Store, path string, objectKinds []configuration.ObjectKind, log logr.Logger) (Indexer, error) {
+	idxFileLocation := filepath.Join(path, indexFile)
 
-	index, err := bleve.New(idxFileLocation, mapping)
+	index, err := openIndex(idxFileLocation, log)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create indexer: %w", err)
 	}
 
 	return &bleveIndexer{
+		idx:         index,
+		path:        idxFileLocation,
+		store:       s,
+		log:         log,
+		objectKinds: objectKinds,
+	}, nil
+}
+
+func openIndex(path string, log logr.Logger) (bleve.Index, error) {
+	if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+		return bleve.New(path, newIndexMapping())
+	}
+
+	index, err := bleve.Open(path)
+	if err == nil {
+		return index, nil
+	}
+
+	log.Error(err, "index is corrupt, creating it again", "path", path)
+	if err := os.RemoveAll(path); err != nil {
+		return nil, fmt.Errorf("failed to remove corrupt index: %w", err)
+	}
+
+	return bleve.New(path, newIndexMapping())
+}
+
+// EnsureIndex reindexes the objects of the store when the index is not consistent with it, like when
+// the index is missing or has been created again because it was corrupt, while the store has been kept.
+func EnsureIndex(ctx context.Context, idx IndexMaintainer, log logr.Logger) error {
+	consistency, err := idx.CheckConsistency(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check index consistency: %w", err)
+	}
+
+	if consistency.Consistent() {
+		return nil
+	}
+
+	log.Info("index is not consistent with the store, reindexing",
+		"missingFromIndex", len(consistency.MissingFromIndex),
+		"missingFromStore", len(consistency.MissingFromStore))
+
+	count, err := idx.Reindex(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to reindex: %w", err)
+	}
+
+	log.Info("objects reindexed", "count", count)
+
+	return nil
+}
+
+// KeepIndexed keeps the index up to date with a store shared with other writers, like the replicas collecting
+// other clusters, until the context is done. The objects written or deleted since the previous period are
+// indexed every period, and the index is only rebuilt when it is found not to be consistent with the store,
+// which is checked every repair period.
+func KeepIndexed(ctx context.Context, idx IndexMaintainer, period, repairPeriod time.Duration, log logr.Logger) {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+	repairTicker := time.NewTicker(repairPeriod)
+	defer repairTicker.Stop()
+
+	last := time.Now()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			now := time.Now()
+			// The changes of the previous period are indexed again, in case the clocks of the writers differ
+			// or their transactions were committed after the previous changes were read.
+			indexed, removed, err := idx.IndexChanges(ctx, last.Add(-period))
+			if err != nil {
+				log.Error(err, "failed to index changes")
+				continue
+			}
+			last = now
+			log.V(logger.LogLevelDebug).Info("changes indexed", "indexed", len(indexed), "removed", len(removed))
+		case <-repairTicker.C:
+			if err := EnsureIndex(ctx, idx, log); err != nil && !errors.Is(err, ErrReindexInProgress) {
+				log.Error(err, "failed to repair index")
+			}
+		}
+	}
+}
+
+// newIndexerFromTransitions indexes in memory the objects as they were after their transitions, so
+// they can be searched like the current ones. Objects only have the fields recorded by their transitions.
+// The returned function releases the index.
+func newIndexerFromTransitions(r StoreReader, transitions []models.ObjectTransition, log logr.Logger) (IndexReader, func() error, error) {
+	index, err := bleve.NewMemOnly(newIndexMapping())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create indexer: %w", err)
+	}
+
+	objects := map[string]models.Object{}
+	batch := index.NewBatch()
+
+	for _, t := range transitions {
+		obj := t.Object()
+		obj.UpdatedAt = t.Timestamp
+		objects[obj.ID] = obj
+
+		if err := batch.Index(obj.ID, obj); err != nil {
+			log.Error(err, "failed to index object", "object", obj.ID)
+		}
+	}
+
+	if err := index.Batch(batch); err != nil {
+		index.Close()
+		return nil, nil, fmt.Errorf("failed to index objects: %w", err)
+	}
+
+	idx := &bleveIndexer{
 		idx:   index,
-		store: s,
+		store: objectsAsOf{StoreReader: r, objects: objects},
 		log:   log,
-	}, nil
+	}
+
+	return idx, index.Close, nil
+}
+
+func newIndexMapping() *mapping.IndexMappingImpl {
+	mapping := bleve.NewIndexMapping()
+
+	addFieldMappings(mapping, commonFields)
+
+	return mapping
+}
+
+// objectsAsOf reads the objects of an index created from their transitions.
+type objectsAsOf struct {
+	StoreReader
+	objects map[string]models.Object
+}
+
+func (s objectsAsOf) GetObjectByID(ctx context.Context, id string) (models.Object, error) {
+	obj, ok := s.objects[id]
+	if !ok {
+		return models.Object{}, fmt.Errorf("failed to get object: %s", id)
+	}
+	return obj, nil
+}
+
+func (s objectsAsOf) GetObjects(ctx context.Context, ids []string, opts QueryOption) (Iterator, error) {
+	objects := []models.Object{}
+	for _, id := range ids {
+		if obj, ok := s.objects[id]; ok {
+			objects = append(objects, obj)
+		}
+	}
+	return &objectsIterator{objects: objects, index: -1}, nil
+}
+
+// objectsIterator iterates over objects already in memory.
+type objectsIterator struct {
+	objects []models.Object
+	index   int
+}
+
+func (i *objectsIterator) Next() bool {
+	i.index++
+	return i.index < len(i.objects)
+}
+
+func (i *objectsIterator) Row() (models.Object, error) {
+	return i.objects[i.index], nil
+}
+
+func (i *objectsIterator) All() ([]models.Object, error) {
+	return i.objects, nil
+}
+
+func (i *objectsIterator) Page(count int, offset int) ([]models.Object, error) {
+	if offset >= len(i.objects) {
+		return []models.Object{}, nil
+	}
+
+	upper := offset + count
+	if upper > len(i.objects) {
+		upper = len(i.objects)
+	}
+
+	return i.objects[offset:upper], nil
+}
+
+func (i *objectsIterator) Close() error {
+	return nil
 }
 
 var facetSuffix = ".facet"
 
+// labelPrefix is the prefix of the index fields holding object labels.
+const labelPrefix = "labels."
+
 func addFieldMappings(index *mapping.IndexMappingImpl, fields []string) {
 	objMapping := bleve.NewDocumentMapping()
 
@@ -105,9 +326,17 @@ func addFieldMappings(index *mapping.IndexMappingImpl, fields []string) {
 }
 
 type bleveIndexer struct {
-	idx   bleve.Index
-	store Store
-	log   logr.Logger
+	// mu guards the index, that is swapped by reindexing.
+	mu  sync.RWMutex
+	idx bleve.Index
+	// next is the index being rebuilt. Objects written while it is rebuilt are written to it too.
+	next *rebuild
+	// path is where the index is kept. Indexes in memory have no path.
+	path        string
+	reindexing  sync.Mutex
+	store       StoreReader
+	log         logr.Logger
+	objectKinds []configuration.ObjectKind
 }
 
 // We want the index to contain our raw JSON objects,
@@ -120,9 +349,34 @@ func (i *bleveIndexer) Add(ctx context.Context, objects []models.Object) (err er
 	metrics.IndexerAddInflightRequests(metrics.AddAction, 1)
 	defer recordIndexerMetrics(metrics.AddAction, time.Now(), err)
 
-	batch := i.idx.NewBatch()
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	if i.next != nil {
+		ids := []string{}
+		for _, obj := range objects {
+			ids = append(ids, obj.GetID())
+		}
+		i.next.written(ids...)
+
+		if err := i.add(i.next.idx, objects); err != nil {
+			return fmt.Errorf("failed to add objects to the index being rebuilt: %w", err)
+		}
+	}
+
+	return i.add(i.idx, objects)
+}
+
+func (i *bleveIndexer) add(idx bleve.Index, objects []models.Object) error {
+	batch := idx.NewBatch()
+	now := time.Now()
 
 	for _, obj := range objects {
+		// The store keeps its own copy of the object, so the index records when it was last updated.
+		if obj.UpdatedAt.IsZero() {
+			obj.UpdatedAt = now
+		}
+
 		if err := batch.Index(obj.GetID(), obj); err != nil {
 			i.log.Error(err, "failed to index object", "object", obj.GetID())
 			continue
@@ -143,7 +397,7 @@ func (i *bleveIndexer) Add(ctx context.Context, objects []models.Object) (err er
 		}
 	}
 
-	return i.idx.Batch(batch)
+	return idx.Batch(batch)
 }
 
 func (i *bleveIndexer) Remove(ctx context.Context, objects []models.Object) (err error) {
@@ -151,8 +405,11 @@ func (i *bleveIndexer) Remove(ctx context.Context, objects []models.Object) (err
 	metrics.IndexerAddInflightRequests(metrics.RemoveAction, 1)
 	defer recordIndexerMetrics(metrics.RemoveAction, time.Now(), err)
 
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
 	for _, obj := range objects {
-		if err := i.idx.Delete(obj.GetID()); err != nil {
+		if err := i.delete(obj.GetID()); err != nil {
 			return fmt.Errorf("failed to delete object: %w", err)
 		}
 	}
@@ -160,11 +417,26 @@ func (i *bleveIndexer) Remove(ctx context.Context, objects []models.Object) (err
 	return nil
 }
 
+// delete deletes the document from the index, and from the index being rebuilt.
+func (i *bleveIndexer) delete(id string) error {
+	if i.next != nil {
+		i.next.written(strings.TrimSuffix(id, unstructuredSuffix))
+
+		if err := i.next.idx.Delete(id); err != nil {
+			return err
+		}
+	}
+	return i.idx.Delete(id)
+}
+
 func (i *bleveIndexer) RemoveByQuery(ctx context.Context, q string) (err error) {
 	// metrics
 	metrics.IndexerAddInflightRequests(metrics.RemoveByQueryAction, 1)
 	defer recordIndexerMetrics(metrics.RemoveByQueryAction, time.Now(), err)
 
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
 	query := bleve.NewQueryStringQuery(q)
 	req := bleve.NewSearchRequest(query)
 
@@ -174,7 +446,7 @@ func (i *bleveIndexer) RemoveByQuery(ctx context.Context, q string) (err error)
 	}
 
 	for _, hit := range result.Hits {
-		if err := i.idx.Delete(hit.ID); err != nil {
+		if err := i.delete(hit.ID); err != nil {
 			return fmt.Errorf("failed to delete object: %w", err)
 		}
 	}
@@ -211,8 +483,25 @@ func (i *bleveIndexer) Search(ctx context.Context, q Query, opts QueryOption) (i
 		query.AddQuery(qs)
 	}
 
+	if sq, ok := q.(StructuredQuery); ok {
+		for _, group := range sq.GetFilterGroups() {
+			gq, err := group.query()
+			if err != nil {
+				return nil, fmt.Errorf("invalid filter group: %w", err)
+			}
+			query.AddQuery(gq)
+		}
+	}
+
+	if iq, ok := q.(IDsQuery); ok {
+		query.AddQuery(bleve.NewDocIDQuery(iq.GetIDs()))
+	}
+
 	req := bleve.NewSearchRequest(query)
 
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
 	count, err := i.idx.DocCount()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get document count: %w", err)
@@ -224,6 +513,9 @@ func (i *bleveIndexer) Search(ctx context.Context, q Query, opts QueryOption) (i
 	// The query iterator will handle limiting the page size.
 	req.Size = int(count)
 
+	// Labels are not kept in the store, so they are loaded from the index.
+	req.Fields = labelFields(i.objectKinds)
+
 	orders := search.SortOrder{}
 
 	if opts != nil {
@@ -265,14 +557,19 @@ func (i *bleveIndexer) Search(ctx context.Context, q Query, opts QueryOption) (i
 		searchResults.Hits[i] = hit
 	}
 
-	// Ensure hits are unique
-	seen := map[string]bool{}
+	// Ensure hits are unique. The unstructured document of an object has no labels,
+	// so the fields of the object document are kept whichever comes first.
+	seen := map[string]*search.DocumentMatch{}
 	uniqueHits := []*search.DocumentMatch{}
 	for _, hit := range searchResults.Hits {
-		if _, ok := seen[hit.ID]; !ok {
-			uniqueHits = append(uniqueHits, hit)
-			seen[hit.ID] = true
+		if first, ok := seen[hit.ID]; ok {
+			if len(first.Fields) == 0 {
+				first.Fields = hit.Fields
+			}
+			continue
 		}
+		uniqueHits = append(uniqueHits, hit)
+		seen[hit.ID] = hit
 	}
 
 	searchResults.Hits = uniqueHits
@@ -305,7 +602,10 @@ func (i *bleveIndexer) ListFacets(ctx context.Context, category configuration.Ob
 	query := bleve.NewMatchAllQuery()
 	req := bleve.NewSearchRequest(query)
 
-	addDefaultFacets(req, category)
+	addDefaultFacets(req, category, i.objectKinds)
+
+	i.mu.RLock()
+	defer i.mu.RUnlock()
 
 	searchResults, err := i.idx.Search(req)
 	if err != nil {
@@ -331,29 +631,59 @@ func (i *bleveIndexer) ListFacets(ctx context.Context, category configuration.Ob
 
 // addDefaultFacets adds a set of defaault facets to facets search requests. Default facets are comprised of a set
 // of common fields like cluster and set of objectkind specific fields like labels
-func addDefaultFacets(req *bleve.SearchRequest, cat configuration.ObjectCategory) {
+func addDefaultFacets(req *bleve.SearchRequest, cat configuration.ObjectCategory, objectKinds []configuration.ObjectKind) {
 	// adding facets for common fields
 	for _, f := range commonFields {
 		req.AddFacet(f, bleve.NewFacetRequest(f+facetSuffix, 100))
 	}
 
 	// adding facets for labels
-	for _, objectKind := range configuration.SupportedObjectKinds {
+	for _, objectKind := range objectKinds {
 		if cat != "" && objectKind.Category != cat {
 			continue
 		}
 		for _, label := range objectKind.Labels {
-			labelFacet := fmt.Sprintf("labels.%s", label)
+			labelFacet := labelPrefix + label
 			req.AddFacet(labelFacet, bleve.NewFacetRequest(labelFacet, 100))
 		}
 	}
 }
 
+// labelFields returns the index fields of the labels collected for the given object kinds.
+func labelFields(objectKinds []configuration.ObjectKind) []string {
+	fields := []string{}
+	seen := map[string]bool{}
+	for _, objectKind := range objectKinds {
+		for _, label := range objectKind.Labels {
+			field := labelPrefix + label
+			if !seen[field] {
+				fields = append(fields, field)
+				seen[field] = true
+			}
+		}
+	}
+	return fields
+}
+
+func labelsFromFields(fields map[string]interface{}) map[string]string {
+	labels := map[string]string{}
+	for field, value := range fields {
+		label, ok := strings.CutPrefix(field, labelPrefix)
+		if !ok {
+			continue
+		}
+		if v, ok := value.(string); ok {
+			labels[label] = v
+		}
+	}
+	return labels
+}
+
 type indexerIterator struct {
 	result *bleve.SearchResult
 	mu     sync.Mutex
 	index  int
-	s      Store
+	s      StoreReader
 	opts   QueryOption
 }
 
@@ -373,7 +703,16 @@ func (i *indexerIterator) Row() (models.Object, error) {
 
 	id := result.ID
 
-	return i.s.GetObjectByID(context.Background(), id)
+	obj, err := i.s.GetObjectByID(context.Background(), id)
+	if err != nil {
+		return obj, err
+	}
+
+	if labels := labelsFromFields(result.Fields); len(labels) > 0 {
+		obj.Labels = labels
+	}
+
+	return obj, nil
 }
 
 func (i *indexerIterator) All() ([]models.Object, error) {
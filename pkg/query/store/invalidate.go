@@ -0,0 +1,78 @@
+package store
+
+import (
+	"context"
+
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+)
+
+// Invalidator drops what it caches of the access rules of clusters.
+type Invalidator interface {
+	Invalidate(clusters []string)
+}
+
+// NewInvalidatingStore invalidates the clusters whose roles or role bindings are written, like the
+// rules cached for them. Clusters are invalidated even when writing fails, as it may be partly done.
+// Wrap it with NewWatchableStore so that watched queries run again with the new rules.
+func NewInvalidatingStore(s Store, invalidator Invalidator) Store {
+	return &invalidatingStore{Store: s, invalidator: invalidator}
+}
+
+type invalidatingStore struct {
+	Store
+	invalidator Invalidator
+}
+
+func (s *invalidatingStore) StoreRoles(ctx context.Context, roles []models.Role) error {
+	defer s.invalidator.Invalidate(roleClusters(roles))
+	return s.Store.StoreRoles(ctx, roles)
+}
+
+func (s *invalidatingStore) StoreRoleBindings(ctx context.Context, roleBindings []models.RoleBinding) error {
+	defer s.invalidator.Invalidate(roleBindingClusters(roleBindings))
+	return s.Store.StoreRoleBindings(ctx, roleBindings)
+}
+
+func (s *invalidatingStore) DeleteRoles(ctx context.Context, roles []models.Role) error {
+	defer s.invalidator.Invalidate(roleClusters(roles))
+	return s.Store.DeleteRoles(ctx, roles)
+}
+
+func (s *invalidatingStore) DeleteAllRoles(ctx context.Context, clusters []string) error {
+	defer s.invalidator.Invalidate(clusters)
+	return s.Store.DeleteAllRoles(ctx, clusters)
+}
+
+func (s *invalidatingStore) DeleteRoleBindings(ctx context.Context, roleBindings []models.RoleBinding) error {
+	defer s.invalidator.Invalidate(roleBindingClusters(roleBindings))
+	return s.Store.DeleteRoleBindings(ctx, roleBindings)
+}
+
+func (s *invalidatingStore) DeleteAllRoleBindings(ctx context.Context, clusters []string) error {
+	defer s.invalidator.Invalidate(clusters)
+	return s.Store.DeleteAllRoleBindings(ctx, clusters)
+}
+
+func roleClusters(roles []models.Role) []string {
+	seen := map[string]bool{}
+	clusters := []string{}
+	for _, r := range roles {
+		if !seen[r.Cluster] {
+			seen[r.Cluster] = true
+			clusters = append(clusters, r.Cluster)
+		}
+	}
+	return clusters
+}
+
+func roleBindingClusters(roleBindings []models.RoleBinding) []string {
+	seen := map[string]bool{}
+	clusters := []string{}
+	for _, rb := range roleBindings {
+		if !seen[rb.Cluster] {
+			seen[rb.Cluster] = true
+			clusters = append(clusters, rb.Cluster)
+		}
+	}
+	return clusters
+}
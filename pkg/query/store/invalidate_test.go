@@ -0,0 +1,60 @@
+package store
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+)
+
+type recordingInvalidator struct {
+	clusters [][]string
+}
+
+func (r *recordingInvalidator) Invalidate(clusters []string) {
+	r.clusters = append(r.clusters, clusters)
+}
+
+func TestInvalidatingStore(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+	s, _ := createStore(t)
+
+	invalidator := &recordingInvalidator{}
+	s = NewInvalidatingStore(s, invalidator)
+
+	rules := func() []models.PolicyRule {
+		return []models.PolicyRule{{APIGroups: "*", Resources: "*", Verbs: "list"}}
+	}
+	roles := []models.Role{
+		{Cluster: "management", Namespace: "flux-system", Kind: "Role", Name: "reader", PolicyRules: rules()},
+		{Cluster: "leaf", Namespace: "flux-system", Kind: "Role", Name: "reader", PolicyRules: rules()},
+		{Cluster: "leaf", Namespace: "apps", Kind: "Role", Name: "reader", PolicyRules: rules()},
+	}
+	binding := models.RoleBinding{
+		Cluster:     "leaf",
+		Namespace:   "apps",
+		Kind:        "RoleBinding",
+		Name:        "readers",
+		RoleRefKind: "Role",
+		RoleRefName: "reader",
+		Subjects:    []models.Subject{{Kind: "User", Name: "jane"}},
+	}
+
+	g.Expect(s.StoreRoles(ctx, roles)).To(Succeed())
+	g.Expect(s.StoreRoleBindings(ctx, []models.RoleBinding{binding})).To(Succeed())
+	g.Expect(s.DeleteRoleBindings(ctx, []models.RoleBinding{binding})).To(Succeed())
+	g.Expect(s.DeleteAllRoles(ctx, []string{"management"})).To(Succeed())
+
+	g.Expect(invalidator.clusters).To(Equal([][]string{
+		{"management", "leaf"},
+		{"leaf"},
+		{"leaf"},
+		{"management"},
+	}))
+
+	// writing objects does not change who can see them
+	g.Expect(s.StoreTenants(ctx, []models.Tenant{{Name: "team", ClusterName: "leaf", Namespace: "apps"}})).To(Succeed())
+	g.Expect(invalidator.clusters).To(HaveLen(4))
+}
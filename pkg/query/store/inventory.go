@@ -0,0 +1,111 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/store/metrics"
+)
+
+// NewInventoryStore keeps the inventory up to date with the objects and the tenants written to the store.
+func NewInventoryStore(s Store, inventory *metrics.Inventory) Store {
+	return &inventoryStore{Store: s, inventory: inventory}
+}
+
+type inventoryStore struct {
+	Store
+	inventory *metrics.Inventory
+}
+
+func (s *inventoryStore) StoreObjects(ctx context.Context, objects []models.Object) error {
+	if err := s.Store.StoreObjects(ctx, objects); err != nil {
+		return err
+	}
+
+	s.inventory.Upsert(objects)
+	return nil
+}
+
+func (s *inventoryStore) DeleteObjects(ctx context.Context, objects []models.Object) error {
+	if err := s.Store.DeleteObjects(ctx, objects); err != nil {
+		return err
+	}
+
+	s.inventory.Delete(objects)
+	return nil
+}
+
+func (s *inventoryStore) DeleteAllObjects(ctx context.Context, clusters []string) error {
+	if err := s.Store.DeleteAllObjects(ctx, clusters); err != nil {
+		return err
+	}
+
+	s.inventory.DeleteClusters(clusters)
+	return nil
+}
+
+func (s *inventoryStore) StoreTenants(ctx context.Context, tenants []models.Tenant) error {
+	if err := s.Store.StoreTenants(ctx, tenants); err != nil {
+		return err
+	}
+
+	s.inventory.UpsertTenants(tenants)
+	return nil
+}
+
+func (s *inventoryStore) DeleteTenants(ctx context.Context, tenants []models.Tenant) error {
+	if err := s.Store.DeleteTenants(ctx, tenants); err != nil {
+		return err
+	}
+
+	s.inventory.DeleteTenants(tenants)
+	return nil
+}
+
+// LoadInventory loads the inventory from the objects and the tenants of the store. The objects are
+// read without their payload, one row at a time.
+func LoadInventory(ctx context.Context, r StoreReader, inventory *metrics.Inventory) error {
+	iter, err := r.GetInventoryObjects(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get objects: %w", err)
+	}
+	defer iter.Close()
+
+	objects := []models.Object{}
+	for iter.Next() {
+		obj, err := iter.Row()
+		if err != nil {
+			return fmt.Errorf("failed to get objects: %w", err)
+		}
+		objects = append(objects, obj)
+	}
+
+	tenants, err := r.GetTenants(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tenants: %w", err)
+	}
+
+	inventory.Reset(objects, tenants)
+	return nil
+}
+
+// KeepInventory loads the inventory from the store periodically until the context is done, so it
+// includes the objects written by other replicas sharing the store.
+func KeepInventory(ctx context.Context, r StoreReader, inventory *metrics.Inventory, period time.Duration, log logr.Logger) {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := LoadInventory(ctx, r, inventory); err != nil {
+				log.Error(err, "failed to load inventory")
+			}
+		}
+	}
+}
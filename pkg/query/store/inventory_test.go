@@ -0,0 +1,77 @@
+package store
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/configuration"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/store/metrics"
+)
+
+func TestInventoryStore(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+	s, _ := createStore(t)
+
+	obj := models.Object{
+		Cluster:    "management",
+		Namespace:  "flux-system",
+		APIGroup:   "kustomize.toolkit.fluxcd.io",
+		APIVersion: "v1",
+		Kind:       "Kustomization",
+		Name:       "flux-system",
+		Status:     "Success",
+		Category:   configuration.CategoryAutomation,
+	}
+	g.Expect(s.StoreObjects(ctx, []models.Object{obj})).To(Succeed())
+
+	inventory := metrics.NewInventory(nil)
+	g.Expect(LoadInventory(ctx, s, inventory)).To(Succeed())
+	g.Expect(testutil.CollectAndCount(inventory, "wge_explorer_objects")).To(Equal(1))
+
+	s = NewInventoryStore(s, inventory)
+
+	suspended := obj
+	suspended.Name = "podinfo"
+	suspended.Status = string(configuration.Suspended)
+	g.Expect(s.StoreObjects(ctx, []models.Object{suspended})).To(Succeed())
+	g.Expect(testutil.CollectAndCount(inventory, "wge_explorer_objects")).To(Equal(2))
+	g.Expect(testutil.CollectAndCount(inventory, "wge_explorer_suspended_objects")).To(Equal(1))
+
+	g.Expect(s.DeleteAllObjects(ctx, []string{"management"})).To(Succeed())
+	g.Expect(testutil.CollectAndCount(inventory)).To(BeZero())
+}
+
+func TestSQLiteStore_GetInventoryObjects(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+	s, _ := createStore(t)
+
+	obj := models.Object{
+		Cluster:      "management",
+		Namespace:    "flux-system",
+		APIGroup:     "kustomize.toolkit.fluxcd.io",
+		APIVersion:   "v1",
+		Kind:         "Kustomization",
+		Name:         "flux-system",
+		Status:       "Success",
+		Message:      "Applied revision: main/1234",
+		Category:     configuration.CategoryAutomation,
+		Unstructured: []byte(`{"Object":{"kind":"Kustomization"}}`),
+	}
+	g.Expect(s.StoreObjects(ctx, []models.Object{obj})).To(Succeed())
+
+	iter, err := s.GetInventoryObjects(ctx)
+	g.Expect(err).NotTo(HaveOccurred())
+	objects, err := iter.All()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(objects).To(HaveLen(1))
+	g.Expect(objects[0].GetID()).To(Equal(obj.GetID()))
+	g.Expect(objects[0].Status).To(Equal("Success"))
+	g.Expect(objects[0].Message).To(BeEmpty())
+	g.Expect(objects[0].Unstructured).To(BeEmpty())
+}
@@ -0,0 +1,244 @@
+package metrics
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/configuration"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+)
+
+const (
+	inventoryNamespace = "wge"
+	inventorySubsystem = "explorer"
+)
+
+// inventoryObject is what the inventory keeps of an object to count it.
+type inventoryObject struct {
+	cluster   string
+	namespace string
+	kind      string
+	status    string
+}
+
+type inventoryKey struct {
+	cluster   string
+	namespace string
+	kind      string
+	status    string
+	tenant    string
+}
+
+// Inventory counts the objects of the explorer by cluster, namespace, kind, status and tenant.
+// The counts are kept up to date by the writes to the store, so scrapes do not read the store
+// or the index.
+type Inventory struct {
+	mu      sync.Mutex
+	objects map[string]inventoryObject
+	tenants map[string]models.Tenant
+	// lookup maps cluster/namespace pairs to their tenant
+	lookup map[string]string
+	counts map[inventoryKey]int
+	owns   func(cluster string) bool
+
+	objectsDesc   *prometheus.Desc
+	suspendedDesc *prometheus.Desc
+}
+
+// NewInventory returns an empty inventory. When owns is given, only the clusters it owns
+// are exported, like the clusters collected by a replica when collection is sharded.
+func NewInventory(owns func(cluster string) bool) *Inventory {
+	return &Inventory{
+		objects: map[string]inventoryObject{},
+		tenants: map[string]models.Tenant{},
+		lookup:  map[string]string{},
+		counts:  map[inventoryKey]int{},
+		owns:    owns,
+		objectsDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(inventoryNamespace, inventorySubsystem, "objects"),
+			"number of objects in the explorer",
+			[]string{"cluster", "namespace", "kind", "status", "tenant"}, nil,
+		),
+		suspendedDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(inventoryNamespace, inventorySubsystem, "suspended_objects"),
+			"number of suspended objects in the explorer",
+			[]string{"cluster", "kind"}, nil,
+		),
+	}
+}
+
+// RegisterInventory exports the inventory with the default prometheus registry, replacing the
+// inventory of a previous query server.
+func RegisterInventory(inventory *Inventory) error {
+	err := prometheus.Register(inventory)
+
+	are := prometheus.AlreadyRegisteredError{}
+	if errors.As(err, &are) {
+		prometheus.Unregister(are.ExistingCollector)
+		err = prometheus.Register(inventory)
+	}
+
+	return err
+}
+
+// Reset replaces the objects and the tenants of the inventory, like when it is loaded from the store.
+func (i *Inventory) Reset(objects []models.Object, tenants []models.Tenant) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.objects = map[string]inventoryObject{}
+	for _, obj := range objects {
+		i.objects[obj.GetID()] = newInventoryObject(obj)
+	}
+
+	i.tenants = map[string]models.Tenant{}
+	for _, t := range tenants {
+		i.tenants[t.GetID()] = t
+	}
+
+	i.recount()
+}
+
+// Upsert adds objects to the inventory, or updates them.
+func (i *Inventory) Upsert(objects []models.Object) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for _, obj := range objects {
+		id := obj.GetID()
+		if previous, ok := i.objects[id]; ok {
+			i.add(previous, -1)
+		}
+
+		o := newInventoryObject(obj)
+		i.objects[id] = o
+		i.add(o, 1)
+	}
+}
+
+// Delete removes objects from the inventory.
+func (i *Inventory) Delete(objects []models.Object) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for _, obj := range objects {
+		id := obj.GetID()
+		if previous, ok := i.objects[id]; ok {
+			i.add(previous, -1)
+			delete(i.objects, id)
+		}
+	}
+}
+
+// DeleteClusters removes the objects of the clusters from the inventory.
+func (i *Inventory) DeleteClusters(clusters []string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	deleted := map[string]bool{}
+	for _, c := range clusters {
+		deleted[c] = true
+	}
+
+	for id, obj := range i.objects {
+		if deleted[obj.cluster] {
+			i.add(obj, -1)
+			delete(i.objects, id)
+		}
+	}
+}
+
+// UpsertTenants adds tenants, or updates them. The objects of their namespaces are counted
+// for them from now on.
+func (i *Inventory) UpsertTenants(tenants []models.Tenant) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for _, t := range tenants {
+		i.tenants[t.GetID()] = t
+	}
+	i.recount()
+}
+
+// DeleteTenants removes tenants.
+func (i *Inventory) DeleteTenants(tenants []models.Tenant) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for _, t := range tenants {
+		delete(i.tenants, t.GetID())
+	}
+	i.recount()
+}
+
+func (i *Inventory) Describe(ch chan<- *prometheus.Desc) {
+	ch <- i.objectsDesc
+	ch <- i.suspendedDesc
+}
+
+func (i *Inventory) Collect(ch chan<- prometheus.Metric) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	type suspendedKey struct {
+		cluster string
+		kind    string
+	}
+	suspended := map[suspendedKey]int{}
+
+	for k, count := range i.counts {
+		if i.owns != nil && !i.owns(k.cluster) {
+			continue
+		}
+
+		ch <- prometheus.MustNewConstMetric(i.objectsDesc, prometheus.GaugeValue, float64(count),
+			k.cluster, k.namespace, k.kind, k.status, k.tenant)
+
+		if k.status == string(configuration.Suspended) {
+			suspended[suspendedKey{cluster: k.cluster, kind: k.kind}] += count
+		}
+	}
+
+	for k, count := range suspended {
+		ch <- prometheus.MustNewConstMetric(i.suspendedDesc, prometheus.GaugeValue, float64(count), k.cluster, k.kind)
+	}
+}
+
+// recount counts the objects again, as changing the tenants changes how they are counted.
+func (i *Inventory) recount() {
+	i.lookup = map[string]string{}
+	for _, t := range i.tenants {
+		i.lookup[t.GetClusterNamespacePair()] = t.Name
+	}
+
+	i.counts = map[inventoryKey]int{}
+	for _, obj := range i.objects {
+		i.add(obj, 1)
+	}
+}
+
+func (i *Inventory) add(obj inventoryObject, delta int) {
+	key := inventoryKey{
+		cluster:   obj.cluster,
+		namespace: obj.namespace,
+		kind:      obj.kind,
+		status:    obj.status,
+		tenant:    i.lookup[obj.cluster+"/"+obj.namespace],
+	}
+
+	i.counts[key] += delta
+	// series of objects that are gone are no longer exported
+	if i.counts[key] <= 0 {
+		delete(i.counts, key)
+	}
+}
+
+func newInventoryObject(obj models.Object) inventoryObject {
+	return inventoryObject{
+		cluster:   obj.Cluster,
+		namespace: obj.Namespace,
+		kind:      obj.Kind,
+		status:    obj.Status,
+	}
+}
@@ -0,0 +1,65 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+)
+
+func TestInventory(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	podinfo := models.Object{Cluster: "prod", Namespace: "apps", APIGroup: "helm.toolkit.fluxcd.io", APIVersion: "v2beta1", Kind: "HelmRelease", Name: "podinfo", Status: "Success"}
+	nginx := models.Object{Cluster: "prod", Namespace: "apps", APIGroup: "helm.toolkit.fluxcd.io", APIVersion: "v2beta1", Kind: "HelmRelease", Name: "nginx", Status: "Success"}
+	flux := models.Object{Cluster: "dev", Namespace: "flux-system", APIGroup: "kustomize.toolkit.fluxcd.io", APIVersion: "v1", Kind: "Kustomization", Name: "flux-system", Status: "Suspended"}
+
+	inventory := NewInventory(nil)
+	inventory.Reset([]models.Object{podinfo, flux}, nil)
+
+	t.Run("counts the objects loaded", func(t *testing.T) {
+		g.Expect(testutil.CollectAndCompare(inventory, strings.NewReader(`
+# HELP wge_explorer_objects number of objects in the explorer
+# TYPE wge_explorer_objects gauge
+wge_explorer_objects{cluster="dev",kind="Kustomization",namespace="flux-system",status="Suspended",tenant=""} 1
+wge_explorer_objects{cluster="prod",kind="HelmRelease",namespace="apps",status="Success",tenant=""} 1
+# HELP wge_explorer_suspended_objects number of suspended objects in the explorer
+# TYPE wge_explorer_suspended_objects gauge
+wge_explorer_suspended_objects{cluster="dev",kind="Kustomization"} 1
+`))).To(Succeed())
+	})
+
+	t.Run("updates the counts as objects are written", func(t *testing.T) {
+		failed := podinfo
+		failed.Status = "Failed"
+		inventory.Upsert([]models.Object{failed, nginx})
+		inventory.DeleteClusters([]string{"dev"})
+
+		g.Expect(testutil.CollectAndCompare(inventory, strings.NewReader(`
+# HELP wge_explorer_objects number of objects in the explorer
+# TYPE wge_explorer_objects gauge
+wge_explorer_objects{cluster="prod",kind="HelmRelease",namespace="apps",status="Failed",tenant=""} 1
+wge_explorer_objects{cluster="prod",kind="HelmRelease",namespace="apps",status="Success",tenant=""} 1
+`))).To(Succeed())
+	})
+
+	t.Run("counts the objects of a namespace for its tenant", func(t *testing.T) {
+		inventory.Delete([]models.Object{nginx})
+		inventory.UpsertTenants([]models.Tenant{{ClusterName: "prod", Name: "team-a", Namespace: "apps"}})
+
+		g.Expect(testutil.CollectAndCompare(inventory, strings.NewReader(`
+# HELP wge_explorer_objects number of objects in the explorer
+# TYPE wge_explorer_objects gauge
+wge_explorer_objects{cluster="prod",kind="HelmRelease",namespace="apps",status="Failed",tenant="team-a"} 1
+`))).To(Succeed())
+	})
+
+	t.Run("only exports the clusters it owns", func(t *testing.T) {
+		owned := NewInventory(func(cluster string) bool { return cluster == "dev" })
+		owned.Reset([]models.Object{podinfo, flux}, nil)
+
+		g.Expect(testutil.CollectAndCount(owned, "wge_explorer_objects")).To(Equal(1))
+	})
+}
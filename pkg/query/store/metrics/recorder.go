@@ -28,6 +28,7 @@ const (
 	GetRoleBindingsAction       = "GetRoleBindings"
 	GetAccessRulesAction        = "GetAccessRules"
 	GetTenantsAction            = "GetTenants"
+	CountObjectsAction          = "CountObjects"
 
 	// indexer actions
 	AddAction           = "Add"
@@ -35,6 +36,9 @@ const (
 	RemoveByQueryAction = "RemoveByQuery"
 	SearchAction        = "Search"
 	ListFacetsAction    = "ListFacets"
+	ReindexAction       = "Reindex"
+	CheckAction         = "CheckConsistency"
+	IndexChangesAction  = "IndexChanges"
 
 	FailedLabel  = "error"
 	SuccessLabel = "success"
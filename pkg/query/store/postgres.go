@@ -0,0 +1,113 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/store/metrics"
+	"github.com/weaveworks/weave-gitops/core/logger"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	gormlog "gorm.io/gorm/logger"
+)
+
+// postgresMigrationLockID is the key of the advisory lock held while migrating the schema,
+// so replicas starting at the same time against the same database do not race each other.
+const postgresMigrationLockID = 7_164_921_850
+
+// PostgresStore is a Store backed by a PostgreSQL database. Unlike SQLiteStore, the database
+// outlives the process and can be shared by several replicas of the query server.
+//
+// Reads and writes are plain GORM, so the SQLiteStore implementation is reused as is and only
+// the operations where the two dialects behave differently are overridden here.
+type PostgresStore struct {
+	*SQLiteStore
+}
+
+func NewPostgresStore(db *gorm.DB, log logr.Logger) (*PostgresStore, error) {
+	return &PostgresStore{
+		SQLiteStore: &SQLiteStore{
+			db:    db,
+			log:   log.WithName("postgres"),
+			debug: log.WithName("postgres").V(logger.LogLevelDebug),
+		},
+	}, nil
+}
+
+// StoreObjects upserts the objects in a single statement. Postgres refuses an upsert that
+// touches the same row twice, so only the last version of each object in the batch is kept.
+func (i *PostgresStore) StoreObjects(ctx context.Context, objects []models.Object) (err error) {
+	// metrics
+	metrics.DataStoreInflightRequests(metrics.StoreObjectsAction, 1)
+	defer recordMetrics(metrics.StoreObjectsAction, time.Now(), err)
+
+	//do nothing if empty collection
+	if len(objects) == 0 {
+		return nil
+	}
+
+	rows := []models.Object{}
+	positions := map[string]int{}
+
+	for _, object := range objects {
+		if err := object.Validate(); err != nil {
+			return fmt.Errorf("invalid object: %w", err)
+		}
+
+		object.ID = object.GetID()
+
+		if pos, ok := positions[object.ID]; ok {
+			rows[pos] = object
+			continue
+		}
+
+		positions[object.ID] = len(rows)
+		rows = append(rows, object)
+		i.debug.Info("storing object", "object", object.ID)
+	}
+
+	return i.storeObjectsTx(ctx, rows)
+}
+
+// CreatePostgresDB connects to the database at the given connection string (URL or key/value DSN)
+// and migrates the schema to the current version of the models.
+func CreatePostgresDB(uri string) (*gorm.DB, error) {
+	if uri == "" {
+		return nil, fmt.Errorf("postgres connection string cannot be empty")
+	}
+
+	db, err := gorm.Open(postgres.Open(uri), &gorm.Config{
+		Logger: gormlog.Discard,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	if err := migratePostgresDB(db); err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+
+	return db, nil
+}
+
+// migratePostgresDB creates or updates the tables for the store models. Advisory locks are
+// held by a session, so the lock, the migration and the unlock all run on the same connection.
+func migratePostgresDB(db *gorm.DB) error {
+	return db.Connection(func(conn *gorm.DB) error {
+		if err := conn.Exec("SELECT pg_advisory_lock(?)", postgresMigrationLockID).Error; err != nil {
+			return fmt.Errorf("failed to acquire migration lock: %w", err)
+		}
+
+		defer conn.Exec("SELECT pg_advisory_unlock(?)", postgresMigrationLockID)
+
+		if err := conn.AutoMigrate(&models.Object{}, &models.Role{}, &models.Subject{}, &models.RoleBinding{}, &models.PolicyRule{}, &models.Tenant{}, &models.ObjectTransition{}, &models.ObjectRelation{}, &models.SavedQuery{}, &models.AlertRule{}, &models.AlertRuleState{}); err != nil {
+			return err
+		}
+
+		return createObjectRelationIndexes(conn)
+	})
+}
@@ -0,0 +1,388 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	bleve "github.com/blevesearch/bleve/v2"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/configuration"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/store/metrics"
+)
+
+// ErrReindexInProgress is returned when the index is reindexed while it is already being rebuilt.
+var ErrReindexInProgress = errors.New("the index is already being rebuilt")
+
+// reindexBatchSize is the number of objects of the store added at once to the index being rebuilt.
+const reindexBatchSize = 500
+
+// rebuild is an index being rebuilt from the objects of the store.
+type rebuild struct {
+	idx bleve.Index
+	mu  sync.Mutex
+	// writes are the ids of the objects added or removed while the index is rebuilt.
+	// They are newer than the objects read from the store, which are not indexed for them.
+	writes map[string]bool
+}
+
+func (r *rebuild) written(ids ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, id := range ids {
+		r.writes[id] = true
+	}
+}
+
+// addFromStore adds the objects read from the store that have not been written since, and returns their number.
+func (r *rebuild) addFromStore(i *bleveIndexer, objects []models.Object) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	unwritten := []models.Object{}
+	for _, obj := range objects {
+		if !r.writes[obj.GetID()] {
+			unwritten = append(unwritten, obj)
+		}
+	}
+
+	if err := i.add(r.idx, unwritten); err != nil {
+		return 0, err
+	}
+
+	return len(unwritten), nil
+}
+
+func (i *bleveIndexer) Reindex(ctx context.Context) (count int, err error) {
+	// metrics
+	metrics.IndexerAddInflightRequests(metrics.ReindexAction, 1)
+	defer recordIndexerMetrics(metrics.ReindexAction, time.Now(), err)
+
+	if !i.reindexing.TryLock() {
+		return 0, ErrReindexInProgress
+	}
+	defer i.reindexing.Unlock()
+
+	idx, err := i.newRebuildIndex()
+	if err != nil {
+		return 0, fmt.Errorf("failed to create index: %w", err)
+	}
+	next := &rebuild{idx: idx, writes: map[string]bool{}}
+
+	// Objects written from now on are written to both indexes, so none is lost by the swap.
+	i.mu.Lock()
+	i.next = next
+	i.mu.Unlock()
+
+	count, err = i.indexStore(ctx, next)
+	if err != nil {
+		i.mu.Lock()
+		i.next = nil
+		i.mu.Unlock()
+
+		if closeErr := idx.Close(); closeErr != nil {
+			i.log.Error(closeErr, "failed to close the index being rebuilt")
+		}
+		if i.path != "" {
+			os.RemoveAll(i.rebuildPath())
+		}
+
+		return 0, fmt.Errorf("failed to index objects: %w", err)
+	}
+
+	if err := i.swap(next); err != nil {
+		return 0, fmt.Errorf("failed to swap the rebuilt index: %w", err)
+	}
+
+	i.log.Info("index rebuilt", "count", count)
+
+	return count, nil
+}
+
+func (i *bleveIndexer) IndexChanges(ctx context.Context, since time.Time) (indexed []string, removed []string, err error) {
+	// metrics
+	metrics.IndexerAddInflightRequests(metrics.IndexChangesAction, 1)
+	defer recordIndexerMetrics(metrics.IndexChangesAction, time.Now(), err)
+
+	iter, deleted, err := i.store.GetObjectChanges(ctx, since)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get changed objects: %w", err)
+	}
+	defer iter.Close()
+
+	indexed = []string{}
+	batch := []models.Object{}
+
+	flush := func() error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := i.Add(ctx, batch); err != nil {
+			return err
+		}
+		indexed = append(indexed, objectIDs(batch)...)
+		batch = []models.Object{}
+		return nil
+	}
+
+	for iter.Next() {
+		obj, err := iter.Row()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read object: %w", err)
+		}
+
+		// Labels are not kept in the store, so they are derived again from the object.
+		obj.Labels = labelsFromUnstructured(obj, i.objectKinds)
+		batch = append(batch, obj)
+
+		if len(batch) == reindexBatchSize {
+			if err := flush(); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	if err := flush(); err != nil {
+		return nil, nil, err
+	}
+
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	for _, id := range deleted {
+		if err := i.delete(id); err != nil {
+			return nil, nil, fmt.Errorf("failed to delete object: %w", err)
+		}
+	}
+
+	return indexed, deleted, nil
+}
+
+func (i *bleveIndexer) rebuildPath() string {
+	return i.path + ".rebuild"
+}
+
+func (i *bleveIndexer) newRebuildIndex() (bleve.Index, error) {
+	if i.path == "" {
+		return bleve.NewMemOnly(newIndexMapping())
+	}
+
+	// remove the leftovers of a rebuild that did not complete
+	if err := os.RemoveAll(i.rebuildPath()); err != nil {
+		return nil, err
+	}
+
+	return bleve.New(i.rebuildPath(), newIndexMapping())
+}
+
+// indexStore streams the objects of the store into the index being rebuilt.
+func (i *bleveIndexer) indexStore(ctx context.Context, next *rebuild) (int, error) {
+	iter, err := i.store.GetAllObjects(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get objects: %w", err)
+	}
+	defer iter.Close()
+
+	count := 0
+	batch := []models.Object{}
+
+	flush := func() error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		added, err := next.addFromStore(i, batch)
+		if err != nil {
+			return err
+		}
+		count += added
+		batch = []models.Object{}
+		return nil
+	}
+
+	for iter.Next() {
+		obj, err := iter.Row()
+		if err != nil {
+			return 0, fmt.Errorf("failed to read object: %w", err)
+		}
+
+		// Labels are not kept in the store, so they are derived again from the object.
+		obj.Labels = labelsFromUnstructured(obj, i.objectKinds)
+		batch = append(batch, obj)
+
+		if len(batch) == reindexBatchSize {
+			if err := flush(); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if err := flush(); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// swap replaces the index with the rebuilt one. Indexes on disk are closed to be moved,
+// so searches wait for the rebuilt index to be opened in place of the current one.
+func (i *bleveIndexer) swap(next *rebuild) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.next = nil
+	current := i.idx
+
+	if i.path == "" {
+		i.idx = next.idx
+		return current.Close()
+	}
+
+	if err := next.idx.Close(); err != nil {
+		return fmt.Errorf("failed to close rebuilt index: %w", err)
+	}
+	if err := current.Close(); err != nil {
+		return fmt.Errorf("failed to close index: %w", err)
+	}
+
+	old := i.path + ".old"
+	if err := os.RemoveAll(old); err != nil {
+		return i.reopen(fmt.Errorf("failed to remove previous index: %w", err))
+	}
+	if err := os.Rename(i.path, old); err != nil {
+		return i.reopen(fmt.Errorf("failed to move index: %w", err))
+	}
+	if err := os.Rename(i.rebuildPath(), i.path); err != nil {
+		if restoreErr := os.Rename(old, i.path); restoreErr != nil {
+			i.log.Error(restoreErr, "failed to restore index")
+		}
+		return i.reopen(fmt.Errorf("failed to move rebuilt index: %w", err))
+	}
+
+	idx, err := bleve.Open(i.path)
+	if err != nil {
+		return fmt.Errorf("failed to open rebuilt index: %w", err)
+	}
+	i.idx = idx
+
+	return os.RemoveAll(old)
+}
+
+// reopen opens the index again after a failed swap.
+func (i *bleveIndexer) reopen(cause error) error {
+	idx, err := bleve.Open(i.path)
+	if err != nil {
+		return fmt.Errorf("%w: failed to reopen index: %v", cause, err)
+	}
+	i.idx = idx
+
+	return cause
+}
+
+func (i *bleveIndexer) CheckConsistency(ctx context.Context) (c IndexConsistency, err error) {
+	// metrics
+	metrics.IndexerAddInflightRequests(metrics.CheckAction, 1)
+	defer recordIndexerMetrics(metrics.CheckAction, time.Now(), err)
+
+	stored := map[string]bool{}
+
+	iter, err := i.store.GetAllObjects(ctx)
+	if err != nil {
+		return IndexConsistency{}, fmt.Errorf("failed to get objects: %w", err)
+	}
+	defer iter.Close()
+
+	for iter.Next() {
+		obj, err := iter.Row()
+		if err != nil {
+			return IndexConsistency{}, fmt.Errorf("failed to read object: %w", err)
+		}
+		stored[obj.GetID()] = true
+	}
+
+	indexed, err := i.indexedIDs()
+	if err != nil {
+		return IndexConsistency{}, err
+	}
+
+	consistency := IndexConsistency{
+		StoreCount:       len(stored),
+		IndexCount:       len(indexed),
+		MissingFromIndex: []string{},
+		MissingFromStore: []string{},
+	}
+
+	for id := range stored {
+		if !indexed[id] {
+			consistency.MissingFromIndex = append(consistency.MissingFromIndex, id)
+		}
+	}
+	for id := range indexed {
+		if !stored[id] {
+			consistency.MissingFromStore = append(consistency.MissingFromStore, id)
+		}
+	}
+
+	sort.Strings(consistency.MissingFromIndex)
+	sort.Strings(consistency.MissingFromStore)
+
+	return consistency, nil
+}
+
+// indexedIDs returns the ids of the objects in the index.
+func (i *bleveIndexer) indexedIDs() (map[string]bool, error) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	count, err := i.idx.DocCount()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get document count: %w", err)
+	}
+
+	req := bleve.NewSearchRequest(bleve.NewMatchAllQuery())
+	req.Size = int(count)
+
+	result, err := i.idx.Search(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search index: %w", err)
+	}
+
+	ids := map[string]bool{}
+	for _, hit := range result.Hits {
+		ids[strings.TrimSuffix(hit.ID, unstructuredSuffix)] = true
+	}
+
+	return ids, nil
+}
+
+// labelsFromUnstructured derives the labels of an object of the store from its unstructured content,
+// the same way the collector does.
+func labelsFromUnstructured(obj models.Object, objectKinds []configuration.ObjectKind) map[string]string {
+	if len(obj.Unstructured) == 0 {
+		return nil
+	}
+
+	for _, kind := range objectKinds {
+		if kind.Gvk.Group != obj.APIGroup || kind.Gvk.Kind != obj.Kind {
+			continue
+		}
+		if len(kind.Labels) == 0 || kind.NewClientObjectFunc == nil {
+			return nil
+		}
+
+		clientObj := kind.NewClientObjectFunc()
+		if err := json.Unmarshal(obj.ClientObjectJSON(), clientObj); err != nil {
+			return nil
+		}
+
+		return models.NewNormalizedObject(clientObj, kind).GetRelevantLabels()
+	}
+
+	return nil
+}
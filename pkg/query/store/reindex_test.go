@@ -0,0 +1,249 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	gapiv1 "github.com/weaveworks/templates-controller/apis/gitops/v1alpha2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/configuration"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+)
+
+func TestReindex(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	dir := t.TempDir()
+
+	s, err := NewStore(StorageBackendSQLite, dir, logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	idx, err := NewIndexer(s, dir, logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	kustomization := models.Object{
+		Cluster:    "management",
+		Namespace:  "flux-system",
+		APIGroup:   kustomizev1.GroupVersion.Group,
+		APIVersion: kustomizev1.GroupVersion.Version,
+		Kind:       kustomizev1.KustomizationKind,
+		Name:       "flux-system",
+		Category:   configuration.CategoryAutomation,
+	}
+
+	template := &gapiv1.GitOpsTemplate{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gapiv1.GroupVersion.String(),
+			Kind:       gapiv1.Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster-template",
+			Namespace: "templates",
+			Labels: map[string]string{
+				"weave.works/template-type": "cluster",
+			},
+		},
+	}
+	raw, err := json.Marshal(models.NewNormalizedObject(template, configuration.GitopsTemplateObjectKind))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	clusterTemplate := models.Object{
+		Cluster:      "management",
+		Namespace:    "templates",
+		APIGroup:     gapiv1.GroupVersion.Group,
+		APIVersion:   gapiv1.GroupVersion.Version,
+		Kind:         gapiv1.Kind,
+		Name:         "cluster-template",
+		Category:     configuration.CategoryTemplate,
+		Unstructured: raw,
+	}
+
+	deleted := models.Object{
+		Cluster:    "management",
+		Namespace:  "flux-system",
+		APIGroup:   kustomizev1.GroupVersion.Group,
+		APIVersion: kustomizev1.GroupVersion.Version,
+		Kind:       kustomizev1.KustomizationKind,
+		Name:       "deleted",
+		Category:   configuration.CategoryAutomation,
+	}
+
+	// the index drifted from the store: it missed an added object and a deleted one
+	g.Expect(s.StoreObjects(ctx, []models.Object{kustomization, clusterTemplate})).To(Succeed())
+	g.Expect(idx.Add(ctx, []models.Object{kustomization, deleted})).To(Succeed())
+
+	t.Run("reports the objects missing from either side", func(t *testing.T) {
+		consistency, err := idx.CheckConsistency(ctx)
+		g.Expect(err).NotTo(HaveOccurred())
+
+		g.Expect(consistency.Consistent()).To(BeFalse())
+		g.Expect(consistency.StoreCount).To(Equal(2))
+		g.Expect(consistency.IndexCount).To(Equal(2))
+		g.Expect(consistency.MissingFromIndex).To(Equal([]string{clusterTemplate.GetID()}))
+		g.Expect(consistency.MissingFromStore).To(Equal([]string{deleted.GetID()}))
+	})
+
+	t.Run("rebuilds the index from the store", func(t *testing.T) {
+		count, err := idx.Reindex(ctx)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(count).To(Equal(2))
+
+		consistency, err := idx.CheckConsistency(ctx)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(consistency.Consistent()).To(BeTrue())
+
+		iter, err := idx.Search(ctx, query{}, nil)
+		g.Expect(err).NotTo(HaveOccurred())
+		objects, err := iter.All()
+		g.Expect(err).NotTo(HaveOccurred())
+
+		names := []string{}
+		for _, obj := range objects {
+			names = append(names, obj.Name)
+		}
+		g.Expect(names).To(ConsistOf("flux-system", "cluster-template"))
+
+		// labels are not kept in the store, they are derived again from the object
+		facets, err := idx.ListFacets(ctx, configuration.CategoryTemplate)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(facets).To(HaveKeyWithValue("labels.weave.works/template-type", []string{"cluster"}))
+
+		// the rebuilt index has replaced the previous one on disk
+		g.Expect(filepath.Join(dir, indexFile)).To(BeADirectory())
+		g.Expect(filepath.Join(dir, indexFile+".rebuild")).NotTo(BeAnExistingFile())
+		g.Expect(filepath.Join(dir, indexFile+".old")).NotTo(BeAnExistingFile())
+	})
+
+	t.Run("keeps the objects written while the index is rebuilt", func(t *testing.T) {
+		bi := idx.(*bleveIndexer)
+		next, err := bi.newRebuildIndex()
+		g.Expect(err).NotTo(HaveOccurred())
+		rebuilding := &rebuild{idx: next, writes: map[string]bool{}}
+
+		bi.mu.Lock()
+		bi.next = rebuilding
+		bi.mu.Unlock()
+
+		updated := kustomization
+		updated.Status = "Failed"
+		g.Expect(idx.Add(ctx, []models.Object{updated})).To(Succeed())
+		g.Expect(idx.Remove(ctx, []models.Object{clusterTemplate})).To(Succeed())
+
+		count, err := bi.indexStore(ctx, rebuilding)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(count).To(Equal(0))
+		g.Expect(bi.swap(rebuilding)).To(Succeed())
+
+		ids, err := bi.indexedIDs()
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(ids).To(Equal(map[string]bool{kustomization.GetID(): true}))
+	})
+}
+
+func TestIndexChanges(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	dir := t.TempDir()
+
+	s, err := NewStore(StorageBackendSQLite, dir, logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	idx, err := NewIndexer(s, dir, logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	newKustomization := func(name string) models.Object {
+		return models.Object{
+			Cluster:    "management",
+			Namespace:  "flux-system",
+			APIGroup:   kustomizev1.GroupVersion.Group,
+			APIVersion: kustomizev1.GroupVersion.Version,
+			Kind:       kustomizev1.KustomizationKind,
+			Name:       name,
+			Category:   configuration.CategoryAutomation,
+		}
+	}
+	updated := newKustomization("updated")
+	deleted := newKustomization("deleted")
+	added := newKustomization("added")
+	recreated := newKustomization("recreated")
+
+	g.Expect(s.StoreObjects(ctx, []models.Object{updated, deleted, recreated})).To(Succeed())
+	g.Expect(idx.Add(ctx, []models.Object{updated, deleted, recreated})).To(Succeed())
+
+	since := time.Now()
+
+	// another writer of the store changes the objects without indexing them
+	updated.Status = "Failed"
+	g.Expect(s.StoreObjects(ctx, []models.Object{updated, added})).To(Succeed())
+	g.Expect(s.DeleteObjects(ctx, []models.Object{deleted, recreated})).To(Succeed())
+	g.Expect(s.StoreObjects(ctx, []models.Object{recreated})).To(Succeed())
+
+	t.Run("indexes the objects written and removes the ones deleted", func(t *testing.T) {
+		indexed, removed, err := idx.IndexChanges(ctx, since)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(indexed).To(ConsistOf(updated.GetID(), added.GetID(), recreated.GetID()))
+		g.Expect(removed).To(Equal([]string{deleted.GetID()}))
+
+		iter, err := idx.Search(ctx, query{}, nil)
+		g.Expect(err).NotTo(HaveOccurred())
+		objects, err := iter.All()
+		g.Expect(err).NotTo(HaveOccurred())
+
+		statuses := map[string]string{}
+		for _, obj := range objects {
+			statuses[obj.Name] = obj.Status
+		}
+		g.Expect(statuses).To(Equal(map[string]string{"updated": "Failed", "added": "", "recreated": ""}))
+	})
+
+	t.Run("only indexes the changes since a time", func(t *testing.T) {
+		indexed, removed, err := idx.IndexChanges(ctx, time.Now())
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(indexed).To(BeEmpty())
+		g.Expect(removed).To(BeEmpty())
+	})
+}
+
+func TestNewIndexer_CorruptIndex(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	dir := t.TempDir()
+
+	s, err := NewStore(StorageBackendSQLite, dir, logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	obj := models.Object{
+		Cluster:    "management",
+		Namespace:  "flux-system",
+		APIGroup:   kustomizev1.GroupVersion.Group,
+		APIVersion: kustomizev1.GroupVersion.Version,
+		Kind:       kustomizev1.KustomizationKind,
+		Name:       "flux-system",
+		Category:   configuration.CategoryAutomation,
+	}
+	g.Expect(s.StoreObjects(ctx, []models.Object{obj})).To(Succeed())
+
+	g.Expect(os.MkdirAll(filepath.Join(dir, indexFile), 0755)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(dir, indexFile, "index_meta.json"), []byte("not an index"), 0644)).To(Succeed())
+
+	idx, err := NewIndexer(s, dir, logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(EnsureIndex(ctx, idx, logr.Discard())).To(Succeed())
+
+	consistency, err := idx.CheckConsistency(ctx)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(consistency.Consistent()).To(BeTrue())
+	g.Expect(consistency.IndexCount).To(Equal(1))
+}
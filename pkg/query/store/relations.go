@@ -0,0 +1,106 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+	"gorm.io/gorm"
+)
+
+// createObjectRelationIndexes indexes both ends of the relations, as relations are looked up from and to
+// an object. The indexes span the columns of the embedded nodes, which gorm tags cannot express.
+func createObjectRelationIndexes(db *gorm.DB) error {
+	for _, end := range []string{"from", "to"} {
+		statement := fmt.Sprintf("CREATE INDEX IF NOT EXISTS idx_object_relations_%[1]s ON object_relations (%[1]s_cluster, %[1]s_namespace, %[1]s_api_group, %[1]s_kind, %[1]s_name)", end)
+		if err := db.Exec(statement).Error; err != nil {
+			return fmt.Errorf("failed to create object relations index: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// replaceObjectRelations replaces the relations collected from the objects with their current ones.
+// When an object is twice in the batch, the relations of its last version are kept.
+func replaceObjectRelations(db *gorm.DB, objects []models.Object) error {
+	ids := []string{}
+	latest := map[string]models.Object{}
+	for _, obj := range objects {
+		id := obj.GetID()
+		if _, ok := latest[id]; !ok {
+			ids = append(ids, id)
+		}
+		latest[id] = obj
+	}
+
+	if err := deleteObjectRelations(db, db.Where("object_id IN ?", ids)); err != nil {
+		return err
+	}
+
+	relations := []models.ObjectRelation{}
+	for _, id := range ids {
+		relations = append(relations, latest[id].Relations...)
+	}
+
+	if len(relations) == 0 {
+		return nil
+	}
+
+	if result := db.Create(&relations); result.Error != nil {
+		return fmt.Errorf("failed to store object relations: %w", result.Error)
+	}
+
+	return nil
+}
+
+func deleteObjectRelations(db *gorm.DB, where *gorm.DB) error {
+	if result := db.Delete(&models.ObjectRelation{}, where); result.Error != nil {
+		return fmt.Errorf("failed to delete object relations: %w", result.Error)
+	}
+
+	return nil
+}
+
+func (i *SQLiteStore) GetObjectRelations(ctx context.Context, node models.ObjectNode) ([]models.ObjectRelation, error) {
+	relations := []models.ObjectRelation{}
+
+	from := i.db.Where("from_cluster = ? AND from_namespace = ? AND from_api_group = ? AND from_kind = ? AND from_name = ?",
+		node.Cluster, node.Namespace, node.APIGroup, node.Kind, node.Name)
+	to := i.db.Where("to_cluster = ? AND to_namespace = ? AND to_api_group = ? AND to_kind = ? AND to_name = ?",
+		node.Cluster, node.Namespace, node.APIGroup, node.Kind, node.Name)
+
+	result := i.db.WithContext(ctx).Model(&models.ObjectRelation{}).Where(from).Or(to).Order("id").Find(&relations)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to get object relations: %w", result.Error)
+	}
+
+	return relations, nil
+}
+
+func (i *SQLiteStore) GetObjectsByNode(ctx context.Context, node models.ObjectNode) ([]models.Object, error) {
+	objects := []models.Object{}
+
+	result := i.db.WithContext(ctx).Model(&models.Object{}).
+		Where("cluster = ? AND namespace = ? AND api_group = ? AND kind = ? AND name = ?", node.Cluster, node.Namespace, node.APIGroup, node.Kind, node.Name).
+		Find(&objects)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to get objects: %w", result.Error)
+	}
+
+	return objects, nil
+}
+
+func (i *SQLiteStore) GetObjectsByName(ctx context.Context, kind, namespace, name string) ([]models.Object, error) {
+	objects := []models.Object{}
+
+	result := i.db.WithContext(ctx).Model(&models.Object{}).
+		Where("kind = ? AND namespace = ? AND name = ?", kind, namespace, name).
+		Order("cluster, id").
+		Find(&objects)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to get objects: %w", result.Error)
+	}
+
+	return objects, nil
+}
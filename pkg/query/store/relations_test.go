@@ -0,0 +1,131 @@
+package store
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/configuration"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+)
+
+func TestSQLiteStore_ObjectRelations(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+	store, _ := createStore(t)
+
+	repository := models.Object{
+		Cluster:    "test-cluster",
+		Name:       "flux-system",
+		Namespace:  "flux-system",
+		Kind:       "GitRepository",
+		APIGroup:   "source.toolkit.fluxcd.io",
+		APIVersion: "v1",
+		Category:   configuration.CategorySource,
+	}
+
+	newKustomization := func(refs ...configuration.ObjectReference) models.Object {
+		obj := models.Object{
+			Cluster:    "test-cluster",
+			Name:       "apps",
+			Namespace:  "flux-system",
+			Kind:       "Kustomization",
+			APIGroup:   "kustomize.toolkit.fluxcd.io",
+			APIVersion: "v1",
+			Category:   configuration.CategoryAutomation,
+		}
+		for _, ref := range refs {
+			obj.Relations = append(obj.Relations, models.NewObjectRelation(obj, ref))
+		}
+		return obj
+	}
+
+	source := configuration.ObjectReference{Namespace: "flux-system", APIGroup: "source.toolkit.fluxcd.io", Kind: "GitRepository", Name: "flux-system", Direction: configuration.RelationUpstream}
+	podinfo := configuration.ObjectReference{Namespace: "apps", APIGroup: "apps", Kind: "Deployment", Name: "podinfo", Direction: configuration.RelationDownstream}
+	redis := configuration.ObjectReference{Namespace: "apps", APIGroup: "apps", Kind: "Deployment", Name: "redis", Direction: configuration.RelationDownstream}
+
+	kustomization := newKustomization(source, podinfo)
+	g.Expect(store.StoreObjects(ctx, []models.Object{repository, kustomization})).To(Succeed())
+
+	names := func(relations []models.ObjectRelation) []string {
+		result := []string{}
+		for _, r := range relations {
+			result = append(result, r.From.Name+"->"+r.To.Name)
+		}
+		return result
+	}
+
+	t.Run("gets the relations from and to an object", func(t *testing.T) {
+		relations, err := store.GetObjectRelations(ctx, models.NewObjectNode(kustomization))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(names(relations)).To(ConsistOf("flux-system->apps", "apps->podinfo"))
+
+		relations, err = store.GetObjectRelations(ctx, models.NewObjectNode(repository))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(names(relations)).To(ConsistOf("flux-system->apps"))
+	})
+
+	t.Run("gets the objects of a node in any version", func(t *testing.T) {
+		node := models.NewObjectNode(repository)
+		objects, err := store.GetObjectsByNode(ctx, node)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(objects).To(HaveLen(1))
+		g.Expect(objects[0].APIVersion).To(Equal("v1"))
+
+		node.Name = "missing"
+		objects, err = store.GetObjectsByNode(ctx, node)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(objects).To(BeEmpty())
+	})
+
+	t.Run("gets the objects with a name in every cluster", func(t *testing.T) {
+		other := repository
+		other.Cluster = "another-cluster"
+		g.Expect(store.StoreObjects(ctx, []models.Object{other})).To(Succeed())
+
+		objects, err := store.GetObjectsByName(ctx, "GitRepository", "flux-system", "flux-system")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(objects).To(HaveLen(2))
+		g.Expect(objects[0].Cluster).To(Equal("another-cluster"))
+		g.Expect(objects[1].Cluster).To(Equal("test-cluster"))
+
+		g.Expect(store.DeleteObjects(ctx, []models.Object{other})).To(Succeed())
+	})
+
+	t.Run("replaces the relations of an updated object", func(t *testing.T) {
+		g.Expect(store.StoreObjects(ctx, []models.Object{newKustomization(source, redis)})).To(Succeed())
+
+		relations, err := store.GetObjectRelations(ctx, models.NewObjectNode(kustomization))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(names(relations)).To(ConsistOf("flux-system->apps", "apps->redis"))
+	})
+
+	t.Run("deletes the relations of a deleted object", func(t *testing.T) {
+		g.Expect(store.DeleteObjects(ctx, []models.Object{kustomization})).To(Succeed())
+
+		relations, err := store.GetObjectRelations(ctx, models.NewObjectNode(repository))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(relations).To(BeEmpty())
+	})
+
+	t.Run("deletes the relations of a cluster", func(t *testing.T) {
+		g.Expect(store.StoreObjects(ctx, []models.Object{kustomization})).To(Succeed())
+		g.Expect(store.DeleteAllObjects(ctx, []string{"test-cluster"})).To(Succeed())
+
+		relations, err := store.GetObjectRelations(ctx, models.NewObjectNode(kustomization))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(relations).To(BeEmpty())
+	})
+}
+
+func TestSQLiteStore_ObjectRelationIndexes(t *testing.T) {
+	g := NewGomegaWithT(t)
+	_, db := createStore(t)
+
+	var indexes []string
+	g.Expect(db.Raw("SELECT name FROM sqlite_master WHERE type = 'index' AND tbl_name = 'object_relations'").Scan(&indexes).Error).To(Succeed())
+	g.Expect(indexes).To(ContainElements("idx_object_relations_from", "idx_object_relations_to"))
+
+	// migrating an existing database keeps the indexes
+	g.Expect(createObjectRelationIndexes(db)).To(Succeed())
+}
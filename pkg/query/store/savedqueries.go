@@ -0,0 +1,67 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ErrSavedQueryNotFound is returned when getting a saved query that is not stored.
+var ErrSavedQueryNotFound = errors.New("saved query not found")
+
+func (i *SQLiteStore) StoreSavedQuery(ctx context.Context, query models.SavedQuery) error {
+	if err := query.Validate(); err != nil {
+		return fmt.Errorf("invalid saved query: %w", err)
+	}
+
+	clauses := i.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{
+			{Name: "id"},
+		},
+		// the creation time is kept when the query is updated
+		DoUpdates: clause.AssignmentColumns([]string{"name", "terms", "filters", "order_by", "descending", "owner", "visibility", "group_name", "updated_at"}),
+	})
+
+	if result := clauses.Create(&query); result.Error != nil {
+		return fmt.Errorf("failed to store saved query: %w", result.Error)
+	}
+	i.debug.Info("saved query stored", "savedQuery", query.ID)
+
+	return nil
+}
+
+func (i *SQLiteStore) DeleteSavedQuery(ctx context.Context, id string) error {
+	if result := i.db.WithContext(ctx).Where("id = ?", id).Delete(&models.SavedQuery{}); result.Error != nil {
+		return fmt.Errorf("failed to delete saved query: %w", result.Error)
+	}
+
+	return nil
+}
+
+func (i *SQLiteStore) GetSavedQuery(ctx context.Context, id string) (models.SavedQuery, error) {
+	query := models.SavedQuery{}
+
+	result := i.db.WithContext(ctx).Where("id = ?", id).First(&query)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return models.SavedQuery{}, fmt.Errorf("%w: %s", ErrSavedQueryNotFound, id)
+	}
+	if result.Error != nil {
+		return models.SavedQuery{}, fmt.Errorf("failed to get saved query: %s with error: %w", id, result.Error)
+	}
+
+	return query, nil
+}
+
+func (i *SQLiteStore) GetSavedQueries(ctx context.Context) ([]models.SavedQuery, error) {
+	queries := []models.SavedQuery{}
+
+	if result := i.db.WithContext(ctx).Order("name").Find(&queries); result.Error != nil {
+		return nil, fmt.Errorf("failed to get saved queries: %w", result.Error)
+	}
+
+	return queries, nil
+}
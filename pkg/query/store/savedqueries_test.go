@@ -0,0 +1,69 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+)
+
+func TestSQLiteStore_SavedQueries(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+	store, _ := createStore(t)
+
+	created := time.Date(2023, 9, 1, 10, 0, 0, 0, time.UTC)
+	query := models.SavedQuery{
+		ID:         "5b6f7a2e-5a47-4f4b-8f7c-1c2d3e4f5a6b",
+		Name:       "Failing releases",
+		Filters:    []string{"kind:HelmRelease", "status:Failed"},
+		OrderBy:    "name",
+		Owner:      "alice",
+		Visibility: models.SavedQueryGroup,
+		Group:      "team-a",
+		CreatedAt:  created,
+		UpdatedAt:  created,
+	}
+
+	t.Run("stores a saved query", func(t *testing.T) {
+		g.Expect(store.StoreSavedQuery(ctx, query)).To(Succeed())
+
+		stored, err := store.GetSavedQuery(ctx, query.ID)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(stored.Name).To(Equal("Failing releases"))
+		g.Expect(stored.Filters).To(Equal([]string{"kind:HelmRelease", "status:Failed"}))
+		g.Expect(stored.Group).To(Equal("team-a"))
+	})
+
+	t.Run("updates a saved query and keeps its creation time", func(t *testing.T) {
+		updated := query
+		updated.Name = "Failed releases"
+		updated.Filters = []string{"status:Failed"}
+		updated.CreatedAt = created.Add(time.Hour)
+		updated.UpdatedAt = created.Add(time.Hour)
+		g.Expect(store.StoreSavedQuery(ctx, updated)).To(Succeed())
+
+		queries, err := store.GetSavedQueries(ctx)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(queries).To(HaveLen(1))
+		g.Expect(queries[0].Name).To(Equal("Failed releases"))
+		g.Expect(queries[0].Filters).To(Equal([]string{"status:Failed"}))
+		g.Expect(queries[0].CreatedAt).To(BeTemporally("==", created))
+		g.Expect(queries[0].UpdatedAt).To(BeTemporally("==", created.Add(time.Hour)))
+	})
+
+	t.Run("rejects invalid saved queries", func(t *testing.T) {
+		invalid := query
+		invalid.Group = ""
+		g.Expect(store.StoreSavedQuery(ctx, invalid)).To(MatchError(ContainSubstring("missing group")))
+	})
+
+	t.Run("deletes a saved query", func(t *testing.T) {
+		g.Expect(store.DeleteSavedQuery(ctx, query.ID)).To(Succeed())
+
+		_, err := store.GetSavedQuery(ctx, query.ID)
+		g.Expect(err).To(MatchError(ErrSavedQueryNotFound))
+	})
+}
@@ -73,13 +73,21 @@ func (i *SQLiteStore) DeleteAllObjects(ctx context.Context, clusters []string) (
 	defer recordMetrics(metrics.DeleteAllObjectsAction, time.Now(), err)
 
 	for _, cluster := range clusters {
-		where := i.db.Where(
-			"cluster = ? ",
-			cluster,
-		)
-		result := i.db.Unscoped().Delete(&models.Object{}, where)
-		if result.Error != nil {
-			return fmt.Errorf("failed to delete all objects: %w", result.Error)
+		err := i.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			// the transitions are copied from the objects, that are not loaded for it
+			if err := storeDeletedClusterTransitions(tx, cluster, time.Now()); err != nil {
+				return err
+			}
+
+			result := tx.Unscoped().Delete(&models.Object{}, "cluster = ?", cluster)
+			if result.Error != nil {
+				return fmt.Errorf("failed to delete all objects: %w", result.Error)
+			}
+
+			return deleteObjectRelations(tx, tx.Where("from_cluster = ?", cluster))
+		})
+		if err != nil {
+			return err
 		}
 	}
 
@@ -199,20 +207,42 @@ func (i *SQLiteStore) StoreObjects(ctx context.Context, objects []models.Object)
 		i.debug.Info("storing object", "object", object.GetID())
 	}
 
-	clauses := i.db.Clauses(clause.OnConflict{
-		Columns: []clause.Column{
-			{Name: "id"},
-		},
-		UpdateAll: true,
-	})
+	return i.storeObjectsTx(ctx, rows)
+}
 
-	result := clauses.Create(rows)
-	if result.Error != nil {
-		return fmt.Errorf("failed to store object: %w", result.Error)
-	}
+// storeObjectsTx upserts the rows, records the status transitions and replaces the relations
+// of the objects in one transaction. It is shared by the SQLite and Postgres stores, which only
+// differ in how they prepare the rows.
+func (i *SQLiteStore) storeObjectsTx(ctx context.Context, rows []models.Object) error {
+	return i.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		transitions, err := changedObjectTransitions(tx, rows, time.Now())
+		if err != nil {
+			return err
+		}
 
-	i.debug.Info("objects stored", "rows-affected", result.RowsAffected)
-	return nil
+		clauses := tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{
+				{Name: "id"},
+			},
+			UpdateAll: true,
+		})
+
+		result := clauses.Create(rows)
+		if result.Error != nil {
+			return fmt.Errorf("failed to store object: %w", result.Error)
+		}
+
+		if err := storeObjectTransitions(tx, transitions); err != nil {
+			return err
+		}
+
+		if err := replaceObjectRelations(tx, rows); err != nil {
+			return err
+		}
+
+		i.debug.Info("objects stored", "rows-affected", result.RowsAffected, "transitions", len(transitions))
+		return nil
+	})
 }
 
 func (i *SQLiteStore) StoreTenants(ctx context.Context, tenants []models.Tenant) (err error) {
@@ -317,6 +347,36 @@ func (i *SQLiteStore) GetAllObjects(ctx context.Context) (Iterator, error) {
 	return sqliterator.New(result)
 }
 
+func (i *SQLiteStore) GetObjectChanges(ctx context.Context, since time.Time) (Iterator, []string, error) {
+	// deleted objects are only known by their transitions
+	deleted := []string{}
+	result := i.db.WithContext(ctx).Model(&models.ObjectTransition{}).
+		Distinct("object_id").
+		Where("deleted = ? AND timestamp >= ?", true, since).
+		Where("object_id NOT IN (?)", i.db.Model(&models.Object{}).Select("id")).
+		Pluck("object_id", &deleted)
+	if result.Error != nil {
+		return nil, nil, fmt.Errorf("failed to get deleted objects: %w", result.Error)
+	}
+
+	iter, err := sqliterator.New(i.db.WithContext(ctx).Model(&models.Object{}).Where("updated_at >= ?", since))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get objects: %w", err)
+	}
+
+	return iter, deleted, nil
+}
+
+func (i *SQLiteStore) GetInventoryObjects(ctx context.Context) (Iterator, error) {
+	iter, err := sqliterator.New(i.db.WithContext(ctx).Model(&models.Object{}).
+		Select("cluster, namespace, api_group, api_version, kind, name, status"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get objects: %w", err)
+	}
+
+	return iter, nil
+}
+
 func (i *SQLiteStore) GetRoles(ctx context.Context) (roles []models.Role, err error) {
 	// metrics
 	metrics.DataStoreInflightRequests(metrics.GetRolesAction, 1)
@@ -378,6 +438,30 @@ func (i *SQLiteStore) GetTenants(ctx context.Context) (tenants []models.Tenant,
 	return tenants, nil
 }
 
+func (i *SQLiteStore) CountObjectsByCluster(ctx context.Context) (counts map[string]int, err error) {
+	// metrics
+	metrics.DataStoreInflightRequests(metrics.CountObjectsAction, 1)
+	defer recordMetrics(metrics.CountObjectsAction, time.Now(), err)
+
+	rows := []struct {
+		Cluster string
+		Count   int
+	}{}
+	result := i.db.WithContext(ctx).Model(&models.Object{}).
+		Select("cluster, count(*) as count").
+		Group("cluster").
+		Scan(&rows)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to count objects: %w", result.Error)
+	}
+
+	counts = map[string]int{}
+	for _, row := range rows {
+		counts[row.Cluster] = row.Count
+	}
+	return counts, nil
+}
+
 func (i *SQLiteStore) DeleteObjects(ctx context.Context, objects []models.Object) (err error) {
 	// metrics
 	metrics.DataStoreInflightRequests(metrics.DeleteObjectsAction, 1)
@@ -387,18 +471,30 @@ func (i *SQLiteStore) DeleteObjects(ctx context.Context, objects []models.Object
 		if err := object.Validate(); err != nil {
 			return fmt.Errorf("invalid object: %w", err)
 		}
+	}
 
-		where := i.db.Where(
-			"id = ? ",
-			object.GetID(),
-		)
-		result := i.db.Unscoped().Delete(&models.Object{}, where)
-		if result.Error != nil {
-			return fmt.Errorf("failed to delete object: %w", result.Error)
+	// the objects, their transitions and their relations are deleted together
+	return i.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		for _, object := range objects {
+			result := tx.Unscoped().Delete(&models.Object{}, "id = ?", object.GetID())
+			if result.Error != nil {
+				return fmt.Errorf("failed to delete object: %w", result.Error)
+			}
+
+			if result.RowsAffected > 0 {
+				if err := storeObjectTransitions(tx, deletedObjectTransitions([]models.Object{object}, now)); err != nil {
+					return err
+				}
+			}
+
+			if err := deleteObjectRelations(tx, tx.Where("object_id = ?", object.GetID())); err != nil {
+				return err
+			}
 		}
-	}
 
-	return nil
+		return nil
+	})
 }
 
 func (i *SQLiteStore) DeleteRoles(ctx context.Context, roles []models.Role) (err error) {
@@ -491,7 +587,11 @@ func CreateSQLiteDB(path string) (*gorm.DB, error) {
 	// From the readme: https://github.com/mattn/go-sqlite3
 	goDB.SetMaxOpenConns(1)
 
-	if err := db.AutoMigrate(&models.Object{}, &models.Role{}, &models.Subject{}, &models.RoleBinding{}, &models.PolicyRule{}, &models.Tenant{}); err != nil {
+	if err := db.AutoMigrate(&models.Object{}, &models.Role{}, &models.Subject{}, &models.RoleBinding{}, &models.PolicyRule{}, &models.Tenant{}, &models.ObjectTransition{}, &models.ObjectRelation{}, &models.SavedQuery{}, &models.AlertRule{}, &models.AlertRuleState{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+
+	if err := createObjectRelationIndexes(db); err != nil {
 		return nil, fmt.Errorf("failed to migrate database: %w", err)
 	}
 
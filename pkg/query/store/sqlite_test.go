@@ -95,8 +95,6 @@ func TestSQLiteStore_StoreObjects(t *testing.T) {
 	g := NewGomegaWithT(t)
 	ctx := context.Background()
 	store, db := createStore(t)
-	sqlDB, err := db.DB()
-	g.Expect(err).To(BeNil())
 
 	tests := []struct {
 		name       string
@@ -157,7 +155,7 @@ func TestSQLiteStore_StoreObjects(t *testing.T) {
 			}
 			g.Expect(err).To(BeNil())
 			var storedObjectsNum int
-			g.Expect(sqlDB.QueryRow("SELECT COUNT(id) FROM objects").Scan(&storedObjectsNum)).To(Succeed())
+			g.Expect(db.Raw("SELECT COUNT(id) FROM objects").Row().Scan(&storedObjectsNum)).To(Succeed())
 			g.Expect(storedObjectsNum == len(tt.objects)).To(BeTrue())
 		})
 	}
@@ -352,8 +350,6 @@ func TestSQLiteStore_DeleteAllObjects(t *testing.T) {
 	g := NewGomegaWithT(t)
 	ctx := context.Background()
 	store, db := createStore(t)
-	sqlDB, err := db.DB()
-	g.Expect(err).To(BeNil())
 
 	tests := []struct {
 		name           string
@@ -420,19 +416,46 @@ func TestSQLiteStore_DeleteAllObjects(t *testing.T) {
 			g.Expect(err).To(BeNil())
 			for _, deleteCluster := range tt.deleteClusters {
 				var numResources int
-				g.Expect(sqlDB.QueryRow("SELECT COUNT(id) FROM objects WHERE cluster = ?", deleteCluster).Scan(&numResources)).To(Succeed())
+				g.Expect(db.Raw("SELECT COUNT(id) FROM objects WHERE cluster = ?", deleteCluster).Row().Scan(&numResources)).To(Succeed())
 				g.Expect(numResources).To(Equal(0))
 			}
 		})
 	}
 }
 
+func TestSQLiteStore_CountObjectsByCluster(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+	store, _ := createStore(t)
+
+	object := func(cluster, name string) models.Object {
+		return models.Object{
+			Cluster:    cluster,
+			Name:       name,
+			Namespace:  "namespace",
+			Kind:       "ValidKind",
+			APIGroup:   "example.com",
+			APIVersion: "v1",
+			Category:   configuration.CategoryAutomation,
+		}
+	}
+
+	g.Expect(store.StoreObjects(ctx, []models.Object{
+		object("management", "obj-1"),
+		object("management", "obj-2"),
+		object("leaf", "obj-1"),
+	})).To(Succeed())
+	g.Expect(store.DeleteObjects(ctx, []models.Object{object("management", "obj-2")})).To(Succeed())
+
+	counts, err := store.CountObjectsByCluster(ctx)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(counts).To(Equal(map[string]int{"management": 1, "leaf": 1}))
+}
+
 func TestSQLiteStore_DeleteAllRoles(t *testing.T) {
 	g := NewGomegaWithT(t)
 	ctx := context.Background()
 	store, db := createStore(t)
-	sqlDB, err := db.DB()
-	g.Expect(err).To(BeNil())
 
 	tests := []struct {
 		name           string
@@ -505,7 +528,7 @@ func TestSQLiteStore_DeleteAllRoles(t *testing.T) {
 			g.Expect(err).To(BeNil())
 			for _, deleteCluster := range tt.deleteClusters {
 				var numResources int
-				g.Expect(sqlDB.QueryRow("SELECT COUNT(id) FROM roles WHERE cluster = ?", deleteCluster).Scan(&numResources)).To(Succeed())
+				g.Expect(db.Raw("SELECT COUNT(id) FROM roles WHERE cluster = ?", deleteCluster).Row().Scan(&numResources)).To(Succeed())
 				g.Expect(numResources).To(Equal(0))
 			}
 		})
@@ -516,8 +539,6 @@ func TestSQLiteStore_DeleteRoles(t *testing.T) {
 	g := NewGomegaWithT(t)
 	ctx := context.Background()
 	store, db := createStore(t)
-	sqlDB, err := db.DB()
-	g.Expect(err).To(BeNil())
 
 	tests := []struct {
 		name                 string
@@ -631,7 +652,7 @@ func TestSQLiteStore_DeleteRoles(t *testing.T) {
 			g.Expect(err).To(BeNil())
 			for _, deleteCluster := range tt.deleteClusters {
 				var numResources int
-				g.Expect(sqlDB.QueryRow("SELECT COUNT(id) FROM roles WHERE cluster = ?", deleteCluster).Scan(&numResources)).To(Succeed())
+				g.Expect(db.Raw("SELECT COUNT(id) FROM roles WHERE cluster = ?", deleteCluster).Row().Scan(&numResources)).To(Succeed())
 				g.Expect(numResources).To(Equal(tt.expectedNumResources))
 			}
 		})
@@ -642,8 +663,6 @@ func TestSQLiteStore_DeleteAllRoleBindings(t *testing.T) {
 	g := NewGomegaWithT(t)
 	ctx := context.Background()
 	store, db := createStore(t)
-	sqlDB, err := db.DB()
-	g.Expect(err).To(BeNil())
 
 	tests := []struct {
 		name              string
@@ -719,7 +738,7 @@ func TestSQLiteStore_DeleteAllRoleBindings(t *testing.T) {
 			g.Expect(err).To(BeNil())
 			for _, deleteCluster := range tt.deleteClusters {
 				var numResources int
-				g.Expect(sqlDB.QueryRow("SELECT COUNT(id) FROM role_bindings WHERE cluster = ?", deleteCluster).Scan(&numResources)).To(Succeed())
+				g.Expect(db.Raw("SELECT COUNT(id) FROM role_bindings WHERE cluster = ?", deleteCluster).Row().Scan(&numResources)).To(Succeed())
 				g.Expect(numResources).To(Equal(0))
 			}
 		})
@@ -778,16 +797,13 @@ func TestUpsertRoleWithPolicyRules(t *testing.T) {
 	g.Expect(store.StoreRoles(ctx, []models.Role{role})).To(Succeed())
 	g.Expect(store.StoreRoleBindings(ctx, []models.RoleBinding{rb})).To(Succeed())
 
-	sqlDB, err := db.DB()
-	g.Expect(err).To(BeNil())
-
 	var roleID string
 
-	g.Expect(sqlDB.QueryRow("SELECT id FROM roles").Scan(&roleID)).To(Succeed())
+	g.Expect(db.Raw("SELECT id FROM roles").Row().Scan(&roleID)).To(Succeed())
 
 	var id int64
 	var resources1 string
-	g.Expect(sqlDB.QueryRow("SELECT id, resources FROM policy_rules WHERE role_id = ?", roleID).Scan(&id, &resources1)).To(Succeed())
+	g.Expect(db.Raw("SELECT id, resources FROM policy_rules WHERE role_id = ?", roleID).Row().Scan(&id, &resources1)).To(Succeed())
 
 	g.Expect(resources1).To(Equal("helmreleases"))
 
@@ -813,12 +829,12 @@ func TestUpsertRoleWithPolicyRules(t *testing.T) {
 	g.Expect(store.StoreRoles(ctx, []models.Role{role})).To(Succeed())
 
 	var count1 int64
-	g.Expect(sqlDB.QueryRow("SELECT COUNT(*) FROM policy_rules WHERE role_id = ?", roleID).Scan(&count1)).To(Succeed())
+	g.Expect(db.Raw("SELECT COUNT(*) FROM policy_rules WHERE role_id = ?", roleID).Row().Scan(&count1)).To(Succeed())
 	g.Expect(count1).To(Equal(int64(1)))
 
 	var resources2 string
 
-	g.Expect(sqlDB.QueryRow("SELECT resources FROM policy_rules WHERE role_id = ?", roleID).Scan(&resources2)).To(Succeed())
+	g.Expect(db.Raw("SELECT resources FROM policy_rules WHERE role_id = ?", roleID).Row().Scan(&resources2)).To(Succeed())
 
 	g.Expect(resources2).To(Equal(""))
 
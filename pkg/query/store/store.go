@@ -3,10 +3,12 @@ package store
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
 	"gorm.io/gorm"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/kubectl/pkg/util/slice"
 )
 
@@ -33,6 +35,18 @@ type StoreWriter interface {
 	DeleteRoleBindings(ctx context.Context, roleBindings []models.RoleBinding) error
 	DeleteAllRoleBindings(ctx context.Context, clusters []string) error
 	DeleteTenants(ctx context.Context, tenants []models.Tenant) error
+	// DeleteObjectTransitions deletes the transitions of the objects of a kind recorded before a time.
+	// The latest transition of an object that is not deleted is kept.
+	DeleteObjectTransitions(ctx context.Context, gvk schema.GroupVersionKind, before time.Time) error
+	// StoreSavedQuery creates or updates a saved query.
+	StoreSavedQuery(ctx context.Context, query models.SavedQuery) error
+	DeleteSavedQuery(ctx context.Context, id string) error
+	// StoreAlertRule creates or updates an alert rule.
+	StoreAlertRule(ctx context.Context, rule models.AlertRule) error
+	// DeleteAlertRule deletes an alert rule and its state.
+	DeleteAlertRule(ctx context.Context, id string) error
+	// StoreAlertRuleState creates or updates the state of an alert rule.
+	StoreAlertRuleState(ctx context.Context, state models.AlertRuleState) error
 }
 
 type QueryOperand string
@@ -58,6 +72,35 @@ type Query interface {
 	GetFilters() []string
 }
 
+// IDsQuery is a Query whose results are restricted to the objects with the given ids.
+type IDsQuery interface {
+	Query
+	GetIDs() []string
+}
+
+// WithIDs restricts the results of a query to the objects with the given ids, like
+// the objects changed since the query last ran.
+func WithIDs(q Query, ids []string) Query {
+	return idsQuery{Query: q, ids: ids}
+}
+
+type idsQuery struct {
+	Query
+	ids []string
+}
+
+func (q idsQuery) GetIDs() []string {
+	return q.ids
+}
+
+// GetFilterGroups keeps the filter groups of a StructuredQuery, which would be hidden by the wrapper.
+func (q idsQuery) GetFilterGroups() []FilterGroup {
+	if sq, ok := q.Query.(StructuredQuery); ok {
+		return sq.GetFilterGroups()
+	}
+	return nil
+}
+
 type QueryOption interface {
 	GetLimit() int32
 	GetOffset() int32
@@ -72,10 +115,36 @@ type StoreReader interface {
 	GetObjectByID(ctx context.Context, id string) (models.Object, error)
 	GetObjects(ctx context.Context, ids []string, opts QueryOption) (Iterator, error)
 	GetAllObjects(ctx context.Context) (Iterator, error)
+	// GetObjectChanges returns the objects written since a time, and the ids of the objects deleted since then
+	// that have not been written again.
+	GetObjectChanges(ctx context.Context, since time.Time) (Iterator, []string, error)
+	// GetInventoryObjects returns every object with only the fields the inventory counts them by,
+	// without their payload.
+	GetInventoryObjects(ctx context.Context) (Iterator, error)
 	GetRoles(ctx context.Context) ([]models.Role, error)
 	GetRoleBindings(ctx context.Context) ([]models.RoleBinding, error)
 	GetAccessRules(ctx context.Context) ([]models.AccessRule, error)
 	GetTenants(ctx context.Context) ([]models.Tenant, error)
+	// CountObjectsByCluster returns the number of objects of each cluster.
+	CountObjectsByCluster(ctx context.Context) (map[string]int, error)
+	// GetObjectTransitions returns the transitions of an object, oldest first.
+	GetObjectTransitions(ctx context.Context, id string) ([]models.ObjectTransition, error)
+	// GetObjectTransitionsAsOf returns the latest transition, up to a time, of every object that was not deleted then.
+	GetObjectTransitionsAsOf(ctx context.Context, asOf time.Time) ([]models.ObjectTransition, error)
+	// GetObjectRelations returns the relations from and to an object.
+	GetObjectRelations(ctx context.Context, node models.ObjectNode) ([]models.ObjectRelation, error)
+	// GetObjectsByNode returns the collected versions of an object, if any.
+	GetObjectsByNode(ctx context.Context, node models.ObjectNode) ([]models.Object, error)
+	// GetObjectsByName returns the objects of a kind with a namespace and name in every cluster, ordered by cluster.
+	GetObjectsByName(ctx context.Context, kind, namespace, name string) ([]models.Object, error)
+	// GetSavedQuery returns a saved query, or ErrSavedQueryNotFound.
+	GetSavedQuery(ctx context.Context, id string) (models.SavedQuery, error)
+	// GetSavedQueries returns every saved query, ordered by name.
+	GetSavedQueries(ctx context.Context) ([]models.SavedQuery, error)
+	// GetAlertRule returns an alert rule with its state, or ErrAlertRuleNotFound.
+	GetAlertRule(ctx context.Context, id string) (models.AlertRule, error)
+	// GetAlertRules returns every alert rule with its state, ordered by name.
+	GetAlertRules(ctx context.Context) ([]models.AlertRule, error)
 }
 
 // Iterator provides an iterable interface for requesting the next row of an object.
@@ -100,7 +169,8 @@ type Iterator interface {
 type StorageBackend string
 
 const (
-	StorageBackendSQLite StorageBackend = "sqlite"
+	StorageBackendSQLite   StorageBackend = "sqlite"
+	StorageBackendPostgres StorageBackend = "postgres"
 )
 
 // factory method that by default creates a in memory store
@@ -112,6 +182,12 @@ func NewStore(backend StorageBackend, uri string, log logr.Logger) (Store, error
 			return nil, fmt.Errorf("error creating sqlite db: %w", err)
 		}
 		return NewSQLiteStore(db, log)
+	case StorageBackendPostgres:
+		db, err := CreatePostgresDB(uri)
+		if err != nil {
+			return nil, fmt.Errorf("error creating postgres db: %w", err)
+		}
+		return NewPostgresStore(db, log)
 	default:
 		return nil, fmt.Errorf("unknown storage backend: %s", backend)
 	}
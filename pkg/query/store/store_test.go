@@ -414,7 +414,13 @@ func TestStoreUnstructured(t *testing.T) {
 	g.Expect(result).To(Equal(input))
 }
 
+// createStore returns a sqlite store, or a postgres store when EXPLORER_TEST_POSTGRES_URI is set,
+// so the same suite runs against both backends.
 func createStore(t *testing.T) (Store, *gorm.DB) {
+	if uri := os.Getenv(postgresTestURIEnv); uri != "" {
+		return createPostgresStore(t, uri)
+	}
+
 	g := NewGomegaWithT(t)
 	dbDir, err := os.MkdirTemp("", "db")
 	g.Expect(err).To(BeNil())
@@ -4,6 +4,7 @@ package storefakes
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/configuration"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
@@ -23,6 +24,35 @@ type FakeIndexer struct {
 	addReturnsOnCall map[int]struct {
 		result1 error
 	}
+	CheckConsistencyStub        func(context.Context) (store.IndexConsistency, error)
+	checkConsistencyMutex       sync.RWMutex
+	checkConsistencyArgsForCall []struct {
+		arg1 context.Context
+	}
+	checkConsistencyReturns struct {
+		result1 store.IndexConsistency
+		result2 error
+	}
+	checkConsistencyReturnsOnCall map[int]struct {
+		result1 store.IndexConsistency
+		result2 error
+	}
+	IndexChangesStub        func(context.Context, time.Time) ([]string, []string, error)
+	indexChangesMutex       sync.RWMutex
+	indexChangesArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+	}
+	indexChangesReturns struct {
+		result1 []string
+		result2 []string
+		result3 error
+	}
+	indexChangesReturnsOnCall map[int]struct {
+		result1 []string
+		result2 []string
+		result3 error
+	}
 	ListFacetsStub        func(context.Context, configuration.ObjectCategory) (store.Facets, error)
 	listFacetsMutex       sync.RWMutex
 	listFacetsArgsForCall []struct {
@@ -37,6 +67,19 @@ type FakeIndexer struct {
 		result1 store.Facets
 		result2 error
 	}
+	ReindexStub        func(context.Context) (int, error)
+	reindexMutex       sync.RWMutex
+	reindexArgsForCall []struct {
+		arg1 context.Context
+	}
+	reindexReturns struct {
+		result1 int
+		result2 error
+	}
+	reindexReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
 	RemoveStub        func(context.Context, []models.Object) error
 	removeMutex       sync.RWMutex
 	removeArgsForCall []struct {
@@ -147,6 +190,138 @@ func (fake *FakeIndexer) AddReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeIndexer) CheckConsistency(arg1 context.Context) (store.IndexConsistency, error) {
+	fake.checkConsistencyMutex.Lock()
+	ret, specificReturn := fake.checkConsistencyReturnsOnCall[len(fake.checkConsistencyArgsForCall)]
+	fake.checkConsistencyArgsForCall = append(fake.checkConsistencyArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.CheckConsistencyStub
+	fakeReturns := fake.checkConsistencyReturns
+	fake.recordInvocation("CheckConsistency", []interface{}{arg1})
+	fake.checkConsistencyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeIndexer) CheckConsistencyCallCount() int {
+	fake.checkConsistencyMutex.RLock()
+	defer fake.checkConsistencyMutex.RUnlock()
+	return len(fake.checkConsistencyArgsForCall)
+}
+
+func (fake *FakeIndexer) CheckConsistencyCalls(stub func(context.Context) (store.IndexConsistency, error)) {
+	fake.checkConsistencyMutex.Lock()
+	defer fake.checkConsistencyMutex.Unlock()
+	fake.CheckConsistencyStub = stub
+}
+
+func (fake *FakeIndexer) CheckConsistencyArgsForCall(i int) context.Context {
+	fake.checkConsistencyMutex.RLock()
+	defer fake.checkConsistencyMutex.RUnlock()
+	argsForCall := fake.checkConsistencyArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeIndexer) CheckConsistencyReturns(result1 store.IndexConsistency, result2 error) {
+	fake.checkConsistencyMutex.Lock()
+	defer fake.checkConsistencyMutex.Unlock()
+	fake.CheckConsistencyStub = nil
+	fake.checkConsistencyReturns = struct {
+		result1 store.IndexConsistency
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeIndexer) CheckConsistencyReturnsOnCall(i int, result1 store.IndexConsistency, result2 error) {
+	fake.checkConsistencyMutex.Lock()
+	defer fake.checkConsistencyMutex.Unlock()
+	fake.CheckConsistencyStub = nil
+	if fake.checkConsistencyReturnsOnCall == nil {
+		fake.checkConsistencyReturnsOnCall = make(map[int]struct {
+			result1 store.IndexConsistency
+			result2 error
+		})
+	}
+	fake.checkConsistencyReturnsOnCall[i] = struct {
+		result1 store.IndexConsistency
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeIndexer) IndexChanges(arg1 context.Context, arg2 time.Time) ([]string, []string, error) {
+	fake.indexChangesMutex.Lock()
+	ret, specificReturn := fake.indexChangesReturnsOnCall[len(fake.indexChangesArgsForCall)]
+	fake.indexChangesArgsForCall = append(fake.indexChangesArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.IndexChangesStub
+	fakeReturns := fake.indexChangesReturns
+	fake.recordInvocation("IndexChanges", []interface{}{arg1, arg2})
+	fake.indexChangesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeIndexer) IndexChangesCallCount() int {
+	fake.indexChangesMutex.RLock()
+	defer fake.indexChangesMutex.RUnlock()
+	return len(fake.indexChangesArgsForCall)
+}
+
+func (fake *FakeIndexer) IndexChangesCalls(stub func(context.Context, time.Time) ([]string, []string, error)) {
+	fake.indexChangesMutex.Lock()
+	defer fake.indexChangesMutex.Unlock()
+	fake.IndexChangesStub = stub
+}
+
+func (fake *FakeIndexer) IndexChangesArgsForCall(i int) (context.Context, time.Time) {
+	fake.indexChangesMutex.RLock()
+	defer fake.indexChangesMutex.RUnlock()
+	argsForCall := fake.indexChangesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeIndexer) IndexChangesReturns(result1 []string, result2 []string, result3 error) {
+	fake.indexChangesMutex.Lock()
+	defer fake.indexChangesMutex.Unlock()
+	fake.IndexChangesStub = nil
+	fake.indexChangesReturns = struct {
+		result1 []string
+		result2 []string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeIndexer) IndexChangesReturnsOnCall(i int, result1 []string, result2 []string, result3 error) {
+	fake.indexChangesMutex.Lock()
+	defer fake.indexChangesMutex.Unlock()
+	fake.IndexChangesStub = nil
+	if fake.indexChangesReturnsOnCall == nil {
+		fake.indexChangesReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 []string
+			result3 error
+		})
+	}
+	fake.indexChangesReturnsOnCall[i] = struct {
+		result1 []string
+		result2 []string
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeIndexer) ListFacets(arg1 context.Context, arg2 configuration.ObjectCategory) (store.Facets, error) {
 	fake.listFacetsMutex.Lock()
 	ret, specificReturn := fake.listFacetsReturnsOnCall[len(fake.listFacetsArgsForCall)]
@@ -212,6 +387,70 @@ func (fake *FakeIndexer) ListFacetsReturnsOnCall(i int, result1 store.Facets, re
 	}{result1, result2}
 }
 
+func (fake *FakeIndexer) Reindex(arg1 context.Context) (int, error) {
+	fake.reindexMutex.Lock()
+	ret, specificReturn := fake.reindexReturnsOnCall[len(fake.reindexArgsForCall)]
+	fake.reindexArgsForCall = append(fake.reindexArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ReindexStub
+	fakeReturns := fake.reindexReturns
+	fake.recordInvocation("Reindex", []interface{}{arg1})
+	fake.reindexMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeIndexer) ReindexCallCount() int {
+	fake.reindexMutex.RLock()
+	defer fake.reindexMutex.RUnlock()
+	return len(fake.reindexArgsForCall)
+}
+
+func (fake *FakeIndexer) ReindexCalls(stub func(context.Context) (int, error)) {
+	fake.reindexMutex.Lock()
+	defer fake.reindexMutex.Unlock()
+	fake.ReindexStub = stub
+}
+
+func (fake *FakeIndexer) ReindexArgsForCall(i int) context.Context {
+	fake.reindexMutex.RLock()
+	defer fake.reindexMutex.RUnlock()
+	argsForCall := fake.reindexArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeIndexer) ReindexReturns(result1 int, result2 error) {
+	fake.reindexMutex.Lock()
+	defer fake.reindexMutex.Unlock()
+	fake.ReindexStub = nil
+	fake.reindexReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeIndexer) ReindexReturnsOnCall(i int, result1 int, result2 error) {
+	fake.reindexMutex.Lock()
+	defer fake.reindexMutex.Unlock()
+	fake.ReindexStub = nil
+	if fake.reindexReturnsOnCall == nil {
+		fake.reindexReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.reindexReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeIndexer) Remove(arg1 context.Context, arg2 []models.Object) error {
 	var arg2Copy []models.Object
 	if arg2 != nil {
@@ -412,8 +651,14 @@ func (fake *FakeIndexer) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.addMutex.RLock()
 	defer fake.addMutex.RUnlock()
+	fake.checkConsistencyMutex.RLock()
+	defer fake.checkConsistencyMutex.RUnlock()
+	fake.indexChangesMutex.RLock()
+	defer fake.indexChangesMutex.RUnlock()
 	fake.listFacetsMutex.RLock()
 	defer fake.listFacetsMutex.RUnlock()
+	fake.reindexMutex.RLock()
+	defer fake.reindexMutex.RUnlock()
 	fake.removeMutex.RLock()
 	defer fake.removeMutex.RUnlock()
 	fake.removeByQueryMutex.RLock()
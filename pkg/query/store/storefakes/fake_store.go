@@ -4,12 +4,39 @@ package storefakes
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/store"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 type FakeStore struct {
+	CountObjectsByClusterStub        func(context.Context) (map[string]int, error)
+	countObjectsByClusterMutex       sync.RWMutex
+	countObjectsByClusterArgsForCall []struct {
+		arg1 context.Context
+	}
+	countObjectsByClusterReturns struct {
+		result1 map[string]int
+		result2 error
+	}
+	countObjectsByClusterReturnsOnCall map[int]struct {
+		result1 map[string]int
+		result2 error
+	}
+	DeleteAlertRuleStub        func(context.Context, string) error
+	deleteAlertRuleMutex       sync.RWMutex
+	deleteAlertRuleArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteAlertRuleReturns struct {
+		result1 error
+	}
+	deleteAlertRuleReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DeleteAllObjectsStub        func(context.Context, []string) error
 	deleteAllObjectsMutex       sync.RWMutex
 	deleteAllObjectsArgsForCall []struct {
@@ -46,6 +73,19 @@ type FakeStore struct {
 	deleteAllRolesReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DeleteObjectTransitionsStub        func(context.Context, schema.GroupVersionKind, time.Time) error
+	deleteObjectTransitionsMutex       sync.RWMutex
+	deleteObjectTransitionsArgsForCall []struct {
+		arg1 context.Context
+		arg2 schema.GroupVersionKind
+		arg3 time.Time
+	}
+	deleteObjectTransitionsReturns struct {
+		result1 error
+	}
+	deleteObjectTransitionsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DeleteObjectsStub        func(context.Context, []models.Object) error
 	deleteObjectsMutex       sync.RWMutex
 	deleteObjectsArgsForCall []struct {
@@ -82,6 +122,18 @@ type FakeStore struct {
 	deleteRolesReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DeleteSavedQueryStub        func(context.Context, string) error
+	deleteSavedQueryMutex       sync.RWMutex
+	deleteSavedQueryArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteSavedQueryReturns struct {
+		result1 error
+	}
+	deleteSavedQueryReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DeleteTenantsStub        func(context.Context, []models.Tenant) error
 	deleteTenantsMutex       sync.RWMutex
 	deleteTenantsArgsForCall []struct {
@@ -107,6 +159,33 @@ type FakeStore struct {
 		result1 []models.AccessRule
 		result2 error
 	}
+	GetAlertRuleStub        func(context.Context, string) (models.AlertRule, error)
+	getAlertRuleMutex       sync.RWMutex
+	getAlertRuleArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getAlertRuleReturns struct {
+		result1 models.AlertRule
+		result2 error
+	}
+	getAlertRuleReturnsOnCall map[int]struct {
+		result1 models.AlertRule
+		result2 error
+	}
+	GetAlertRulesStub        func(context.Context) ([]models.AlertRule, error)
+	getAlertRulesMutex       sync.RWMutex
+	getAlertRulesArgsForCall []struct {
+		arg1 context.Context
+	}
+	getAlertRulesReturns struct {
+		result1 []models.AlertRule
+		result2 error
+	}
+	getAlertRulesReturnsOnCall map[int]struct {
+		result1 []models.AlertRule
+		result2 error
+	}
 	GetAllObjectsStub        func(context.Context) (store.Iterator, error)
 	getAllObjectsMutex       sync.RWMutex
 	getAllObjectsArgsForCall []struct {
@@ -120,6 +199,19 @@ type FakeStore struct {
 		result1 store.Iterator
 		result2 error
 	}
+	GetInventoryObjectsStub        func(context.Context) (store.Iterator, error)
+	getInventoryObjectsMutex       sync.RWMutex
+	getInventoryObjectsArgsForCall []struct {
+		arg1 context.Context
+	}
+	getInventoryObjectsReturns struct {
+		result1 store.Iterator
+		result2 error
+	}
+	getInventoryObjectsReturnsOnCall map[int]struct {
+		result1 store.Iterator
+		result2 error
+	}
 	GetObjectByIDStub        func(context.Context, string) (models.Object, error)
 	getObjectByIDMutex       sync.RWMutex
 	getObjectByIDArgsForCall []struct {
@@ -134,6 +226,64 @@ type FakeStore struct {
 		result1 models.Object
 		result2 error
 	}
+	GetObjectChangesStub        func(context.Context, time.Time) (store.Iterator, []string, error)
+	getObjectChangesMutex       sync.RWMutex
+	getObjectChangesArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+	}
+	getObjectChangesReturns struct {
+		result1 store.Iterator
+		result2 []string
+		result3 error
+	}
+	getObjectChangesReturnsOnCall map[int]struct {
+		result1 store.Iterator
+		result2 []string
+		result3 error
+	}
+	GetObjectRelationsStub        func(context.Context, models.ObjectNode) ([]models.ObjectRelation, error)
+	getObjectRelationsMutex       sync.RWMutex
+	getObjectRelationsArgsForCall []struct {
+		arg1 context.Context
+		arg2 models.ObjectNode
+	}
+	getObjectRelationsReturns struct {
+		result1 []models.ObjectRelation
+		result2 error
+	}
+	getObjectRelationsReturnsOnCall map[int]struct {
+		result1 []models.ObjectRelation
+		result2 error
+	}
+	GetObjectTransitionsStub        func(context.Context, string) ([]models.ObjectTransition, error)
+	getObjectTransitionsMutex       sync.RWMutex
+	getObjectTransitionsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getObjectTransitionsReturns struct {
+		result1 []models.ObjectTransition
+		result2 error
+	}
+	getObjectTransitionsReturnsOnCall map[int]struct {
+		result1 []models.ObjectTransition
+		result2 error
+	}
+	GetObjectTransitionsAsOfStub        func(context.Context, time.Time) ([]models.ObjectTransition, error)
+	getObjectTransitionsAsOfMutex       sync.RWMutex
+	getObjectTransitionsAsOfArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+	}
+	getObjectTransitionsAsOfReturns struct {
+		result1 []models.ObjectTransition
+		result2 error
+	}
+	getObjectTransitionsAsOfReturnsOnCall map[int]struct {
+		result1 []models.ObjectTransition
+		result2 error
+	}
 	GetObjectsStub        func(context.Context, []string, store.QueryOption) (store.Iterator, error)
 	getObjectsMutex       sync.RWMutex
 	getObjectsArgsForCall []struct {
@@ -149,6 +299,36 @@ type FakeStore struct {
 		result1 store.Iterator
 		result2 error
 	}
+	GetObjectsByNameStub        func(context.Context, string, string, string) ([]models.Object, error)
+	getObjectsByNameMutex       sync.RWMutex
+	getObjectsByNameArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	getObjectsByNameReturns struct {
+		result1 []models.Object
+		result2 error
+	}
+	getObjectsByNameReturnsOnCall map[int]struct {
+		result1 []models.Object
+		result2 error
+	}
+	GetObjectsByNodeStub        func(context.Context, models.ObjectNode) ([]models.Object, error)
+	getObjectsByNodeMutex       sync.RWMutex
+	getObjectsByNodeArgsForCall []struct {
+		arg1 context.Context
+		arg2 models.ObjectNode
+	}
+	getObjectsByNodeReturns struct {
+		result1 []models.Object
+		result2 error
+	}
+	getObjectsByNodeReturnsOnCall map[int]struct {
+		result1 []models.Object
+		result2 error
+	}
 	GetRoleBindingsStub        func(context.Context) ([]models.RoleBinding, error)
 	getRoleBindingsMutex       sync.RWMutex
 	getRoleBindingsArgsForCall []struct {
@@ -175,6 +355,33 @@ type FakeStore struct {
 		result1 []models.Role
 		result2 error
 	}
+	GetSavedQueriesStub        func(context.Context) ([]models.SavedQuery, error)
+	getSavedQueriesMutex       sync.RWMutex
+	getSavedQueriesArgsForCall []struct {
+		arg1 context.Context
+	}
+	getSavedQueriesReturns struct {
+		result1 []models.SavedQuery
+		result2 error
+	}
+	getSavedQueriesReturnsOnCall map[int]struct {
+		result1 []models.SavedQuery
+		result2 error
+	}
+	GetSavedQueryStub        func(context.Context, string) (models.SavedQuery, error)
+	getSavedQueryMutex       sync.RWMutex
+	getSavedQueryArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getSavedQueryReturns struct {
+		result1 models.SavedQuery
+		result2 error
+	}
+	getSavedQueryReturnsOnCall map[int]struct {
+		result1 models.SavedQuery
+		result2 error
+	}
 	GetTenantsStub        func(context.Context) ([]models.Tenant, error)
 	getTenantsMutex       sync.RWMutex
 	getTenantsArgsForCall []struct {
@@ -188,6 +395,30 @@ type FakeStore struct {
 		result1 []models.Tenant
 		result2 error
 	}
+	StoreAlertRuleStub        func(context.Context, models.AlertRule) error
+	storeAlertRuleMutex       sync.RWMutex
+	storeAlertRuleArgsForCall []struct {
+		arg1 context.Context
+		arg2 models.AlertRule
+	}
+	storeAlertRuleReturns struct {
+		result1 error
+	}
+	storeAlertRuleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	StoreAlertRuleStateStub        func(context.Context, models.AlertRuleState) error
+	storeAlertRuleStateMutex       sync.RWMutex
+	storeAlertRuleStateArgsForCall []struct {
+		arg1 context.Context
+		arg2 models.AlertRuleState
+	}
+	storeAlertRuleStateReturns struct {
+		result1 error
+	}
+	storeAlertRuleStateReturnsOnCall map[int]struct {
+		result1 error
+	}
 	StoreObjectsStub        func(context.Context, []models.Object) error
 	storeObjectsMutex       sync.RWMutex
 	storeObjectsArgsForCall []struct {
@@ -224,6 +455,18 @@ type FakeStore struct {
 	storeRolesReturnsOnCall map[int]struct {
 		result1 error
 	}
+	StoreSavedQueryStub        func(context.Context, models.SavedQuery) error
+	storeSavedQueryMutex       sync.RWMutex
+	storeSavedQueryArgsForCall []struct {
+		arg1 context.Context
+		arg2 models.SavedQuery
+	}
+	storeSavedQueryReturns struct {
+		result1 error
+	}
+	storeSavedQueryReturnsOnCall map[int]struct {
+		result1 error
+	}
 	StoreTenantsStub        func(context.Context, []models.Tenant) error
 	storeTenantsMutex       sync.RWMutex
 	storeTenantsArgsForCall []struct {
@@ -240,6 +483,132 @@ type FakeStore struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeStore) CountObjectsByCluster(arg1 context.Context) (map[string]int, error) {
+	fake.countObjectsByClusterMutex.Lock()
+	ret, specificReturn := fake.countObjectsByClusterReturnsOnCall[len(fake.countObjectsByClusterArgsForCall)]
+	fake.countObjectsByClusterArgsForCall = append(fake.countObjectsByClusterArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.CountObjectsByClusterStub
+	fakeReturns := fake.countObjectsByClusterReturns
+	fake.recordInvocation("CountObjectsByCluster", []interface{}{arg1})
+	fake.countObjectsByClusterMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) CountObjectsByClusterCallCount() int {
+	fake.countObjectsByClusterMutex.RLock()
+	defer fake.countObjectsByClusterMutex.RUnlock()
+	return len(fake.countObjectsByClusterArgsForCall)
+}
+
+func (fake *FakeStore) CountObjectsByClusterCalls(stub func(context.Context) (map[string]int, error)) {
+	fake.countObjectsByClusterMutex.Lock()
+	defer fake.countObjectsByClusterMutex.Unlock()
+	fake.CountObjectsByClusterStub = stub
+}
+
+func (fake *FakeStore) CountObjectsByClusterArgsForCall(i int) context.Context {
+	fake.countObjectsByClusterMutex.RLock()
+	defer fake.countObjectsByClusterMutex.RUnlock()
+	argsForCall := fake.countObjectsByClusterArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStore) CountObjectsByClusterReturns(result1 map[string]int, result2 error) {
+	fake.countObjectsByClusterMutex.Lock()
+	defer fake.countObjectsByClusterMutex.Unlock()
+	fake.CountObjectsByClusterStub = nil
+	fake.countObjectsByClusterReturns = struct {
+		result1 map[string]int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) CountObjectsByClusterReturnsOnCall(i int, result1 map[string]int, result2 error) {
+	fake.countObjectsByClusterMutex.Lock()
+	defer fake.countObjectsByClusterMutex.Unlock()
+	fake.CountObjectsByClusterStub = nil
+	if fake.countObjectsByClusterReturnsOnCall == nil {
+		fake.countObjectsByClusterReturnsOnCall = make(map[int]struct {
+			result1 map[string]int
+			result2 error
+		})
+	}
+	fake.countObjectsByClusterReturnsOnCall[i] = struct {
+		result1 map[string]int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) DeleteAlertRule(arg1 context.Context, arg2 string) error {
+	fake.deleteAlertRuleMutex.Lock()
+	ret, specificReturn := fake.deleteAlertRuleReturnsOnCall[len(fake.deleteAlertRuleArgsForCall)]
+	fake.deleteAlertRuleArgsForCall = append(fake.deleteAlertRuleArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteAlertRuleStub
+	fakeReturns := fake.deleteAlertRuleReturns
+	fake.recordInvocation("DeleteAlertRule", []interface{}{arg1, arg2})
+	fake.deleteAlertRuleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) DeleteAlertRuleCallCount() int {
+	fake.deleteAlertRuleMutex.RLock()
+	defer fake.deleteAlertRuleMutex.RUnlock()
+	return len(fake.deleteAlertRuleArgsForCall)
+}
+
+func (fake *FakeStore) DeleteAlertRuleCalls(stub func(context.Context, string) error) {
+	fake.deleteAlertRuleMutex.Lock()
+	defer fake.deleteAlertRuleMutex.Unlock()
+	fake.DeleteAlertRuleStub = stub
+}
+
+func (fake *FakeStore) DeleteAlertRuleArgsForCall(i int) (context.Context, string) {
+	fake.deleteAlertRuleMutex.RLock()
+	defer fake.deleteAlertRuleMutex.RUnlock()
+	argsForCall := fake.deleteAlertRuleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) DeleteAlertRuleReturns(result1 error) {
+	fake.deleteAlertRuleMutex.Lock()
+	defer fake.deleteAlertRuleMutex.Unlock()
+	fake.DeleteAlertRuleStub = nil
+	fake.deleteAlertRuleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) DeleteAlertRuleReturnsOnCall(i int, result1 error) {
+	fake.deleteAlertRuleMutex.Lock()
+	defer fake.deleteAlertRuleMutex.Unlock()
+	fake.DeleteAlertRuleStub = nil
+	if fake.deleteAlertRuleReturnsOnCall == nil {
+		fake.deleteAlertRuleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteAlertRuleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeStore) DeleteAllObjects(arg1 context.Context, arg2 []string) error {
 	var arg2Copy []string
 	if arg2 != nil {
@@ -441,6 +810,69 @@ func (fake *FakeStore) DeleteAllRolesReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeStore) DeleteObjectTransitions(arg1 context.Context, arg2 schema.GroupVersionKind, arg3 time.Time) error {
+	fake.deleteObjectTransitionsMutex.Lock()
+	ret, specificReturn := fake.deleteObjectTransitionsReturnsOnCall[len(fake.deleteObjectTransitionsArgsForCall)]
+	fake.deleteObjectTransitionsArgsForCall = append(fake.deleteObjectTransitionsArgsForCall, struct {
+		arg1 context.Context
+		arg2 schema.GroupVersionKind
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteObjectTransitionsStub
+	fakeReturns := fake.deleteObjectTransitionsReturns
+	fake.recordInvocation("DeleteObjectTransitions", []interface{}{arg1, arg2, arg3})
+	fake.deleteObjectTransitionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) DeleteObjectTransitionsCallCount() int {
+	fake.deleteObjectTransitionsMutex.RLock()
+	defer fake.deleteObjectTransitionsMutex.RUnlock()
+	return len(fake.deleteObjectTransitionsArgsForCall)
+}
+
+func (fake *FakeStore) DeleteObjectTransitionsCalls(stub func(context.Context, schema.GroupVersionKind, time.Time) error) {
+	fake.deleteObjectTransitionsMutex.Lock()
+	defer fake.deleteObjectTransitionsMutex.Unlock()
+	fake.DeleteObjectTransitionsStub = stub
+}
+
+func (fake *FakeStore) DeleteObjectTransitionsArgsForCall(i int) (context.Context, schema.GroupVersionKind, time.Time) {
+	fake.deleteObjectTransitionsMutex.RLock()
+	defer fake.deleteObjectTransitionsMutex.RUnlock()
+	argsForCall := fake.deleteObjectTransitionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) DeleteObjectTransitionsReturns(result1 error) {
+	fake.deleteObjectTransitionsMutex.Lock()
+	defer fake.deleteObjectTransitionsMutex.Unlock()
+	fake.DeleteObjectTransitionsStub = nil
+	fake.deleteObjectTransitionsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) DeleteObjectTransitionsReturnsOnCall(i int, result1 error) {
+	fake.deleteObjectTransitionsMutex.Lock()
+	defer fake.deleteObjectTransitionsMutex.Unlock()
+	fake.DeleteObjectTransitionsStub = nil
+	if fake.deleteObjectTransitionsReturnsOnCall == nil {
+		fake.deleteObjectTransitionsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteObjectTransitionsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeStore) DeleteObjects(arg1 context.Context, arg2 []models.Object) error {
 	var arg2Copy []models.Object
 	if arg2 != nil {
@@ -642,6 +1074,68 @@ func (fake *FakeStore) DeleteRolesReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeStore) DeleteSavedQuery(arg1 context.Context, arg2 string) error {
+	fake.deleteSavedQueryMutex.Lock()
+	ret, specificReturn := fake.deleteSavedQueryReturnsOnCall[len(fake.deleteSavedQueryArgsForCall)]
+	fake.deleteSavedQueryArgsForCall = append(fake.deleteSavedQueryArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteSavedQueryStub
+	fakeReturns := fake.deleteSavedQueryReturns
+	fake.recordInvocation("DeleteSavedQuery", []interface{}{arg1, arg2})
+	fake.deleteSavedQueryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) DeleteSavedQueryCallCount() int {
+	fake.deleteSavedQueryMutex.RLock()
+	defer fake.deleteSavedQueryMutex.RUnlock()
+	return len(fake.deleteSavedQueryArgsForCall)
+}
+
+func (fake *FakeStore) DeleteSavedQueryCalls(stub func(context.Context, string) error) {
+	fake.deleteSavedQueryMutex.Lock()
+	defer fake.deleteSavedQueryMutex.Unlock()
+	fake.DeleteSavedQueryStub = stub
+}
+
+func (fake *FakeStore) DeleteSavedQueryArgsForCall(i int) (context.Context, string) {
+	fake.deleteSavedQueryMutex.RLock()
+	defer fake.deleteSavedQueryMutex.RUnlock()
+	argsForCall := fake.deleteSavedQueryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) DeleteSavedQueryReturns(result1 error) {
+	fake.deleteSavedQueryMutex.Lock()
+	defer fake.deleteSavedQueryMutex.Unlock()
+	fake.DeleteSavedQueryStub = nil
+	fake.deleteSavedQueryReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) DeleteSavedQueryReturnsOnCall(i int, result1 error) {
+	fake.deleteSavedQueryMutex.Lock()
+	defer fake.deleteSavedQueryMutex.Unlock()
+	fake.DeleteSavedQueryStub = nil
+	if fake.deleteSavedQueryReturnsOnCall == nil {
+		fake.deleteSavedQueryReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteSavedQueryReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeStore) DeleteTenants(arg1 context.Context, arg2 []models.Tenant) error {
 	var arg2Copy []models.Tenant
 	if arg2 != nil {
@@ -773,18 +1267,19 @@ func (fake *FakeStore) GetAccessRulesReturnsOnCall(i int, result1 []models.Acces
 	}{result1, result2}
 }
 
-func (fake *FakeStore) GetAllObjects(arg1 context.Context) (store.Iterator, error) {
-	fake.getAllObjectsMutex.Lock()
-	ret, specificReturn := fake.getAllObjectsReturnsOnCall[len(fake.getAllObjectsArgsForCall)]
-	fake.getAllObjectsArgsForCall = append(fake.getAllObjectsArgsForCall, struct {
+func (fake *FakeStore) GetAlertRule(arg1 context.Context, arg2 string) (models.AlertRule, error) {
+	fake.getAlertRuleMutex.Lock()
+	ret, specificReturn := fake.getAlertRuleReturnsOnCall[len(fake.getAlertRuleArgsForCall)]
+	fake.getAlertRuleArgsForCall = append(fake.getAlertRuleArgsForCall, struct {
 		arg1 context.Context
-	}{arg1})
-	stub := fake.GetAllObjectsStub
-	fakeReturns := fake.getAllObjectsReturns
-	fake.recordInvocation("GetAllObjects", []interface{}{arg1})
-	fake.getAllObjectsMutex.Unlock()
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetAlertRuleStub
+	fakeReturns := fake.getAlertRuleReturns
+	fake.recordInvocation("GetAlertRule", []interface{}{arg1, arg2})
+	fake.getAlertRuleMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -792,20 +1287,148 @@ func (fake *FakeStore) GetAllObjects(arg1 context.Context) (store.Iterator, erro
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStore) GetAllObjectsCallCount() int {
-	fake.getAllObjectsMutex.RLock()
-	defer fake.getAllObjectsMutex.RUnlock()
-	return len(fake.getAllObjectsArgsForCall)
+func (fake *FakeStore) GetAlertRuleCallCount() int {
+	fake.getAlertRuleMutex.RLock()
+	defer fake.getAlertRuleMutex.RUnlock()
+	return len(fake.getAlertRuleArgsForCall)
 }
 
-func (fake *FakeStore) GetAllObjectsCalls(stub func(context.Context) (store.Iterator, error)) {
-	fake.getAllObjectsMutex.Lock()
-	defer fake.getAllObjectsMutex.Unlock()
-	fake.GetAllObjectsStub = stub
+func (fake *FakeStore) GetAlertRuleCalls(stub func(context.Context, string) (models.AlertRule, error)) {
+	fake.getAlertRuleMutex.Lock()
+	defer fake.getAlertRuleMutex.Unlock()
+	fake.GetAlertRuleStub = stub
 }
 
-func (fake *FakeStore) GetAllObjectsArgsForCall(i int) context.Context {
-	fake.getAllObjectsMutex.RLock()
+func (fake *FakeStore) GetAlertRuleArgsForCall(i int) (context.Context, string) {
+	fake.getAlertRuleMutex.RLock()
+	defer fake.getAlertRuleMutex.RUnlock()
+	argsForCall := fake.getAlertRuleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) GetAlertRuleReturns(result1 models.AlertRule, result2 error) {
+	fake.getAlertRuleMutex.Lock()
+	defer fake.getAlertRuleMutex.Unlock()
+	fake.GetAlertRuleStub = nil
+	fake.getAlertRuleReturns = struct {
+		result1 models.AlertRule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetAlertRuleReturnsOnCall(i int, result1 models.AlertRule, result2 error) {
+	fake.getAlertRuleMutex.Lock()
+	defer fake.getAlertRuleMutex.Unlock()
+	fake.GetAlertRuleStub = nil
+	if fake.getAlertRuleReturnsOnCall == nil {
+		fake.getAlertRuleReturnsOnCall = make(map[int]struct {
+			result1 models.AlertRule
+			result2 error
+		})
+	}
+	fake.getAlertRuleReturnsOnCall[i] = struct {
+		result1 models.AlertRule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetAlertRules(arg1 context.Context) ([]models.AlertRule, error) {
+	fake.getAlertRulesMutex.Lock()
+	ret, specificReturn := fake.getAlertRulesReturnsOnCall[len(fake.getAlertRulesArgsForCall)]
+	fake.getAlertRulesArgsForCall = append(fake.getAlertRulesArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetAlertRulesStub
+	fakeReturns := fake.getAlertRulesReturns
+	fake.recordInvocation("GetAlertRules", []interface{}{arg1})
+	fake.getAlertRulesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) GetAlertRulesCallCount() int {
+	fake.getAlertRulesMutex.RLock()
+	defer fake.getAlertRulesMutex.RUnlock()
+	return len(fake.getAlertRulesArgsForCall)
+}
+
+func (fake *FakeStore) GetAlertRulesCalls(stub func(context.Context) ([]models.AlertRule, error)) {
+	fake.getAlertRulesMutex.Lock()
+	defer fake.getAlertRulesMutex.Unlock()
+	fake.GetAlertRulesStub = stub
+}
+
+func (fake *FakeStore) GetAlertRulesArgsForCall(i int) context.Context {
+	fake.getAlertRulesMutex.RLock()
+	defer fake.getAlertRulesMutex.RUnlock()
+	argsForCall := fake.getAlertRulesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStore) GetAlertRulesReturns(result1 []models.AlertRule, result2 error) {
+	fake.getAlertRulesMutex.Lock()
+	defer fake.getAlertRulesMutex.Unlock()
+	fake.GetAlertRulesStub = nil
+	fake.getAlertRulesReturns = struct {
+		result1 []models.AlertRule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetAlertRulesReturnsOnCall(i int, result1 []models.AlertRule, result2 error) {
+	fake.getAlertRulesMutex.Lock()
+	defer fake.getAlertRulesMutex.Unlock()
+	fake.GetAlertRulesStub = nil
+	if fake.getAlertRulesReturnsOnCall == nil {
+		fake.getAlertRulesReturnsOnCall = make(map[int]struct {
+			result1 []models.AlertRule
+			result2 error
+		})
+	}
+	fake.getAlertRulesReturnsOnCall[i] = struct {
+		result1 []models.AlertRule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetAllObjects(arg1 context.Context) (store.Iterator, error) {
+	fake.getAllObjectsMutex.Lock()
+	ret, specificReturn := fake.getAllObjectsReturnsOnCall[len(fake.getAllObjectsArgsForCall)]
+	fake.getAllObjectsArgsForCall = append(fake.getAllObjectsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetAllObjectsStub
+	fakeReturns := fake.getAllObjectsReturns
+	fake.recordInvocation("GetAllObjects", []interface{}{arg1})
+	fake.getAllObjectsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) GetAllObjectsCallCount() int {
+	fake.getAllObjectsMutex.RLock()
+	defer fake.getAllObjectsMutex.RUnlock()
+	return len(fake.getAllObjectsArgsForCall)
+}
+
+func (fake *FakeStore) GetAllObjectsCalls(stub func(context.Context) (store.Iterator, error)) {
+	fake.getAllObjectsMutex.Lock()
+	defer fake.getAllObjectsMutex.Unlock()
+	fake.GetAllObjectsStub = stub
+}
+
+func (fake *FakeStore) GetAllObjectsArgsForCall(i int) context.Context {
+	fake.getAllObjectsMutex.RLock()
 	defer fake.getAllObjectsMutex.RUnlock()
 	argsForCall := fake.getAllObjectsArgsForCall[i]
 	return argsForCall.arg1
@@ -837,6 +1460,70 @@ func (fake *FakeStore) GetAllObjectsReturnsOnCall(i int, result1 store.Iterator,
 	}{result1, result2}
 }
 
+func (fake *FakeStore) GetInventoryObjects(arg1 context.Context) (store.Iterator, error) {
+	fake.getInventoryObjectsMutex.Lock()
+	ret, specificReturn := fake.getInventoryObjectsReturnsOnCall[len(fake.getInventoryObjectsArgsForCall)]
+	fake.getInventoryObjectsArgsForCall = append(fake.getInventoryObjectsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetInventoryObjectsStub
+	fakeReturns := fake.getInventoryObjectsReturns
+	fake.recordInvocation("GetInventoryObjects", []interface{}{arg1})
+	fake.getInventoryObjectsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) GetInventoryObjectsCallCount() int {
+	fake.getInventoryObjectsMutex.RLock()
+	defer fake.getInventoryObjectsMutex.RUnlock()
+	return len(fake.getInventoryObjectsArgsForCall)
+}
+
+func (fake *FakeStore) GetInventoryObjectsCalls(stub func(context.Context) (store.Iterator, error)) {
+	fake.getInventoryObjectsMutex.Lock()
+	defer fake.getInventoryObjectsMutex.Unlock()
+	fake.GetInventoryObjectsStub = stub
+}
+
+func (fake *FakeStore) GetInventoryObjectsArgsForCall(i int) context.Context {
+	fake.getInventoryObjectsMutex.RLock()
+	defer fake.getInventoryObjectsMutex.RUnlock()
+	argsForCall := fake.getInventoryObjectsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStore) GetInventoryObjectsReturns(result1 store.Iterator, result2 error) {
+	fake.getInventoryObjectsMutex.Lock()
+	defer fake.getInventoryObjectsMutex.Unlock()
+	fake.GetInventoryObjectsStub = nil
+	fake.getInventoryObjectsReturns = struct {
+		result1 store.Iterator
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetInventoryObjectsReturnsOnCall(i int, result1 store.Iterator, result2 error) {
+	fake.getInventoryObjectsMutex.Lock()
+	defer fake.getInventoryObjectsMutex.Unlock()
+	fake.GetInventoryObjectsStub = nil
+	if fake.getInventoryObjectsReturnsOnCall == nil {
+		fake.getInventoryObjectsReturnsOnCall = make(map[int]struct {
+			result1 store.Iterator
+			result2 error
+		})
+	}
+	fake.getInventoryObjectsReturnsOnCall[i] = struct {
+		result1 store.Iterator
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStore) GetObjectByID(arg1 context.Context, arg2 string) (models.Object, error) {
 	fake.getObjectByIDMutex.Lock()
 	ret, specificReturn := fake.getObjectByIDReturnsOnCall[len(fake.getObjectByIDArgsForCall)]
@@ -902,6 +1589,269 @@ func (fake *FakeStore) GetObjectByIDReturnsOnCall(i int, result1 models.Object,
 	}{result1, result2}
 }
 
+func (fake *FakeStore) GetObjectChanges(arg1 context.Context, arg2 time.Time) (store.Iterator, []string, error) {
+	fake.getObjectChangesMutex.Lock()
+	ret, specificReturn := fake.getObjectChangesReturnsOnCall[len(fake.getObjectChangesArgsForCall)]
+	fake.getObjectChangesArgsForCall = append(fake.getObjectChangesArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.GetObjectChangesStub
+	fakeReturns := fake.getObjectChangesReturns
+	fake.recordInvocation("GetObjectChanges", []interface{}{arg1, arg2})
+	fake.getObjectChangesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeStore) GetObjectChangesCallCount() int {
+	fake.getObjectChangesMutex.RLock()
+	defer fake.getObjectChangesMutex.RUnlock()
+	return len(fake.getObjectChangesArgsForCall)
+}
+
+func (fake *FakeStore) GetObjectChangesCalls(stub func(context.Context, time.Time) (store.Iterator, []string, error)) {
+	fake.getObjectChangesMutex.Lock()
+	defer fake.getObjectChangesMutex.Unlock()
+	fake.GetObjectChangesStub = stub
+}
+
+func (fake *FakeStore) GetObjectChangesArgsForCall(i int) (context.Context, time.Time) {
+	fake.getObjectChangesMutex.RLock()
+	defer fake.getObjectChangesMutex.RUnlock()
+	argsForCall := fake.getObjectChangesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) GetObjectChangesReturns(result1 store.Iterator, result2 []string, result3 error) {
+	fake.getObjectChangesMutex.Lock()
+	defer fake.getObjectChangesMutex.Unlock()
+	fake.GetObjectChangesStub = nil
+	fake.getObjectChangesReturns = struct {
+		result1 store.Iterator
+		result2 []string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStore) GetObjectChangesReturnsOnCall(i int, result1 store.Iterator, result2 []string, result3 error) {
+	fake.getObjectChangesMutex.Lock()
+	defer fake.getObjectChangesMutex.Unlock()
+	fake.GetObjectChangesStub = nil
+	if fake.getObjectChangesReturnsOnCall == nil {
+		fake.getObjectChangesReturnsOnCall = make(map[int]struct {
+			result1 store.Iterator
+			result2 []string
+			result3 error
+		})
+	}
+	fake.getObjectChangesReturnsOnCall[i] = struct {
+		result1 store.Iterator
+		result2 []string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStore) GetObjectRelations(arg1 context.Context, arg2 models.ObjectNode) ([]models.ObjectRelation, error) {
+	fake.getObjectRelationsMutex.Lock()
+	ret, specificReturn := fake.getObjectRelationsReturnsOnCall[len(fake.getObjectRelationsArgsForCall)]
+	fake.getObjectRelationsArgsForCall = append(fake.getObjectRelationsArgsForCall, struct {
+		arg1 context.Context
+		arg2 models.ObjectNode
+	}{arg1, arg2})
+	stub := fake.GetObjectRelationsStub
+	fakeReturns := fake.getObjectRelationsReturns
+	fake.recordInvocation("GetObjectRelations", []interface{}{arg1, arg2})
+	fake.getObjectRelationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) GetObjectRelationsCallCount() int {
+	fake.getObjectRelationsMutex.RLock()
+	defer fake.getObjectRelationsMutex.RUnlock()
+	return len(fake.getObjectRelationsArgsForCall)
+}
+
+func (fake *FakeStore) GetObjectRelationsCalls(stub func(context.Context, models.ObjectNode) ([]models.ObjectRelation, error)) {
+	fake.getObjectRelationsMutex.Lock()
+	defer fake.getObjectRelationsMutex.Unlock()
+	fake.GetObjectRelationsStub = stub
+}
+
+func (fake *FakeStore) GetObjectRelationsArgsForCall(i int) (context.Context, models.ObjectNode) {
+	fake.getObjectRelationsMutex.RLock()
+	defer fake.getObjectRelationsMutex.RUnlock()
+	argsForCall := fake.getObjectRelationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) GetObjectRelationsReturns(result1 []models.ObjectRelation, result2 error) {
+	fake.getObjectRelationsMutex.Lock()
+	defer fake.getObjectRelationsMutex.Unlock()
+	fake.GetObjectRelationsStub = nil
+	fake.getObjectRelationsReturns = struct {
+		result1 []models.ObjectRelation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetObjectRelationsReturnsOnCall(i int, result1 []models.ObjectRelation, result2 error) {
+	fake.getObjectRelationsMutex.Lock()
+	defer fake.getObjectRelationsMutex.Unlock()
+	fake.GetObjectRelationsStub = nil
+	if fake.getObjectRelationsReturnsOnCall == nil {
+		fake.getObjectRelationsReturnsOnCall = make(map[int]struct {
+			result1 []models.ObjectRelation
+			result2 error
+		})
+	}
+	fake.getObjectRelationsReturnsOnCall[i] = struct {
+		result1 []models.ObjectRelation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetObjectTransitions(arg1 context.Context, arg2 string) ([]models.ObjectTransition, error) {
+	fake.getObjectTransitionsMutex.Lock()
+	ret, specificReturn := fake.getObjectTransitionsReturnsOnCall[len(fake.getObjectTransitionsArgsForCall)]
+	fake.getObjectTransitionsArgsForCall = append(fake.getObjectTransitionsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetObjectTransitionsStub
+	fakeReturns := fake.getObjectTransitionsReturns
+	fake.recordInvocation("GetObjectTransitions", []interface{}{arg1, arg2})
+	fake.getObjectTransitionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) GetObjectTransitionsCallCount() int {
+	fake.getObjectTransitionsMutex.RLock()
+	defer fake.getObjectTransitionsMutex.RUnlock()
+	return len(fake.getObjectTransitionsArgsForCall)
+}
+
+func (fake *FakeStore) GetObjectTransitionsCalls(stub func(context.Context, string) ([]models.ObjectTransition, error)) {
+	fake.getObjectTransitionsMutex.Lock()
+	defer fake.getObjectTransitionsMutex.Unlock()
+	fake.GetObjectTransitionsStub = stub
+}
+
+func (fake *FakeStore) GetObjectTransitionsArgsForCall(i int) (context.Context, string) {
+	fake.getObjectTransitionsMutex.RLock()
+	defer fake.getObjectTransitionsMutex.RUnlock()
+	argsForCall := fake.getObjectTransitionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) GetObjectTransitionsReturns(result1 []models.ObjectTransition, result2 error) {
+	fake.getObjectTransitionsMutex.Lock()
+	defer fake.getObjectTransitionsMutex.Unlock()
+	fake.GetObjectTransitionsStub = nil
+	fake.getObjectTransitionsReturns = struct {
+		result1 []models.ObjectTransition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetObjectTransitionsReturnsOnCall(i int, result1 []models.ObjectTransition, result2 error) {
+	fake.getObjectTransitionsMutex.Lock()
+	defer fake.getObjectTransitionsMutex.Unlock()
+	fake.GetObjectTransitionsStub = nil
+	if fake.getObjectTransitionsReturnsOnCall == nil {
+		fake.getObjectTransitionsReturnsOnCall = make(map[int]struct {
+			result1 []models.ObjectTransition
+			result2 error
+		})
+	}
+	fake.getObjectTransitionsReturnsOnCall[i] = struct {
+		result1 []models.ObjectTransition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetObjectTransitionsAsOf(arg1 context.Context, arg2 time.Time) ([]models.ObjectTransition, error) {
+	fake.getObjectTransitionsAsOfMutex.Lock()
+	ret, specificReturn := fake.getObjectTransitionsAsOfReturnsOnCall[len(fake.getObjectTransitionsAsOfArgsForCall)]
+	fake.getObjectTransitionsAsOfArgsForCall = append(fake.getObjectTransitionsAsOfArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.GetObjectTransitionsAsOfStub
+	fakeReturns := fake.getObjectTransitionsAsOfReturns
+	fake.recordInvocation("GetObjectTransitionsAsOf", []interface{}{arg1, arg2})
+	fake.getObjectTransitionsAsOfMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) GetObjectTransitionsAsOfCallCount() int {
+	fake.getObjectTransitionsAsOfMutex.RLock()
+	defer fake.getObjectTransitionsAsOfMutex.RUnlock()
+	return len(fake.getObjectTransitionsAsOfArgsForCall)
+}
+
+func (fake *FakeStore) GetObjectTransitionsAsOfCalls(stub func(context.Context, time.Time) ([]models.ObjectTransition, error)) {
+	fake.getObjectTransitionsAsOfMutex.Lock()
+	defer fake.getObjectTransitionsAsOfMutex.Unlock()
+	fake.GetObjectTransitionsAsOfStub = stub
+}
+
+func (fake *FakeStore) GetObjectTransitionsAsOfArgsForCall(i int) (context.Context, time.Time) {
+	fake.getObjectTransitionsAsOfMutex.RLock()
+	defer fake.getObjectTransitionsAsOfMutex.RUnlock()
+	argsForCall := fake.getObjectTransitionsAsOfArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) GetObjectTransitionsAsOfReturns(result1 []models.ObjectTransition, result2 error) {
+	fake.getObjectTransitionsAsOfMutex.Lock()
+	defer fake.getObjectTransitionsAsOfMutex.Unlock()
+	fake.GetObjectTransitionsAsOfStub = nil
+	fake.getObjectTransitionsAsOfReturns = struct {
+		result1 []models.ObjectTransition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetObjectTransitionsAsOfReturnsOnCall(i int, result1 []models.ObjectTransition, result2 error) {
+	fake.getObjectTransitionsAsOfMutex.Lock()
+	defer fake.getObjectTransitionsAsOfMutex.Unlock()
+	fake.GetObjectTransitionsAsOfStub = nil
+	if fake.getObjectTransitionsAsOfReturnsOnCall == nil {
+		fake.getObjectTransitionsAsOfReturnsOnCall = make(map[int]struct {
+			result1 []models.ObjectTransition
+			result2 error
+		})
+	}
+	fake.getObjectTransitionsAsOfReturnsOnCall[i] = struct {
+		result1 []models.ObjectTransition
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStore) GetObjects(arg1 context.Context, arg2 []string, arg3 store.QueryOption) (store.Iterator, error) {
 	var arg2Copy []string
 	if arg2 != nil {
@@ -973,6 +1923,138 @@ func (fake *FakeStore) GetObjectsReturnsOnCall(i int, result1 store.Iterator, re
 	}{result1, result2}
 }
 
+func (fake *FakeStore) GetObjectsByName(arg1 context.Context, arg2 string, arg3 string, arg4 string) ([]models.Object, error) {
+	fake.getObjectsByNameMutex.Lock()
+	ret, specificReturn := fake.getObjectsByNameReturnsOnCall[len(fake.getObjectsByNameArgsForCall)]
+	fake.getObjectsByNameArgsForCall = append(fake.getObjectsByNameArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetObjectsByNameStub
+	fakeReturns := fake.getObjectsByNameReturns
+	fake.recordInvocation("GetObjectsByName", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getObjectsByNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) GetObjectsByNameCallCount() int {
+	fake.getObjectsByNameMutex.RLock()
+	defer fake.getObjectsByNameMutex.RUnlock()
+	return len(fake.getObjectsByNameArgsForCall)
+}
+
+func (fake *FakeStore) GetObjectsByNameCalls(stub func(context.Context, string, string, string) ([]models.Object, error)) {
+	fake.getObjectsByNameMutex.Lock()
+	defer fake.getObjectsByNameMutex.Unlock()
+	fake.GetObjectsByNameStub = stub
+}
+
+func (fake *FakeStore) GetObjectsByNameArgsForCall(i int) (context.Context, string, string, string) {
+	fake.getObjectsByNameMutex.RLock()
+	defer fake.getObjectsByNameMutex.RUnlock()
+	argsForCall := fake.getObjectsByNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStore) GetObjectsByNameReturns(result1 []models.Object, result2 error) {
+	fake.getObjectsByNameMutex.Lock()
+	defer fake.getObjectsByNameMutex.Unlock()
+	fake.GetObjectsByNameStub = nil
+	fake.getObjectsByNameReturns = struct {
+		result1 []models.Object
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetObjectsByNameReturnsOnCall(i int, result1 []models.Object, result2 error) {
+	fake.getObjectsByNameMutex.Lock()
+	defer fake.getObjectsByNameMutex.Unlock()
+	fake.GetObjectsByNameStub = nil
+	if fake.getObjectsByNameReturnsOnCall == nil {
+		fake.getObjectsByNameReturnsOnCall = make(map[int]struct {
+			result1 []models.Object
+			result2 error
+		})
+	}
+	fake.getObjectsByNameReturnsOnCall[i] = struct {
+		result1 []models.Object
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetObjectsByNode(arg1 context.Context, arg2 models.ObjectNode) ([]models.Object, error) {
+	fake.getObjectsByNodeMutex.Lock()
+	ret, specificReturn := fake.getObjectsByNodeReturnsOnCall[len(fake.getObjectsByNodeArgsForCall)]
+	fake.getObjectsByNodeArgsForCall = append(fake.getObjectsByNodeArgsForCall, struct {
+		arg1 context.Context
+		arg2 models.ObjectNode
+	}{arg1, arg2})
+	stub := fake.GetObjectsByNodeStub
+	fakeReturns := fake.getObjectsByNodeReturns
+	fake.recordInvocation("GetObjectsByNode", []interface{}{arg1, arg2})
+	fake.getObjectsByNodeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) GetObjectsByNodeCallCount() int {
+	fake.getObjectsByNodeMutex.RLock()
+	defer fake.getObjectsByNodeMutex.RUnlock()
+	return len(fake.getObjectsByNodeArgsForCall)
+}
+
+func (fake *FakeStore) GetObjectsByNodeCalls(stub func(context.Context, models.ObjectNode) ([]models.Object, error)) {
+	fake.getObjectsByNodeMutex.Lock()
+	defer fake.getObjectsByNodeMutex.Unlock()
+	fake.GetObjectsByNodeStub = stub
+}
+
+func (fake *FakeStore) GetObjectsByNodeArgsForCall(i int) (context.Context, models.ObjectNode) {
+	fake.getObjectsByNodeMutex.RLock()
+	defer fake.getObjectsByNodeMutex.RUnlock()
+	argsForCall := fake.getObjectsByNodeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) GetObjectsByNodeReturns(result1 []models.Object, result2 error) {
+	fake.getObjectsByNodeMutex.Lock()
+	defer fake.getObjectsByNodeMutex.Unlock()
+	fake.GetObjectsByNodeStub = nil
+	fake.getObjectsByNodeReturns = struct {
+		result1 []models.Object
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetObjectsByNodeReturnsOnCall(i int, result1 []models.Object, result2 error) {
+	fake.getObjectsByNodeMutex.Lock()
+	defer fake.getObjectsByNodeMutex.Unlock()
+	fake.GetObjectsByNodeStub = nil
+	if fake.getObjectsByNodeReturnsOnCall == nil {
+		fake.getObjectsByNodeReturnsOnCall = make(map[int]struct {
+			result1 []models.Object
+			result2 error
+		})
+	}
+	fake.getObjectsByNodeReturnsOnCall[i] = struct {
+		result1 []models.Object
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStore) GetRoleBindings(arg1 context.Context) ([]models.RoleBinding, error) {
 	fake.getRoleBindingsMutex.Lock()
 	ret, specificReturn := fake.getRoleBindingsReturnsOnCall[len(fake.getRoleBindingsArgsForCall)]
@@ -1101,6 +2183,135 @@ func (fake *FakeStore) GetRolesReturnsOnCall(i int, result1 []models.Role, resul
 	}{result1, result2}
 }
 
+func (fake *FakeStore) GetSavedQueries(arg1 context.Context) ([]models.SavedQuery, error) {
+	fake.getSavedQueriesMutex.Lock()
+	ret, specificReturn := fake.getSavedQueriesReturnsOnCall[len(fake.getSavedQueriesArgsForCall)]
+	fake.getSavedQueriesArgsForCall = append(fake.getSavedQueriesArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetSavedQueriesStub
+	fakeReturns := fake.getSavedQueriesReturns
+	fake.recordInvocation("GetSavedQueries", []interface{}{arg1})
+	fake.getSavedQueriesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) GetSavedQueriesCallCount() int {
+	fake.getSavedQueriesMutex.RLock()
+	defer fake.getSavedQueriesMutex.RUnlock()
+	return len(fake.getSavedQueriesArgsForCall)
+}
+
+func (fake *FakeStore) GetSavedQueriesCalls(stub func(context.Context) ([]models.SavedQuery, error)) {
+	fake.getSavedQueriesMutex.Lock()
+	defer fake.getSavedQueriesMutex.Unlock()
+	fake.GetSavedQueriesStub = stub
+}
+
+func (fake *FakeStore) GetSavedQueriesArgsForCall(i int) context.Context {
+	fake.getSavedQueriesMutex.RLock()
+	defer fake.getSavedQueriesMutex.RUnlock()
+	argsForCall := fake.getSavedQueriesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStore) GetSavedQueriesReturns(result1 []models.SavedQuery, result2 error) {
+	fake.getSavedQueriesMutex.Lock()
+	defer fake.getSavedQueriesMutex.Unlock()
+	fake.GetSavedQueriesStub = nil
+	fake.getSavedQueriesReturns = struct {
+		result1 []models.SavedQuery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetSavedQueriesReturnsOnCall(i int, result1 []models.SavedQuery, result2 error) {
+	fake.getSavedQueriesMutex.Lock()
+	defer fake.getSavedQueriesMutex.Unlock()
+	fake.GetSavedQueriesStub = nil
+	if fake.getSavedQueriesReturnsOnCall == nil {
+		fake.getSavedQueriesReturnsOnCall = make(map[int]struct {
+			result1 []models.SavedQuery
+			result2 error
+		})
+	}
+	fake.getSavedQueriesReturnsOnCall[i] = struct {
+		result1 []models.SavedQuery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetSavedQuery(arg1 context.Context, arg2 string) (models.SavedQuery, error) {
+	fake.getSavedQueryMutex.Lock()
+	ret, specificReturn := fake.getSavedQueryReturnsOnCall[len(fake.getSavedQueryArgsForCall)]
+	fake.getSavedQueryArgsForCall = append(fake.getSavedQueryArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetSavedQueryStub
+	fakeReturns := fake.getSavedQueryReturns
+	fake.recordInvocation("GetSavedQuery", []interface{}{arg1, arg2})
+	fake.getSavedQueryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) GetSavedQueryCallCount() int {
+	fake.getSavedQueryMutex.RLock()
+	defer fake.getSavedQueryMutex.RUnlock()
+	return len(fake.getSavedQueryArgsForCall)
+}
+
+func (fake *FakeStore) GetSavedQueryCalls(stub func(context.Context, string) (models.SavedQuery, error)) {
+	fake.getSavedQueryMutex.Lock()
+	defer fake.getSavedQueryMutex.Unlock()
+	fake.GetSavedQueryStub = stub
+}
+
+func (fake *FakeStore) GetSavedQueryArgsForCall(i int) (context.Context, string) {
+	fake.getSavedQueryMutex.RLock()
+	defer fake.getSavedQueryMutex.RUnlock()
+	argsForCall := fake.getSavedQueryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) GetSavedQueryReturns(result1 models.SavedQuery, result2 error) {
+	fake.getSavedQueryMutex.Lock()
+	defer fake.getSavedQueryMutex.Unlock()
+	fake.GetSavedQueryStub = nil
+	fake.getSavedQueryReturns = struct {
+		result1 models.SavedQuery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetSavedQueryReturnsOnCall(i int, result1 models.SavedQuery, result2 error) {
+	fake.getSavedQueryMutex.Lock()
+	defer fake.getSavedQueryMutex.Unlock()
+	fake.GetSavedQueryStub = nil
+	if fake.getSavedQueryReturnsOnCall == nil {
+		fake.getSavedQueryReturnsOnCall = make(map[int]struct {
+			result1 models.SavedQuery
+			result2 error
+		})
+	}
+	fake.getSavedQueryReturnsOnCall[i] = struct {
+		result1 models.SavedQuery
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStore) GetTenants(arg1 context.Context) ([]models.Tenant, error) {
 	fake.getTenantsMutex.Lock()
 	ret, specificReturn := fake.getTenantsReturnsOnCall[len(fake.getTenantsArgsForCall)]
@@ -1165,6 +2376,130 @@ func (fake *FakeStore) GetTenantsReturnsOnCall(i int, result1 []models.Tenant, r
 	}{result1, result2}
 }
 
+func (fake *FakeStore) StoreAlertRule(arg1 context.Context, arg2 models.AlertRule) error {
+	fake.storeAlertRuleMutex.Lock()
+	ret, specificReturn := fake.storeAlertRuleReturnsOnCall[len(fake.storeAlertRuleArgsForCall)]
+	fake.storeAlertRuleArgsForCall = append(fake.storeAlertRuleArgsForCall, struct {
+		arg1 context.Context
+		arg2 models.AlertRule
+	}{arg1, arg2})
+	stub := fake.StoreAlertRuleStub
+	fakeReturns := fake.storeAlertRuleReturns
+	fake.recordInvocation("StoreAlertRule", []interface{}{arg1, arg2})
+	fake.storeAlertRuleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) StoreAlertRuleCallCount() int {
+	fake.storeAlertRuleMutex.RLock()
+	defer fake.storeAlertRuleMutex.RUnlock()
+	return len(fake.storeAlertRuleArgsForCall)
+}
+
+func (fake *FakeStore) StoreAlertRuleCalls(stub func(context.Context, models.AlertRule) error) {
+	fake.storeAlertRuleMutex.Lock()
+	defer fake.storeAlertRuleMutex.Unlock()
+	fake.StoreAlertRuleStub = stub
+}
+
+func (fake *FakeStore) StoreAlertRuleArgsForCall(i int) (context.Context, models.AlertRule) {
+	fake.storeAlertRuleMutex.RLock()
+	defer fake.storeAlertRuleMutex.RUnlock()
+	argsForCall := fake.storeAlertRuleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) StoreAlertRuleReturns(result1 error) {
+	fake.storeAlertRuleMutex.Lock()
+	defer fake.storeAlertRuleMutex.Unlock()
+	fake.StoreAlertRuleStub = nil
+	fake.storeAlertRuleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) StoreAlertRuleReturnsOnCall(i int, result1 error) {
+	fake.storeAlertRuleMutex.Lock()
+	defer fake.storeAlertRuleMutex.Unlock()
+	fake.StoreAlertRuleStub = nil
+	if fake.storeAlertRuleReturnsOnCall == nil {
+		fake.storeAlertRuleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.storeAlertRuleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) StoreAlertRuleState(arg1 context.Context, arg2 models.AlertRuleState) error {
+	fake.storeAlertRuleStateMutex.Lock()
+	ret, specificReturn := fake.storeAlertRuleStateReturnsOnCall[len(fake.storeAlertRuleStateArgsForCall)]
+	fake.storeAlertRuleStateArgsForCall = append(fake.storeAlertRuleStateArgsForCall, struct {
+		arg1 context.Context
+		arg2 models.AlertRuleState
+	}{arg1, arg2})
+	stub := fake.StoreAlertRuleStateStub
+	fakeReturns := fake.storeAlertRuleStateReturns
+	fake.recordInvocation("StoreAlertRuleState", []interface{}{arg1, arg2})
+	fake.storeAlertRuleStateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) StoreAlertRuleStateCallCount() int {
+	fake.storeAlertRuleStateMutex.RLock()
+	defer fake.storeAlertRuleStateMutex.RUnlock()
+	return len(fake.storeAlertRuleStateArgsForCall)
+}
+
+func (fake *FakeStore) StoreAlertRuleStateCalls(stub func(context.Context, models.AlertRuleState) error) {
+	fake.storeAlertRuleStateMutex.Lock()
+	defer fake.storeAlertRuleStateMutex.Unlock()
+	fake.StoreAlertRuleStateStub = stub
+}
+
+func (fake *FakeStore) StoreAlertRuleStateArgsForCall(i int) (context.Context, models.AlertRuleState) {
+	fake.storeAlertRuleStateMutex.RLock()
+	defer fake.storeAlertRuleStateMutex.RUnlock()
+	argsForCall := fake.storeAlertRuleStateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) StoreAlertRuleStateReturns(result1 error) {
+	fake.storeAlertRuleStateMutex.Lock()
+	defer fake.storeAlertRuleStateMutex.Unlock()
+	fake.StoreAlertRuleStateStub = nil
+	fake.storeAlertRuleStateReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) StoreAlertRuleStateReturnsOnCall(i int, result1 error) {
+	fake.storeAlertRuleStateMutex.Lock()
+	defer fake.storeAlertRuleStateMutex.Unlock()
+	fake.StoreAlertRuleStateStub = nil
+	if fake.storeAlertRuleStateReturnsOnCall == nil {
+		fake.storeAlertRuleStateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.storeAlertRuleStateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeStore) StoreObjects(arg1 context.Context, arg2 []models.Object) error {
 	var arg2Copy []models.Object
 	if arg2 != nil {
@@ -1366,6 +2701,68 @@ func (fake *FakeStore) StoreRolesReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeStore) StoreSavedQuery(arg1 context.Context, arg2 models.SavedQuery) error {
+	fake.storeSavedQueryMutex.Lock()
+	ret, specificReturn := fake.storeSavedQueryReturnsOnCall[len(fake.storeSavedQueryArgsForCall)]
+	fake.storeSavedQueryArgsForCall = append(fake.storeSavedQueryArgsForCall, struct {
+		arg1 context.Context
+		arg2 models.SavedQuery
+	}{arg1, arg2})
+	stub := fake.StoreSavedQueryStub
+	fakeReturns := fake.storeSavedQueryReturns
+	fake.recordInvocation("StoreSavedQuery", []interface{}{arg1, arg2})
+	fake.storeSavedQueryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) StoreSavedQueryCallCount() int {
+	fake.storeSavedQueryMutex.RLock()
+	defer fake.storeSavedQueryMutex.RUnlock()
+	return len(fake.storeSavedQueryArgsForCall)
+}
+
+func (fake *FakeStore) StoreSavedQueryCalls(stub func(context.Context, models.SavedQuery) error) {
+	fake.storeSavedQueryMutex.Lock()
+	defer fake.storeSavedQueryMutex.Unlock()
+	fake.StoreSavedQueryStub = stub
+}
+
+func (fake *FakeStore) StoreSavedQueryArgsForCall(i int) (context.Context, models.SavedQuery) {
+	fake.storeSavedQueryMutex.RLock()
+	defer fake.storeSavedQueryMutex.RUnlock()
+	argsForCall := fake.storeSavedQueryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) StoreSavedQueryReturns(result1 error) {
+	fake.storeSavedQueryMutex.Lock()
+	defer fake.storeSavedQueryMutex.Unlock()
+	fake.StoreSavedQueryStub = nil
+	fake.storeSavedQueryReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) StoreSavedQueryReturnsOnCall(i int, result1 error) {
+	fake.storeSavedQueryMutex.Lock()
+	defer fake.storeSavedQueryMutex.Unlock()
+	fake.StoreSavedQueryStub = nil
+	if fake.storeSavedQueryReturnsOnCall == nil {
+		fake.storeSavedQueryReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.storeSavedQueryReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeStore) StoreTenants(arg1 context.Context, arg2 []models.Tenant) error {
 	var arg2Copy []models.Tenant
 	if arg2 != nil {
@@ -1436,40 +2833,76 @@ func (fake *FakeStore) StoreTenantsReturnsOnCall(i int, result1 error) {
 func (fake *FakeStore) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.countObjectsByClusterMutex.RLock()
+	defer fake.countObjectsByClusterMutex.RUnlock()
+	fake.deleteAlertRuleMutex.RLock()
+	defer fake.deleteAlertRuleMutex.RUnlock()
 	fake.deleteAllObjectsMutex.RLock()
 	defer fake.deleteAllObjectsMutex.RUnlock()
 	fake.deleteAllRoleBindingsMutex.RLock()
 	defer fake.deleteAllRoleBindingsMutex.RUnlock()
 	fake.deleteAllRolesMutex.RLock()
 	defer fake.deleteAllRolesMutex.RUnlock()
+	fake.deleteObjectTransitionsMutex.RLock()
+	defer fake.deleteObjectTransitionsMutex.RUnlock()
 	fake.deleteObjectsMutex.RLock()
 	defer fake.deleteObjectsMutex.RUnlock()
 	fake.deleteRoleBindingsMutex.RLock()
 	defer fake.deleteRoleBindingsMutex.RUnlock()
 	fake.deleteRolesMutex.RLock()
 	defer fake.deleteRolesMutex.RUnlock()
+	fake.deleteSavedQueryMutex.RLock()
+	defer fake.deleteSavedQueryMutex.RUnlock()
 	fake.deleteTenantsMutex.RLock()
 	defer fake.deleteTenantsMutex.RUnlock()
 	fake.getAccessRulesMutex.RLock()
 	defer fake.getAccessRulesMutex.RUnlock()
+	fake.getAlertRuleMutex.RLock()
+	defer fake.getAlertRuleMutex.RUnlock()
+	fake.getAlertRulesMutex.RLock()
+	defer fake.getAlertRulesMutex.RUnlock()
 	fake.getAllObjectsMutex.RLock()
 	defer fake.getAllObjectsMutex.RUnlock()
+	fake.getInventoryObjectsMutex.RLock()
+	defer fake.getInventoryObjectsMutex.RUnlock()
 	fake.getObjectByIDMutex.RLock()
 	defer fake.getObjectByIDMutex.RUnlock()
+	fake.getObjectChangesMutex.RLock()
+	defer fake.getObjectChangesMutex.RUnlock()
+	fake.getObjectRelationsMutex.RLock()
+	defer fake.getObjectRelationsMutex.RUnlock()
+	fake.getObjectTransitionsMutex.RLock()
+	defer fake.getObjectTransitionsMutex.RUnlock()
+	fake.getObjectTransitionsAsOfMutex.RLock()
+	defer fake.getObjectTransitionsAsOfMutex.RUnlock()
 	fake.getObjectsMutex.RLock()
 	defer fake.getObjectsMutex.RUnlock()
+	fake.getObjectsByNameMutex.RLock()
+	defer fake.getObjectsByNameMutex.RUnlock()
+	fake.getObjectsByNodeMutex.RLock()
+	defer fake.getObjectsByNodeMutex.RUnlock()
 	fake.getRoleBindingsMutex.RLock()
 	defer fake.getRoleBindingsMutex.RUnlock()
 	fake.getRolesMutex.RLock()
 	defer fake.getRolesMutex.RUnlock()
+	fake.getSavedQueriesMutex.RLock()
+	defer fake.getSavedQueriesMutex.RUnlock()
+	fake.getSavedQueryMutex.RLock()
+	defer fake.getSavedQueryMutex.RUnlock()
 	fake.getTenantsMutex.RLock()
 	defer fake.getTenantsMutex.RUnlock()
+	fake.storeAlertRuleMutex.RLock()
+	defer fake.storeAlertRuleMutex.RUnlock()
+	fake.storeAlertRuleStateMutex.RLock()
+	defer fake.storeAlertRuleStateMutex.RUnlock()
 	fake.storeObjectsMutex.RLock()
 	defer fake.storeObjectsMutex.RUnlock()
 	fake.storeRoleBindingsMutex.RLock()
 	defer fake.storeRoleBindingsMutex.RUnlock()
 	fake.storeRolesMutex.RLock()
 	defer fake.storeRolesMutex.RUnlock()
+	fake.storeSavedQueryMutex.RLock()
+	defer fake.storeSavedQueryMutex.RUnlock()
 	fake.storeTenantsMutex.RLock()
 	defer fake.storeTenantsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -4,12 +4,26 @@ package storefakes
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/store"
 )
 
 type FakeStoreReader struct {
+	CountObjectsByClusterStub        func(context.Context) (map[string]int, error)
+	countObjectsByClusterMutex       sync.RWMutex
+	countObjectsByClusterArgsForCall []struct {
+		arg1 context.Context
+	}
+	countObjectsByClusterReturns struct {
+		result1 map[string]int
+		result2 error
+	}
+	countObjectsByClusterReturnsOnCall map[int]struct {
+		result1 map[string]int
+		result2 error
+	}
 	GetAccessRulesStub        func(context.Context) ([]models.AccessRule, error)
 	getAccessRulesMutex       sync.RWMutex
 	getAccessRulesArgsForCall []struct {
@@ -23,6 +37,33 @@ type FakeStoreReader struct {
 		result1 []models.AccessRule
 		result2 error
 	}
+	GetAlertRuleStub        func(context.Context, string) (models.AlertRule, error)
+	getAlertRuleMutex       sync.RWMutex
+	getAlertRuleArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getAlertRuleReturns struct {
+		result1 models.AlertRule
+		result2 error
+	}
+	getAlertRuleReturnsOnCall map[int]struct {
+		result1 models.AlertRule
+		result2 error
+	}
+	GetAlertRulesStub        func(context.Context) ([]models.AlertRule, error)
+	getAlertRulesMutex       sync.RWMutex
+	getAlertRulesArgsForCall []struct {
+		arg1 context.Context
+	}
+	getAlertRulesReturns struct {
+		result1 []models.AlertRule
+		result2 error
+	}
+	getAlertRulesReturnsOnCall map[int]struct {
+		result1 []models.AlertRule
+		result2 error
+	}
 	GetAllObjectsStub        func(context.Context) (store.Iterator, error)
 	getAllObjectsMutex       sync.RWMutex
 	getAllObjectsArgsForCall []struct {
@@ -36,6 +77,19 @@ type FakeStoreReader struct {
 		result1 store.Iterator
 		result2 error
 	}
+	GetInventoryObjectsStub        func(context.Context) (store.Iterator, error)
+	getInventoryObjectsMutex       sync.RWMutex
+	getInventoryObjectsArgsForCall []struct {
+		arg1 context.Context
+	}
+	getInventoryObjectsReturns struct {
+		result1 store.Iterator
+		result2 error
+	}
+	getInventoryObjectsReturnsOnCall map[int]struct {
+		result1 store.Iterator
+		result2 error
+	}
 	GetObjectByIDStub        func(context.Context, string) (models.Object, error)
 	getObjectByIDMutex       sync.RWMutex
 	getObjectByIDArgsForCall []struct {
@@ -50,6 +104,64 @@ type FakeStoreReader struct {
 		result1 models.Object
 		result2 error
 	}
+	GetObjectChangesStub        func(context.Context, time.Time) (store.Iterator, []string, error)
+	getObjectChangesMutex       sync.RWMutex
+	getObjectChangesArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+	}
+	getObjectChangesReturns struct {
+		result1 store.Iterator
+		result2 []string
+		result3 error
+	}
+	getObjectChangesReturnsOnCall map[int]struct {
+		result1 store.Iterator
+		result2 []string
+		result3 error
+	}
+	GetObjectRelationsStub        func(context.Context, models.ObjectNode) ([]models.ObjectRelation, error)
+	getObjectRelationsMutex       sync.RWMutex
+	getObjectRelationsArgsForCall []struct {
+		arg1 context.Context
+		arg2 models.ObjectNode
+	}
+	getObjectRelationsReturns struct {
+		result1 []models.ObjectRelation
+		result2 error
+	}
+	getObjectRelationsReturnsOnCall map[int]struct {
+		result1 []models.ObjectRelation
+		result2 error
+	}
+	GetObjectTransitionsStub        func(context.Context, string) ([]models.ObjectTransition, error)
+	getObjectTransitionsMutex       sync.RWMutex
+	getObjectTransitionsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getObjectTransitionsReturns struct {
+		result1 []models.ObjectTransition
+		result2 error
+	}
+	getObjectTransitionsReturnsOnCall map[int]struct {
+		result1 []models.ObjectTransition
+		result2 error
+	}
+	GetObjectTransitionsAsOfStub        func(context.Context, time.Time) ([]models.ObjectTransition, error)
+	getObjectTransitionsAsOfMutex       sync.RWMutex
+	getObjectTransitionsAsOfArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+	}
+	getObjectTransitionsAsOfReturns struct {
+		result1 []models.ObjectTransition
+		result2 error
+	}
+	getObjectTransitionsAsOfReturnsOnCall map[int]struct {
+		result1 []models.ObjectTransition
+		result2 error
+	}
 	GetObjectsStub        func(context.Context, []string, store.QueryOption) (store.Iterator, error)
 	getObjectsMutex       sync.RWMutex
 	getObjectsArgsForCall []struct {
@@ -65,6 +177,36 @@ type FakeStoreReader struct {
 		result1 store.Iterator
 		result2 error
 	}
+	GetObjectsByNameStub        func(context.Context, string, string, string) ([]models.Object, error)
+	getObjectsByNameMutex       sync.RWMutex
+	getObjectsByNameArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	getObjectsByNameReturns struct {
+		result1 []models.Object
+		result2 error
+	}
+	getObjectsByNameReturnsOnCall map[int]struct {
+		result1 []models.Object
+		result2 error
+	}
+	GetObjectsByNodeStub        func(context.Context, models.ObjectNode) ([]models.Object, error)
+	getObjectsByNodeMutex       sync.RWMutex
+	getObjectsByNodeArgsForCall []struct {
+		arg1 context.Context
+		arg2 models.ObjectNode
+	}
+	getObjectsByNodeReturns struct {
+		result1 []models.Object
+		result2 error
+	}
+	getObjectsByNodeReturnsOnCall map[int]struct {
+		result1 []models.Object
+		result2 error
+	}
 	GetRoleBindingsStub        func(context.Context) ([]models.RoleBinding, error)
 	getRoleBindingsMutex       sync.RWMutex
 	getRoleBindingsArgsForCall []struct {
@@ -91,6 +233,33 @@ type FakeStoreReader struct {
 		result1 []models.Role
 		result2 error
 	}
+	GetSavedQueriesStub        func(context.Context) ([]models.SavedQuery, error)
+	getSavedQueriesMutex       sync.RWMutex
+	getSavedQueriesArgsForCall []struct {
+		arg1 context.Context
+	}
+	getSavedQueriesReturns struct {
+		result1 []models.SavedQuery
+		result2 error
+	}
+	getSavedQueriesReturnsOnCall map[int]struct {
+		result1 []models.SavedQuery
+		result2 error
+	}
+	GetSavedQueryStub        func(context.Context, string) (models.SavedQuery, error)
+	getSavedQueryMutex       sync.RWMutex
+	getSavedQueryArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getSavedQueryReturns struct {
+		result1 models.SavedQuery
+		result2 error
+	}
+	getSavedQueryReturnsOnCall map[int]struct {
+		result1 models.SavedQuery
+		result2 error
+	}
 	GetTenantsStub        func(context.Context) ([]models.Tenant, error)
 	getTenantsMutex       sync.RWMutex
 	getTenantsArgsForCall []struct {
@@ -108,6 +277,70 @@ type FakeStoreReader struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeStoreReader) CountObjectsByCluster(arg1 context.Context) (map[string]int, error) {
+	fake.countObjectsByClusterMutex.Lock()
+	ret, specificReturn := fake.countObjectsByClusterReturnsOnCall[len(fake.countObjectsByClusterArgsForCall)]
+	fake.countObjectsByClusterArgsForCall = append(fake.countObjectsByClusterArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.CountObjectsByClusterStub
+	fakeReturns := fake.countObjectsByClusterReturns
+	fake.recordInvocation("CountObjectsByCluster", []interface{}{arg1})
+	fake.countObjectsByClusterMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStoreReader) CountObjectsByClusterCallCount() int {
+	fake.countObjectsByClusterMutex.RLock()
+	defer fake.countObjectsByClusterMutex.RUnlock()
+	return len(fake.countObjectsByClusterArgsForCall)
+}
+
+func (fake *FakeStoreReader) CountObjectsByClusterCalls(stub func(context.Context) (map[string]int, error)) {
+	fake.countObjectsByClusterMutex.Lock()
+	defer fake.countObjectsByClusterMutex.Unlock()
+	fake.CountObjectsByClusterStub = stub
+}
+
+func (fake *FakeStoreReader) CountObjectsByClusterArgsForCall(i int) context.Context {
+	fake.countObjectsByClusterMutex.RLock()
+	defer fake.countObjectsByClusterMutex.RUnlock()
+	argsForCall := fake.countObjectsByClusterArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStoreReader) CountObjectsByClusterReturns(result1 map[string]int, result2 error) {
+	fake.countObjectsByClusterMutex.Lock()
+	defer fake.countObjectsByClusterMutex.Unlock()
+	fake.CountObjectsByClusterStub = nil
+	fake.countObjectsByClusterReturns = struct {
+		result1 map[string]int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStoreReader) CountObjectsByClusterReturnsOnCall(i int, result1 map[string]int, result2 error) {
+	fake.countObjectsByClusterMutex.Lock()
+	defer fake.countObjectsByClusterMutex.Unlock()
+	fake.CountObjectsByClusterStub = nil
+	if fake.countObjectsByClusterReturnsOnCall == nil {
+		fake.countObjectsByClusterReturnsOnCall = make(map[int]struct {
+			result1 map[string]int
+			result2 error
+		})
+	}
+	fake.countObjectsByClusterReturnsOnCall[i] = struct {
+		result1 map[string]int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStoreReader) GetAccessRules(arg1 context.Context) ([]models.AccessRule, error) {
 	fake.getAccessRulesMutex.Lock()
 	ret, specificReturn := fake.getAccessRulesReturnsOnCall[len(fake.getAccessRulesArgsForCall)]
@@ -172,6 +405,135 @@ func (fake *FakeStoreReader) GetAccessRulesReturnsOnCall(i int, result1 []models
 	}{result1, result2}
 }
 
+func (fake *FakeStoreReader) GetAlertRule(arg1 context.Context, arg2 string) (models.AlertRule, error) {
+	fake.getAlertRuleMutex.Lock()
+	ret, specificReturn := fake.getAlertRuleReturnsOnCall[len(fake.getAlertRuleArgsForCall)]
+	fake.getAlertRuleArgsForCall = append(fake.getAlertRuleArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetAlertRuleStub
+	fakeReturns := fake.getAlertRuleReturns
+	fake.recordInvocation("GetAlertRule", []interface{}{arg1, arg2})
+	fake.getAlertRuleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStoreReader) GetAlertRuleCallCount() int {
+	fake.getAlertRuleMutex.RLock()
+	defer fake.getAlertRuleMutex.RUnlock()
+	return len(fake.getAlertRuleArgsForCall)
+}
+
+func (fake *FakeStoreReader) GetAlertRuleCalls(stub func(context.Context, string) (models.AlertRule, error)) {
+	fake.getAlertRuleMutex.Lock()
+	defer fake.getAlertRuleMutex.Unlock()
+	fake.GetAlertRuleStub = stub
+}
+
+func (fake *FakeStoreReader) GetAlertRuleArgsForCall(i int) (context.Context, string) {
+	fake.getAlertRuleMutex.RLock()
+	defer fake.getAlertRuleMutex.RUnlock()
+	argsForCall := fake.getAlertRuleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStoreReader) GetAlertRuleReturns(result1 models.AlertRule, result2 error) {
+	fake.getAlertRuleMutex.Lock()
+	defer fake.getAlertRuleMutex.Unlock()
+	fake.GetAlertRuleStub = nil
+	fake.getAlertRuleReturns = struct {
+		result1 models.AlertRule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStoreReader) GetAlertRuleReturnsOnCall(i int, result1 models.AlertRule, result2 error) {
+	fake.getAlertRuleMutex.Lock()
+	defer fake.getAlertRuleMutex.Unlock()
+	fake.GetAlertRuleStub = nil
+	if fake.getAlertRuleReturnsOnCall == nil {
+		fake.getAlertRuleReturnsOnCall = make(map[int]struct {
+			result1 models.AlertRule
+			result2 error
+		})
+	}
+	fake.getAlertRuleReturnsOnCall[i] = struct {
+		result1 models.AlertRule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStoreReader) GetAlertRules(arg1 context.Context) ([]models.AlertRule, error) {
+	fake.getAlertRulesMutex.Lock()
+	ret, specificReturn := fake.getAlertRulesReturnsOnCall[len(fake.getAlertRulesArgsForCall)]
+	fake.getAlertRulesArgsForCall = append(fake.getAlertRulesArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetAlertRulesStub
+	fakeReturns := fake.getAlertRulesReturns
+	fake.recordInvocation("GetAlertRules", []interface{}{arg1})
+	fake.getAlertRulesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStoreReader) GetAlertRulesCallCount() int {
+	fake.getAlertRulesMutex.RLock()
+	defer fake.getAlertRulesMutex.RUnlock()
+	return len(fake.getAlertRulesArgsForCall)
+}
+
+func (fake *FakeStoreReader) GetAlertRulesCalls(stub func(context.Context) ([]models.AlertRule, error)) {
+	fake.getAlertRulesMutex.Lock()
+	defer fake.getAlertRulesMutex.Unlock()
+	fake.GetAlertRulesStub = stub
+}
+
+func (fake *FakeStoreReader) GetAlertRulesArgsForCall(i int) context.Context {
+	fake.getAlertRulesMutex.RLock()
+	defer fake.getAlertRulesMutex.RUnlock()
+	argsForCall := fake.getAlertRulesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStoreReader) GetAlertRulesReturns(result1 []models.AlertRule, result2 error) {
+	fake.getAlertRulesMutex.Lock()
+	defer fake.getAlertRulesMutex.Unlock()
+	fake.GetAlertRulesStub = nil
+	fake.getAlertRulesReturns = struct {
+		result1 []models.AlertRule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStoreReader) GetAlertRulesReturnsOnCall(i int, result1 []models.AlertRule, result2 error) {
+	fake.getAlertRulesMutex.Lock()
+	defer fake.getAlertRulesMutex.Unlock()
+	fake.GetAlertRulesStub = nil
+	if fake.getAlertRulesReturnsOnCall == nil {
+		fake.getAlertRulesReturnsOnCall = make(map[int]struct {
+			result1 []models.AlertRule
+			result2 error
+		})
+	}
+	fake.getAlertRulesReturnsOnCall[i] = struct {
+		result1 []models.AlertRule
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStoreReader) GetAllObjects(arg1 context.Context) (store.Iterator, error) {
 	fake.getAllObjectsMutex.Lock()
 	ret, specificReturn := fake.getAllObjectsReturnsOnCall[len(fake.getAllObjectsArgsForCall)]
@@ -236,6 +598,70 @@ func (fake *FakeStoreReader) GetAllObjectsReturnsOnCall(i int, result1 store.Ite
 	}{result1, result2}
 }
 
+func (fake *FakeStoreReader) GetInventoryObjects(arg1 context.Context) (store.Iterator, error) {
+	fake.getInventoryObjectsMutex.Lock()
+	ret, specificReturn := fake.getInventoryObjectsReturnsOnCall[len(fake.getInventoryObjectsArgsForCall)]
+	fake.getInventoryObjectsArgsForCall = append(fake.getInventoryObjectsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetInventoryObjectsStub
+	fakeReturns := fake.getInventoryObjectsReturns
+	fake.recordInvocation("GetInventoryObjects", []interface{}{arg1})
+	fake.getInventoryObjectsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStoreReader) GetInventoryObjectsCallCount() int {
+	fake.getInventoryObjectsMutex.RLock()
+	defer fake.getInventoryObjectsMutex.RUnlock()
+	return len(fake.getInventoryObjectsArgsForCall)
+}
+
+func (fake *FakeStoreReader) GetInventoryObjectsCalls(stub func(context.Context) (store.Iterator, error)) {
+	fake.getInventoryObjectsMutex.Lock()
+	defer fake.getInventoryObjectsMutex.Unlock()
+	fake.GetInventoryObjectsStub = stub
+}
+
+func (fake *FakeStoreReader) GetInventoryObjectsArgsForCall(i int) context.Context {
+	fake.getInventoryObjectsMutex.RLock()
+	defer fake.getInventoryObjectsMutex.RUnlock()
+	argsForCall := fake.getInventoryObjectsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStoreReader) GetInventoryObjectsReturns(result1 store.Iterator, result2 error) {
+	fake.getInventoryObjectsMutex.Lock()
+	defer fake.getInventoryObjectsMutex.Unlock()
+	fake.GetInventoryObjectsStub = nil
+	fake.getInventoryObjectsReturns = struct {
+		result1 store.Iterator
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStoreReader) GetInventoryObjectsReturnsOnCall(i int, result1 store.Iterator, result2 error) {
+	fake.getInventoryObjectsMutex.Lock()
+	defer fake.getInventoryObjectsMutex.Unlock()
+	fake.GetInventoryObjectsStub = nil
+	if fake.getInventoryObjectsReturnsOnCall == nil {
+		fake.getInventoryObjectsReturnsOnCall = make(map[int]struct {
+			result1 store.Iterator
+			result2 error
+		})
+	}
+	fake.getInventoryObjectsReturnsOnCall[i] = struct {
+		result1 store.Iterator
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStoreReader) GetObjectByID(arg1 context.Context, arg2 string) (models.Object, error) {
 	fake.getObjectByIDMutex.Lock()
 	ret, specificReturn := fake.getObjectByIDReturnsOnCall[len(fake.getObjectByIDArgsForCall)]
@@ -301,6 +727,269 @@ func (fake *FakeStoreReader) GetObjectByIDReturnsOnCall(i int, result1 models.Ob
 	}{result1, result2}
 }
 
+func (fake *FakeStoreReader) GetObjectChanges(arg1 context.Context, arg2 time.Time) (store.Iterator, []string, error) {
+	fake.getObjectChangesMutex.Lock()
+	ret, specificReturn := fake.getObjectChangesReturnsOnCall[len(fake.getObjectChangesArgsForCall)]
+	fake.getObjectChangesArgsForCall = append(fake.getObjectChangesArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.GetObjectChangesStub
+	fakeReturns := fake.getObjectChangesReturns
+	fake.recordInvocation("GetObjectChanges", []interface{}{arg1, arg2})
+	fake.getObjectChangesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeStoreReader) GetObjectChangesCallCount() int {
+	fake.getObjectChangesMutex.RLock()
+	defer fake.getObjectChangesMutex.RUnlock()
+	return len(fake.getObjectChangesArgsForCall)
+}
+
+func (fake *FakeStoreReader) GetObjectChangesCalls(stub func(context.Context, time.Time) (store.Iterator, []string, error)) {
+	fake.getObjectChangesMutex.Lock()
+	defer fake.getObjectChangesMutex.Unlock()
+	fake.GetObjectChangesStub = stub
+}
+
+func (fake *FakeStoreReader) GetObjectChangesArgsForCall(i int) (context.Context, time.Time) {
+	fake.getObjectChangesMutex.RLock()
+	defer fake.getObjectChangesMutex.RUnlock()
+	argsForCall := fake.getObjectChangesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStoreReader) GetObjectChangesReturns(result1 store.Iterator, result2 []string, result3 error) {
+	fake.getObjectChangesMutex.Lock()
+	defer fake.getObjectChangesMutex.Unlock()
+	fake.GetObjectChangesStub = nil
+	fake.getObjectChangesReturns = struct {
+		result1 store.Iterator
+		result2 []string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStoreReader) GetObjectChangesReturnsOnCall(i int, result1 store.Iterator, result2 []string, result3 error) {
+	fake.getObjectChangesMutex.Lock()
+	defer fake.getObjectChangesMutex.Unlock()
+	fake.GetObjectChangesStub = nil
+	if fake.getObjectChangesReturnsOnCall == nil {
+		fake.getObjectChangesReturnsOnCall = make(map[int]struct {
+			result1 store.Iterator
+			result2 []string
+			result3 error
+		})
+	}
+	fake.getObjectChangesReturnsOnCall[i] = struct {
+		result1 store.Iterator
+		result2 []string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStoreReader) GetObjectRelations(arg1 context.Context, arg2 models.ObjectNode) ([]models.ObjectRelation, error) {
+	fake.getObjectRelationsMutex.Lock()
+	ret, specificReturn := fake.getObjectRelationsReturnsOnCall[len(fake.getObjectRelationsArgsForCall)]
+	fake.getObjectRelationsArgsForCall = append(fake.getObjectRelationsArgsForCall, struct {
+		arg1 context.Context
+		arg2 models.ObjectNode
+	}{arg1, arg2})
+	stub := fake.GetObjectRelationsStub
+	fakeReturns := fake.getObjectRelationsReturns
+	fake.recordInvocation("GetObjectRelations", []interface{}{arg1, arg2})
+	fake.getObjectRelationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStoreReader) GetObjectRelationsCallCount() int {
+	fake.getObjectRelationsMutex.RLock()
+	defer fake.getObjectRelationsMutex.RUnlock()
+	return len(fake.getObjectRelationsArgsForCall)
+}
+
+func (fake *FakeStoreReader) GetObjectRelationsCalls(stub func(context.Context, models.ObjectNode) ([]models.ObjectRelation, error)) {
+	fake.getObjectRelationsMutex.Lock()
+	defer fake.getObjectRelationsMutex.Unlock()
+	fake.GetObjectRelationsStub = stub
+}
+
+func (fake *FakeStoreReader) GetObjectRelationsArgsForCall(i int) (context.Context, models.ObjectNode) {
+	fake.getObjectRelationsMutex.RLock()
+	defer fake.getObjectRelationsMutex.RUnlock()
+	argsForCall := fake.getObjectRelationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStoreReader) GetObjectRelationsReturns(result1 []models.ObjectRelation, result2 error) {
+	fake.getObjectRelationsMutex.Lock()
+	defer fake.getObjectRelationsMutex.Unlock()
+	fake.GetObjectRelationsStub = nil
+	fake.getObjectRelationsReturns = struct {
+		result1 []models.ObjectRelation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStoreReader) GetObjectRelationsReturnsOnCall(i int, result1 []models.ObjectRelation, result2 error) {
+	fake.getObjectRelationsMutex.Lock()
+	defer fake.getObjectRelationsMutex.Unlock()
+	fake.GetObjectRelationsStub = nil
+	if fake.getObjectRelationsReturnsOnCall == nil {
+		fake.getObjectRelationsReturnsOnCall = make(map[int]struct {
+			result1 []models.ObjectRelation
+			result2 error
+		})
+	}
+	fake.getObjectRelationsReturnsOnCall[i] = struct {
+		result1 []models.ObjectRelation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStoreReader) GetObjectTransitions(arg1 context.Context, arg2 string) ([]models.ObjectTransition, error) {
+	fake.getObjectTransitionsMutex.Lock()
+	ret, specificReturn := fake.getObjectTransitionsReturnsOnCall[len(fake.getObjectTransitionsArgsForCall)]
+	fake.getObjectTransitionsArgsForCall = append(fake.getObjectTransitionsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetObjectTransitionsStub
+	fakeReturns := fake.getObjectTransitionsReturns
+	fake.recordInvocation("GetObjectTransitions", []interface{}{arg1, arg2})
+	fake.getObjectTransitionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStoreReader) GetObjectTransitionsCallCount() int {
+	fake.getObjectTransitionsMutex.RLock()
+	defer fake.getObjectTransitionsMutex.RUnlock()
+	return len(fake.getObjectTransitionsArgsForCall)
+}
+
+func (fake *FakeStoreReader) GetObjectTransitionsCalls(stub func(context.Context, string) ([]models.ObjectTransition, error)) {
+	fake.getObjectTransitionsMutex.Lock()
+	defer fake.getObjectTransitionsMutex.Unlock()
+	fake.GetObjectTransitionsStub = stub
+}
+
+func (fake *FakeStoreReader) GetObjectTransitionsArgsForCall(i int) (context.Context, string) {
+	fake.getObjectTransitionsMutex.RLock()
+	defer fake.getObjectTransitionsMutex.RUnlock()
+	argsForCall := fake.getObjectTransitionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStoreReader) GetObjectTransitionsReturns(result1 []models.ObjectTransition, result2 error) {
+	fake.getObjectTransitionsMutex.Lock()
+	defer fake.getObjectTransitionsMutex.Unlock()
+	fake.GetObjectTransitionsStub = nil
+	fake.getObjectTransitionsReturns = struct {
+		result1 []models.ObjectTransition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStoreReader) GetObjectTransitionsReturnsOnCall(i int, result1 []models.ObjectTransition, result2 error) {
+	fake.getObjectTransitionsMutex.Lock()
+	defer fake.getObjectTransitionsMutex.Unlock()
+	fake.GetObjectTransitionsStub = nil
+	if fake.getObjectTransitionsReturnsOnCall == nil {
+		fake.getObjectTransitionsReturnsOnCall = make(map[int]struct {
+			result1 []models.ObjectTransition
+			result2 error
+		})
+	}
+	fake.getObjectTransitionsReturnsOnCall[i] = struct {
+		result1 []models.ObjectTransition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStoreReader) GetObjectTransitionsAsOf(arg1 context.Context, arg2 time.Time) ([]models.ObjectTransition, error) {
+	fake.getObjectTransitionsAsOfMutex.Lock()
+	ret, specificReturn := fake.getObjectTransitionsAsOfReturnsOnCall[len(fake.getObjectTransitionsAsOfArgsForCall)]
+	fake.getObjectTransitionsAsOfArgsForCall = append(fake.getObjectTransitionsAsOfArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.GetObjectTransitionsAsOfStub
+	fakeReturns := fake.getObjectTransitionsAsOfReturns
+	fake.recordInvocation("GetObjectTransitionsAsOf", []interface{}{arg1, arg2})
+	fake.getObjectTransitionsAsOfMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStoreReader) GetObjectTransitionsAsOfCallCount() int {
+	fake.getObjectTransitionsAsOfMutex.RLock()
+	defer fake.getObjectTransitionsAsOfMutex.RUnlock()
+	return len(fake.getObjectTransitionsAsOfArgsForCall)
+}
+
+func (fake *FakeStoreReader) GetObjectTransitionsAsOfCalls(stub func(context.Context, time.Time) ([]models.ObjectTransition, error)) {
+	fake.getObjectTransitionsAsOfMutex.Lock()
+	defer fake.getObjectTransitionsAsOfMutex.Unlock()
+	fake.GetObjectTransitionsAsOfStub = stub
+}
+
+func (fake *FakeStoreReader) GetObjectTransitionsAsOfArgsForCall(i int) (context.Context, time.Time) {
+	fake.getObjectTransitionsAsOfMutex.RLock()
+	defer fake.getObjectTransitionsAsOfMutex.RUnlock()
+	argsForCall := fake.getObjectTransitionsAsOfArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStoreReader) GetObjectTransitionsAsOfReturns(result1 []models.ObjectTransition, result2 error) {
+	fake.getObjectTransitionsAsOfMutex.Lock()
+	defer fake.getObjectTransitionsAsOfMutex.Unlock()
+	fake.GetObjectTransitionsAsOfStub = nil
+	fake.getObjectTransitionsAsOfReturns = struct {
+		result1 []models.ObjectTransition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStoreReader) GetObjectTransitionsAsOfReturnsOnCall(i int, result1 []models.ObjectTransition, result2 error) {
+	fake.getObjectTransitionsAsOfMutex.Lock()
+	defer fake.getObjectTransitionsAsOfMutex.Unlock()
+	fake.GetObjectTransitionsAsOfStub = nil
+	if fake.getObjectTransitionsAsOfReturnsOnCall == nil {
+		fake.getObjectTransitionsAsOfReturnsOnCall = make(map[int]struct {
+			result1 []models.ObjectTransition
+			result2 error
+		})
+	}
+	fake.getObjectTransitionsAsOfReturnsOnCall[i] = struct {
+		result1 []models.ObjectTransition
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStoreReader) GetObjects(arg1 context.Context, arg2 []string, arg3 store.QueryOption) (store.Iterator, error) {
 	var arg2Copy []string
 	if arg2 != nil {
@@ -372,6 +1061,138 @@ func (fake *FakeStoreReader) GetObjectsReturnsOnCall(i int, result1 store.Iterat
 	}{result1, result2}
 }
 
+func (fake *FakeStoreReader) GetObjectsByName(arg1 context.Context, arg2 string, arg3 string, arg4 string) ([]models.Object, error) {
+	fake.getObjectsByNameMutex.Lock()
+	ret, specificReturn := fake.getObjectsByNameReturnsOnCall[len(fake.getObjectsByNameArgsForCall)]
+	fake.getObjectsByNameArgsForCall = append(fake.getObjectsByNameArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetObjectsByNameStub
+	fakeReturns := fake.getObjectsByNameReturns
+	fake.recordInvocation("GetObjectsByName", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getObjectsByNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStoreReader) GetObjectsByNameCallCount() int {
+	fake.getObjectsByNameMutex.RLock()
+	defer fake.getObjectsByNameMutex.RUnlock()
+	return len(fake.getObjectsByNameArgsForCall)
+}
+
+func (fake *FakeStoreReader) GetObjectsByNameCalls(stub func(context.Context, string, string, string) ([]models.Object, error)) {
+	fake.getObjectsByNameMutex.Lock()
+	defer fake.getObjectsByNameMutex.Unlock()
+	fake.GetObjectsByNameStub = stub
+}
+
+func (fake *FakeStoreReader) GetObjectsByNameArgsForCall(i int) (context.Context, string, string, string) {
+	fake.getObjectsByNameMutex.RLock()
+	defer fake.getObjectsByNameMutex.RUnlock()
+	argsForCall := fake.getObjectsByNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStoreReader) GetObjectsByNameReturns(result1 []models.Object, result2 error) {
+	fake.getObjectsByNameMutex.Lock()
+	defer fake.getObjectsByNameMutex.Unlock()
+	fake.GetObjectsByNameStub = nil
+	fake.getObjectsByNameReturns = struct {
+		result1 []models.Object
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStoreReader) GetObjectsByNameReturnsOnCall(i int, result1 []models.Object, result2 error) {
+	fake.getObjectsByNameMutex.Lock()
+	defer fake.getObjectsByNameMutex.Unlock()
+	fake.GetObjectsByNameStub = nil
+	if fake.getObjectsByNameReturnsOnCall == nil {
+		fake.getObjectsByNameReturnsOnCall = make(map[int]struct {
+			result1 []models.Object
+			result2 error
+		})
+	}
+	fake.getObjectsByNameReturnsOnCall[i] = struct {
+		result1 []models.Object
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStoreReader) GetObjectsByNode(arg1 context.Context, arg2 models.ObjectNode) ([]models.Object, error) {
+	fake.getObjectsByNodeMutex.Lock()
+	ret, specificReturn := fake.getObjectsByNodeReturnsOnCall[len(fake.getObjectsByNodeArgsForCall)]
+	fake.getObjectsByNodeArgsForCall = append(fake.getObjectsByNodeArgsForCall, struct {
+		arg1 context.Context
+		arg2 models.ObjectNode
+	}{arg1, arg2})
+	stub := fake.GetObjectsByNodeStub
+	fakeReturns := fake.getObjectsByNodeReturns
+	fake.recordInvocation("GetObjectsByNode", []interface{}{arg1, arg2})
+	fake.getObjectsByNodeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStoreReader) GetObjectsByNodeCallCount() int {
+	fake.getObjectsByNodeMutex.RLock()
+	defer fake.getObjectsByNodeMutex.RUnlock()
+	return len(fake.getObjectsByNodeArgsForCall)
+}
+
+func (fake *FakeStoreReader) GetObjectsByNodeCalls(stub func(context.Context, models.ObjectNode) ([]models.Object, error)) {
+	fake.getObjectsByNodeMutex.Lock()
+	defer fake.getObjectsByNodeMutex.Unlock()
+	fake.GetObjectsByNodeStub = stub
+}
+
+func (fake *FakeStoreReader) GetObjectsByNodeArgsForCall(i int) (context.Context, models.ObjectNode) {
+	fake.getObjectsByNodeMutex.RLock()
+	defer fake.getObjectsByNodeMutex.RUnlock()
+	argsForCall := fake.getObjectsByNodeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStoreReader) GetObjectsByNodeReturns(result1 []models.Object, result2 error) {
+	fake.getObjectsByNodeMutex.Lock()
+	defer fake.getObjectsByNodeMutex.Unlock()
+	fake.GetObjectsByNodeStub = nil
+	fake.getObjectsByNodeReturns = struct {
+		result1 []models.Object
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStoreReader) GetObjectsByNodeReturnsOnCall(i int, result1 []models.Object, result2 error) {
+	fake.getObjectsByNodeMutex.Lock()
+	defer fake.getObjectsByNodeMutex.Unlock()
+	fake.GetObjectsByNodeStub = nil
+	if fake.getObjectsByNodeReturnsOnCall == nil {
+		fake.getObjectsByNodeReturnsOnCall = make(map[int]struct {
+			result1 []models.Object
+			result2 error
+		})
+	}
+	fake.getObjectsByNodeReturnsOnCall[i] = struct {
+		result1 []models.Object
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStoreReader) GetRoleBindings(arg1 context.Context) ([]models.RoleBinding, error) {
 	fake.getRoleBindingsMutex.Lock()
 	ret, specificReturn := fake.getRoleBindingsReturnsOnCall[len(fake.getRoleBindingsArgsForCall)]
@@ -500,6 +1321,135 @@ func (fake *FakeStoreReader) GetRolesReturnsOnCall(i int, result1 []models.Role,
 	}{result1, result2}
 }
 
+func (fake *FakeStoreReader) GetSavedQueries(arg1 context.Context) ([]models.SavedQuery, error) {
+	fake.getSavedQueriesMutex.Lock()
+	ret, specificReturn := fake.getSavedQueriesReturnsOnCall[len(fake.getSavedQueriesArgsForCall)]
+	fake.getSavedQueriesArgsForCall = append(fake.getSavedQueriesArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetSavedQueriesStub
+	fakeReturns := fake.getSavedQueriesReturns
+	fake.recordInvocation("GetSavedQueries", []interface{}{arg1})
+	fake.getSavedQueriesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStoreReader) GetSavedQueriesCallCount() int {
+	fake.getSavedQueriesMutex.RLock()
+	defer fake.getSavedQueriesMutex.RUnlock()
+	return len(fake.getSavedQueriesArgsForCall)
+}
+
+func (fake *FakeStoreReader) GetSavedQueriesCalls(stub func(context.Context) ([]models.SavedQuery, error)) {
+	fake.getSavedQueriesMutex.Lock()
+	defer fake.getSavedQueriesMutex.Unlock()
+	fake.GetSavedQueriesStub = stub
+}
+
+func (fake *FakeStoreReader) GetSavedQueriesArgsForCall(i int) context.Context {
+	fake.getSavedQueriesMutex.RLock()
+	defer fake.getSavedQueriesMutex.RUnlock()
+	argsForCall := fake.getSavedQueriesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStoreReader) GetSavedQueriesReturns(result1 []models.SavedQuery, result2 error) {
+	fake.getSavedQueriesMutex.Lock()
+	defer fake.getSavedQueriesMutex.Unlock()
+	fake.GetSavedQueriesStub = nil
+	fake.getSavedQueriesReturns = struct {
+		result1 []models.SavedQuery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStoreReader) GetSavedQueriesReturnsOnCall(i int, result1 []models.SavedQuery, result2 error) {
+	fake.getSavedQueriesMutex.Lock()
+	defer fake.getSavedQueriesMutex.Unlock()
+	fake.GetSavedQueriesStub = nil
+	if fake.getSavedQueriesReturnsOnCall == nil {
+		fake.getSavedQueriesReturnsOnCall = make(map[int]struct {
+			result1 []models.SavedQuery
+			result2 error
+		})
+	}
+	fake.getSavedQueriesReturnsOnCall[i] = struct {
+		result1 []models.SavedQuery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStoreReader) GetSavedQuery(arg1 context.Context, arg2 string) (models.SavedQuery, error) {
+	fake.getSavedQueryMutex.Lock()
+	ret, specificReturn := fake.getSavedQueryReturnsOnCall[len(fake.getSavedQueryArgsForCall)]
+	fake.getSavedQueryArgsForCall = append(fake.getSavedQueryArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetSavedQueryStub
+	fakeReturns := fake.getSavedQueryReturns
+	fake.recordInvocation("GetSavedQuery", []interface{}{arg1, arg2})
+	fake.getSavedQueryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStoreReader) GetSavedQueryCallCount() int {
+	fake.getSavedQueryMutex.RLock()
+	defer fake.getSavedQueryMutex.RUnlock()
+	return len(fake.getSavedQueryArgsForCall)
+}
+
+func (fake *FakeStoreReader) GetSavedQueryCalls(stub func(context.Context, string) (models.SavedQuery, error)) {
+	fake.getSavedQueryMutex.Lock()
+	defer fake.getSavedQueryMutex.Unlock()
+	fake.GetSavedQueryStub = stub
+}
+
+func (fake *FakeStoreReader) GetSavedQueryArgsForCall(i int) (context.Context, string) {
+	fake.getSavedQueryMutex.RLock()
+	defer fake.getSavedQueryMutex.RUnlock()
+	argsForCall := fake.getSavedQueryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStoreReader) GetSavedQueryReturns(result1 models.SavedQuery, result2 error) {
+	fake.getSavedQueryMutex.Lock()
+	defer fake.getSavedQueryMutex.Unlock()
+	fake.GetSavedQueryStub = nil
+	fake.getSavedQueryReturns = struct {
+		result1 models.SavedQuery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStoreReader) GetSavedQueryReturnsOnCall(i int, result1 models.SavedQuery, result2 error) {
+	fake.getSavedQueryMutex.Lock()
+	defer fake.getSavedQueryMutex.Unlock()
+	fake.GetSavedQueryStub = nil
+	if fake.getSavedQueryReturnsOnCall == nil {
+		fake.getSavedQueryReturnsOnCall = make(map[int]struct {
+			result1 models.SavedQuery
+			result2 error
+		})
+	}
+	fake.getSavedQueryReturnsOnCall[i] = struct {
+		result1 models.SavedQuery
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStoreReader) GetTenants(arg1 context.Context) ([]models.Tenant, error) {
 	fake.getTenantsMutex.Lock()
 	ret, specificReturn := fake.getTenantsReturnsOnCall[len(fake.getTenantsArgsForCall)]
@@ -567,18 +1517,42 @@ func (fake *FakeStoreReader) GetTenantsReturnsOnCall(i int, result1 []models.Ten
 func (fake *FakeStoreReader) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.countObjectsByClusterMutex.RLock()
+	defer fake.countObjectsByClusterMutex.RUnlock()
 	fake.getAccessRulesMutex.RLock()
 	defer fake.getAccessRulesMutex.RUnlock()
+	fake.getAlertRuleMutex.RLock()
+	defer fake.getAlertRuleMutex.RUnlock()
+	fake.getAlertRulesMutex.RLock()
+	defer fake.getAlertRulesMutex.RUnlock()
 	fake.getAllObjectsMutex.RLock()
 	defer fake.getAllObjectsMutex.RUnlock()
+	fake.getInventoryObjectsMutex.RLock()
+	defer fake.getInventoryObjectsMutex.RUnlock()
 	fake.getObjectByIDMutex.RLock()
 	defer fake.getObjectByIDMutex.RUnlock()
+	fake.getObjectChangesMutex.RLock()
+	defer fake.getObjectChangesMutex.RUnlock()
+	fake.getObjectRelationsMutex.RLock()
+	defer fake.getObjectRelationsMutex.RUnlock()
+	fake.getObjectTransitionsMutex.RLock()
+	defer fake.getObjectTransitionsMutex.RUnlock()
+	fake.getObjectTransitionsAsOfMutex.RLock()
+	defer fake.getObjectTransitionsAsOfMutex.RUnlock()
 	fake.getObjectsMutex.RLock()
 	defer fake.getObjectsMutex.RUnlock()
+	fake.getObjectsByNameMutex.RLock()
+	defer fake.getObjectsByNameMutex.RUnlock()
+	fake.getObjectsByNodeMutex.RLock()
+	defer fake.getObjectsByNodeMutex.RUnlock()
 	fake.getRoleBindingsMutex.RLock()
 	defer fake.getRoleBindingsMutex.RUnlock()
 	fake.getRolesMutex.RLock()
 	defer fake.getRolesMutex.RUnlock()
+	fake.getSavedQueriesMutex.RLock()
+	defer fake.getSavedQueriesMutex.RUnlock()
+	fake.getSavedQueryMutex.RLock()
+	defer fake.getSavedQueryMutex.RUnlock()
 	fake.getTenantsMutex.RLock()
 	defer fake.getTenantsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
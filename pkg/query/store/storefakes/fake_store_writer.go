@@ -4,12 +4,26 @@ package storefakes
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/store"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 type FakeStoreWriter struct {
+	DeleteAlertRuleStub        func(context.Context, string) error
+	deleteAlertRuleMutex       sync.RWMutex
+	deleteAlertRuleArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteAlertRuleReturns struct {
+		result1 error
+	}
+	deleteAlertRuleReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DeleteAllObjectsStub        func(context.Context, []string) error
 	deleteAllObjectsMutex       sync.RWMutex
 	deleteAllObjectsArgsForCall []struct {
@@ -46,6 +60,19 @@ type FakeStoreWriter struct {
 	deleteAllRolesReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DeleteObjectTransitionsStub        func(context.Context, schema.GroupVersionKind, time.Time) error
+	deleteObjectTransitionsMutex       sync.RWMutex
+	deleteObjectTransitionsArgsForCall []struct {
+		arg1 context.Context
+		arg2 schema.GroupVersionKind
+		arg3 time.Time
+	}
+	deleteObjectTransitionsReturns struct {
+		result1 error
+	}
+	deleteObjectTransitionsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DeleteObjectsStub        func(context.Context, []models.Object) error
 	deleteObjectsMutex       sync.RWMutex
 	deleteObjectsArgsForCall []struct {
@@ -82,6 +109,18 @@ type FakeStoreWriter struct {
 	deleteRolesReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DeleteSavedQueryStub        func(context.Context, string) error
+	deleteSavedQueryMutex       sync.RWMutex
+	deleteSavedQueryArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteSavedQueryReturns struct {
+		result1 error
+	}
+	deleteSavedQueryReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DeleteTenantsStub        func(context.Context, []models.Tenant) error
 	deleteTenantsMutex       sync.RWMutex
 	deleteTenantsArgsForCall []struct {
@@ -94,6 +133,30 @@ type FakeStoreWriter struct {
 	deleteTenantsReturnsOnCall map[int]struct {
 		result1 error
 	}
+	StoreAlertRuleStub        func(context.Context, models.AlertRule) error
+	storeAlertRuleMutex       sync.RWMutex
+	storeAlertRuleArgsForCall []struct {
+		arg1 context.Context
+		arg2 models.AlertRule
+	}
+	storeAlertRuleReturns struct {
+		result1 error
+	}
+	storeAlertRuleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	StoreAlertRuleStateStub        func(context.Context, models.AlertRuleState) error
+	storeAlertRuleStateMutex       sync.RWMutex
+	storeAlertRuleStateArgsForCall []struct {
+		arg1 context.Context
+		arg2 models.AlertRuleState
+	}
+	storeAlertRuleStateReturns struct {
+		result1 error
+	}
+	storeAlertRuleStateReturnsOnCall map[int]struct {
+		result1 error
+	}
 	StoreObjectsStub        func(context.Context, []models.Object) error
 	storeObjectsMutex       sync.RWMutex
 	storeObjectsArgsForCall []struct {
@@ -130,6 +193,18 @@ type FakeStoreWriter struct {
 	storeRolesReturnsOnCall map[int]struct {
 		result1 error
 	}
+	StoreSavedQueryStub        func(context.Context, models.SavedQuery) error
+	storeSavedQueryMutex       sync.RWMutex
+	storeSavedQueryArgsForCall []struct {
+		arg1 context.Context
+		arg2 models.SavedQuery
+	}
+	storeSavedQueryReturns struct {
+		result1 error
+	}
+	storeSavedQueryReturnsOnCall map[int]struct {
+		result1 error
+	}
 	StoreTenantsStub        func(context.Context, []models.Tenant) error
 	storeTenantsMutex       sync.RWMutex
 	storeTenantsArgsForCall []struct {
@@ -146,6 +221,68 @@ type FakeStoreWriter struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeStoreWriter) DeleteAlertRule(arg1 context.Context, arg2 string) error {
+	fake.deleteAlertRuleMutex.Lock()
+	ret, specificReturn := fake.deleteAlertRuleReturnsOnCall[len(fake.deleteAlertRuleArgsForCall)]
+	fake.deleteAlertRuleArgsForCall = append(fake.deleteAlertRuleArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteAlertRuleStub
+	fakeReturns := fake.deleteAlertRuleReturns
+	fake.recordInvocation("DeleteAlertRule", []interface{}{arg1, arg2})
+	fake.deleteAlertRuleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStoreWriter) DeleteAlertRuleCallCount() int {
+	fake.deleteAlertRuleMutex.RLock()
+	defer fake.deleteAlertRuleMutex.RUnlock()
+	return len(fake.deleteAlertRuleArgsForCall)
+}
+
+func (fake *FakeStoreWriter) DeleteAlertRuleCalls(stub func(context.Context, string) error) {
+	fake.deleteAlertRuleMutex.Lock()
+	defer fake.deleteAlertRuleMutex.Unlock()
+	fake.DeleteAlertRuleStub = stub
+}
+
+func (fake *FakeStoreWriter) DeleteAlertRuleArgsForCall(i int) (context.Context, string) {
+	fake.deleteAlertRuleMutex.RLock()
+	defer fake.deleteAlertRuleMutex.RUnlock()
+	argsForCall := fake.deleteAlertRuleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStoreWriter) DeleteAlertRuleReturns(result1 error) {
+	fake.deleteAlertRuleMutex.Lock()
+	defer fake.deleteAlertRuleMutex.Unlock()
+	fake.DeleteAlertRuleStub = nil
+	fake.deleteAlertRuleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStoreWriter) DeleteAlertRuleReturnsOnCall(i int, result1 error) {
+	fake.deleteAlertRuleMutex.Lock()
+	defer fake.deleteAlertRuleMutex.Unlock()
+	fake.DeleteAlertRuleStub = nil
+	if fake.deleteAlertRuleReturnsOnCall == nil {
+		fake.deleteAlertRuleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteAlertRuleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeStoreWriter) DeleteAllObjects(arg1 context.Context, arg2 []string) error {
 	var arg2Copy []string
 	if arg2 != nil {
@@ -347,6 +484,69 @@ func (fake *FakeStoreWriter) DeleteAllRolesReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeStoreWriter) DeleteObjectTransitions(arg1 context.Context, arg2 schema.GroupVersionKind, arg3 time.Time) error {
+	fake.deleteObjectTransitionsMutex.Lock()
+	ret, specificReturn := fake.deleteObjectTransitionsReturnsOnCall[len(fake.deleteObjectTransitionsArgsForCall)]
+	fake.deleteObjectTransitionsArgsForCall = append(fake.deleteObjectTransitionsArgsForCall, struct {
+		arg1 context.Context
+		arg2 schema.GroupVersionKind
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteObjectTransitionsStub
+	fakeReturns := fake.deleteObjectTransitionsReturns
+	fake.recordInvocation("DeleteObjectTransitions", []interface{}{arg1, arg2, arg3})
+	fake.deleteObjectTransitionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStoreWriter) DeleteObjectTransitionsCallCount() int {
+	fake.deleteObjectTransitionsMutex.RLock()
+	defer fake.deleteObjectTransitionsMutex.RUnlock()
+	return len(fake.deleteObjectTransitionsArgsForCall)
+}
+
+func (fake *FakeStoreWriter) DeleteObjectTransitionsCalls(stub func(context.Context, schema.GroupVersionKind, time.Time) error) {
+	fake.deleteObjectTransitionsMutex.Lock()
+	defer fake.deleteObjectTransitionsMutex.Unlock()
+	fake.DeleteObjectTransitionsStub = stub
+}
+
+func (fake *FakeStoreWriter) DeleteObjectTransitionsArgsForCall(i int) (context.Context, schema.GroupVersionKind, time.Time) {
+	fake.deleteObjectTransitionsMutex.RLock()
+	defer fake.deleteObjectTransitionsMutex.RUnlock()
+	argsForCall := fake.deleteObjectTransitionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStoreWriter) DeleteObjectTransitionsReturns(result1 error) {
+	fake.deleteObjectTransitionsMutex.Lock()
+	defer fake.deleteObjectTransitionsMutex.Unlock()
+	fake.DeleteObjectTransitionsStub = nil
+	fake.deleteObjectTransitionsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStoreWriter) DeleteObjectTransitionsReturnsOnCall(i int, result1 error) {
+	fake.deleteObjectTransitionsMutex.Lock()
+	defer fake.deleteObjectTransitionsMutex.Unlock()
+	fake.DeleteObjectTransitionsStub = nil
+	if fake.deleteObjectTransitionsReturnsOnCall == nil {
+		fake.deleteObjectTransitionsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteObjectTransitionsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeStoreWriter) DeleteObjects(arg1 context.Context, arg2 []models.Object) error {
 	var arg2Copy []models.Object
 	if arg2 != nil {
@@ -548,6 +748,68 @@ func (fake *FakeStoreWriter) DeleteRolesReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeStoreWriter) DeleteSavedQuery(arg1 context.Context, arg2 string) error {
+	fake.deleteSavedQueryMutex.Lock()
+	ret, specificReturn := fake.deleteSavedQueryReturnsOnCall[len(fake.deleteSavedQueryArgsForCall)]
+	fake.deleteSavedQueryArgsForCall = append(fake.deleteSavedQueryArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteSavedQueryStub
+	fakeReturns := fake.deleteSavedQueryReturns
+	fake.recordInvocation("DeleteSavedQuery", []interface{}{arg1, arg2})
+	fake.deleteSavedQueryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStoreWriter) DeleteSavedQueryCallCount() int {
+	fake.deleteSavedQueryMutex.RLock()
+	defer fake.deleteSavedQueryMutex.RUnlock()
+	return len(fake.deleteSavedQueryArgsForCall)
+}
+
+func (fake *FakeStoreWriter) DeleteSavedQueryCalls(stub func(context.Context, string) error) {
+	fake.deleteSavedQueryMutex.Lock()
+	defer fake.deleteSavedQueryMutex.Unlock()
+	fake.DeleteSavedQueryStub = stub
+}
+
+func (fake *FakeStoreWriter) DeleteSavedQueryArgsForCall(i int) (context.Context, string) {
+	fake.deleteSavedQueryMutex.RLock()
+	defer fake.deleteSavedQueryMutex.RUnlock()
+	argsForCall := fake.deleteSavedQueryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStoreWriter) DeleteSavedQueryReturns(result1 error) {
+	fake.deleteSavedQueryMutex.Lock()
+	defer fake.deleteSavedQueryMutex.Unlock()
+	fake.DeleteSavedQueryStub = nil
+	fake.deleteSavedQueryReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStoreWriter) DeleteSavedQueryReturnsOnCall(i int, result1 error) {
+	fake.deleteSavedQueryMutex.Lock()
+	defer fake.deleteSavedQueryMutex.Unlock()
+	fake.DeleteSavedQueryStub = nil
+	if fake.deleteSavedQueryReturnsOnCall == nil {
+		fake.deleteSavedQueryReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteSavedQueryReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeStoreWriter) DeleteTenants(arg1 context.Context, arg2 []models.Tenant) error {
 	var arg2Copy []models.Tenant
 	if arg2 != nil {
@@ -615,6 +877,130 @@ func (fake *FakeStoreWriter) DeleteTenantsReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeStoreWriter) StoreAlertRule(arg1 context.Context, arg2 models.AlertRule) error {
+	fake.storeAlertRuleMutex.Lock()
+	ret, specificReturn := fake.storeAlertRuleReturnsOnCall[len(fake.storeAlertRuleArgsForCall)]
+	fake.storeAlertRuleArgsForCall = append(fake.storeAlertRuleArgsForCall, struct {
+		arg1 context.Context
+		arg2 models.AlertRule
+	}{arg1, arg2})
+	stub := fake.StoreAlertRuleStub
+	fakeReturns := fake.storeAlertRuleReturns
+	fake.recordInvocation("StoreAlertRule", []interface{}{arg1, arg2})
+	fake.storeAlertRuleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStoreWriter) StoreAlertRuleCallCount() int {
+	fake.storeAlertRuleMutex.RLock()
+	defer fake.storeAlertRuleMutex.RUnlock()
+	return len(fake.storeAlertRuleArgsForCall)
+}
+
+func (fake *FakeStoreWriter) StoreAlertRuleCalls(stub func(context.Context, models.AlertRule) error) {
+	fake.storeAlertRuleMutex.Lock()
+	defer fake.storeAlertRuleMutex.Unlock()
+	fake.StoreAlertRuleStub = stub
+}
+
+func (fake *FakeStoreWriter) StoreAlertRuleArgsForCall(i int) (context.Context, models.AlertRule) {
+	fake.storeAlertRuleMutex.RLock()
+	defer fake.storeAlertRuleMutex.RUnlock()
+	argsForCall := fake.storeAlertRuleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStoreWriter) StoreAlertRuleReturns(result1 error) {
+	fake.storeAlertRuleMutex.Lock()
+	defer fake.storeAlertRuleMutex.Unlock()
+	fake.StoreAlertRuleStub = nil
+	fake.storeAlertRuleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStoreWriter) StoreAlertRuleReturnsOnCall(i int, result1 error) {
+	fake.storeAlertRuleMutex.Lock()
+	defer fake.storeAlertRuleMutex.Unlock()
+	fake.StoreAlertRuleStub = nil
+	if fake.storeAlertRuleReturnsOnCall == nil {
+		fake.storeAlertRuleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.storeAlertRuleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStoreWriter) StoreAlertRuleState(arg1 context.Context, arg2 models.AlertRuleState) error {
+	fake.storeAlertRuleStateMutex.Lock()
+	ret, specificReturn := fake.storeAlertRuleStateReturnsOnCall[len(fake.storeAlertRuleStateArgsForCall)]
+	fake.storeAlertRuleStateArgsForCall = append(fake.storeAlertRuleStateArgsForCall, struct {
+		arg1 context.Context
+		arg2 models.AlertRuleState
+	}{arg1, arg2})
+	stub := fake.StoreAlertRuleStateStub
+	fakeReturns := fake.storeAlertRuleStateReturns
+	fake.recordInvocation("StoreAlertRuleState", []interface{}{arg1, arg2})
+	fake.storeAlertRuleStateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStoreWriter) StoreAlertRuleStateCallCount() int {
+	fake.storeAlertRuleStateMutex.RLock()
+	defer fake.storeAlertRuleStateMutex.RUnlock()
+	return len(fake.storeAlertRuleStateArgsForCall)
+}
+
+func (fake *FakeStoreWriter) StoreAlertRuleStateCalls(stub func(context.Context, models.AlertRuleState) error) {
+	fake.storeAlertRuleStateMutex.Lock()
+	defer fake.storeAlertRuleStateMutex.Unlock()
+	fake.StoreAlertRuleStateStub = stub
+}
+
+func (fake *FakeStoreWriter) StoreAlertRuleStateArgsForCall(i int) (context.Context, models.AlertRuleState) {
+	fake.storeAlertRuleStateMutex.RLock()
+	defer fake.storeAlertRuleStateMutex.RUnlock()
+	argsForCall := fake.storeAlertRuleStateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStoreWriter) StoreAlertRuleStateReturns(result1 error) {
+	fake.storeAlertRuleStateMutex.Lock()
+	defer fake.storeAlertRuleStateMutex.Unlock()
+	fake.StoreAlertRuleStateStub = nil
+	fake.storeAlertRuleStateReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStoreWriter) StoreAlertRuleStateReturnsOnCall(i int, result1 error) {
+	fake.storeAlertRuleStateMutex.Lock()
+	defer fake.storeAlertRuleStateMutex.Unlock()
+	fake.StoreAlertRuleStateStub = nil
+	if fake.storeAlertRuleStateReturnsOnCall == nil {
+		fake.storeAlertRuleStateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.storeAlertRuleStateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeStoreWriter) StoreObjects(arg1 context.Context, arg2 []models.Object) error {
 	var arg2Copy []models.Object
 	if arg2 != nil {
@@ -816,6 +1202,68 @@ func (fake *FakeStoreWriter) StoreRolesReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeStoreWriter) StoreSavedQuery(arg1 context.Context, arg2 models.SavedQuery) error {
+	fake.storeSavedQueryMutex.Lock()
+	ret, specificReturn := fake.storeSavedQueryReturnsOnCall[len(fake.storeSavedQueryArgsForCall)]
+	fake.storeSavedQueryArgsForCall = append(fake.storeSavedQueryArgsForCall, struct {
+		arg1 context.Context
+		arg2 models.SavedQuery
+	}{arg1, arg2})
+	stub := fake.StoreSavedQueryStub
+	fakeReturns := fake.storeSavedQueryReturns
+	fake.recordInvocation("StoreSavedQuery", []interface{}{arg1, arg2})
+	fake.storeSavedQueryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStoreWriter) StoreSavedQueryCallCount() int {
+	fake.storeSavedQueryMutex.RLock()
+	defer fake.storeSavedQueryMutex.RUnlock()
+	return len(fake.storeSavedQueryArgsForCall)
+}
+
+func (fake *FakeStoreWriter) StoreSavedQueryCalls(stub func(context.Context, models.SavedQuery) error) {
+	fake.storeSavedQueryMutex.Lock()
+	defer fake.storeSavedQueryMutex.Unlock()
+	fake.StoreSavedQueryStub = stub
+}
+
+func (fake *FakeStoreWriter) StoreSavedQueryArgsForCall(i int) (context.Context, models.SavedQuery) {
+	fake.storeSavedQueryMutex.RLock()
+	defer fake.storeSavedQueryMutex.RUnlock()
+	argsForCall := fake.storeSavedQueryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStoreWriter) StoreSavedQueryReturns(result1 error) {
+	fake.storeSavedQueryMutex.Lock()
+	defer fake.storeSavedQueryMutex.Unlock()
+	fake.StoreSavedQueryStub = nil
+	fake.storeSavedQueryReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStoreWriter) StoreSavedQueryReturnsOnCall(i int, result1 error) {
+	fake.storeSavedQueryMutex.Lock()
+	defer fake.storeSavedQueryMutex.Unlock()
+	fake.StoreSavedQueryStub = nil
+	if fake.storeSavedQueryReturnsOnCall == nil {
+		fake.storeSavedQueryReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.storeSavedQueryReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeStoreWriter) StoreTenants(arg1 context.Context, arg2 []models.Tenant) error {
 	var arg2Copy []models.Tenant
 	if arg2 != nil {
@@ -886,26 +1334,38 @@ func (fake *FakeStoreWriter) StoreTenantsReturnsOnCall(i int, result1 error) {
 func (fake *FakeStoreWriter) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.deleteAlertRuleMutex.RLock()
+	defer fake.deleteAlertRuleMutex.RUnlock()
 	fake.deleteAllObjectsMutex.RLock()
 	defer fake.deleteAllObjectsMutex.RUnlock()
 	fake.deleteAllRoleBindingsMutex.RLock()
 	defer fake.deleteAllRoleBindingsMutex.RUnlock()
 	fake.deleteAllRolesMutex.RLock()
 	defer fake.deleteAllRolesMutex.RUnlock()
+	fake.deleteObjectTransitionsMutex.RLock()
+	defer fake.deleteObjectTransitionsMutex.RUnlock()
 	fake.deleteObjectsMutex.RLock()
 	defer fake.deleteObjectsMutex.RUnlock()
 	fake.deleteRoleBindingsMutex.RLock()
 	defer fake.deleteRoleBindingsMutex.RUnlock()
 	fake.deleteRolesMutex.RLock()
 	defer fake.deleteRolesMutex.RUnlock()
+	fake.deleteSavedQueryMutex.RLock()
+	defer fake.deleteSavedQueryMutex.RUnlock()
 	fake.deleteTenantsMutex.RLock()
 	defer fake.deleteTenantsMutex.RUnlock()
+	fake.storeAlertRuleMutex.RLock()
+	defer fake.storeAlertRuleMutex.RUnlock()
+	fake.storeAlertRuleStateMutex.RLock()
+	defer fake.storeAlertRuleStateMutex.RUnlock()
 	fake.storeObjectsMutex.RLock()
 	defer fake.storeObjectsMutex.RUnlock()
 	fake.storeRoleBindingsMutex.RLock()
 	defer fake.storeRoleBindingsMutex.RUnlock()
 	fake.storeRolesMutex.RLock()
 	defer fake.storeRolesMutex.RUnlock()
+	fake.storeSavedQueryMutex.RLock()
+	defer fake.storeSavedQueryMutex.RUnlock()
 	fake.storeTenantsMutex.RLock()
 	defer fake.storeTenantsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
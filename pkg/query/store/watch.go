@@ -0,0 +1,247 @@
+package store
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+)
+
+// Watcher lets readers know when the results of a query may have changed.
+type Watcher interface {
+	// Watch subscribes to the changes written to the store and the index.
+	// The subscription must be stopped when no longer needed.
+	Watch() *Watch
+}
+
+// Broadcaster is a Watcher that gets notified of the changes made through
+// the store and the index returned by NewWatchableStore and NewWatchableIndexer.
+type Broadcaster struct {
+	mu      sync.Mutex
+	watches map[*Watch]struct{}
+}
+
+func NewBroadcaster() *Broadcaster {
+	return &Broadcaster{
+		watches: map[*Watch]struct{}{},
+	}
+}
+
+func (b *Broadcaster) Watch() *Watch {
+	w := &Watch{
+		broadcaster: b,
+		changes:     newChanges(),
+		changed:     make(chan struct{}, 1),
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.watches[w] = struct{}{}
+
+	return w
+}
+
+// notify records the ids of the upserted and removed objects in every watch. Changes
+// that may affect any object, like access rules updates, are recorded as unscoped.
+func (b *Broadcaster) notify(upserted, removed []string, unscoped bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for w := range b.watches {
+		w.add(upserted, removed, unscoped)
+	}
+}
+
+func (b *Broadcaster) stop(w *Watch) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.watches, w)
+}
+
+// Changes are the changes made since they were last taken from a watch.
+type Changes struct {
+	// Upserted are the ids of the objects added or updated.
+	Upserted map[string]bool
+	// Removed are the ids of the objects removed.
+	Removed map[string]bool
+	// Unscoped is true when there were changes that may affect any object, like access
+	// rules, tenants or index rebuilds, so the results of a query must be computed again.
+	Unscoped bool
+}
+
+func newChanges() Changes {
+	return Changes{
+		Upserted: map[string]bool{},
+		Removed:  map[string]bool{},
+	}
+}
+
+// Watch is a subscription to changes. Changes made while the subscriber is busy are
+// coalesced, so a slow subscriber never blocks writers.
+type Watch struct {
+	broadcaster *Broadcaster
+	mu          sync.Mutex
+	changes     Changes
+	changed     chan struct{}
+}
+
+// Changed receives a value when there are changes that have not been taken yet.
+func (w *Watch) Changed() <-chan struct{} {
+	return w.changed
+}
+
+// Take returns the changes made since the last call.
+func (w *Watch) Take() Changes {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	changes := w.changes
+	w.changes = newChanges()
+
+	return changes
+}
+
+// Stop unsubscribes from the changes.
+func (w *Watch) Stop() {
+	w.broadcaster.stop(w)
+}
+
+func (w *Watch) add(upserted, removed []string, unscoped bool) {
+	w.mu.Lock()
+	// The last change of an object wins.
+	for _, id := range upserted {
+		w.changes.Upserted[id] = true
+		delete(w.changes.Removed, id)
+	}
+	for _, id := range removed {
+		w.changes.Removed[id] = true
+		delete(w.changes.Upserted, id)
+	}
+	w.changes.Unscoped = w.changes.Unscoped || unscoped
+	w.mu.Unlock()
+
+	select {
+	case w.changed <- struct{}{}:
+	default:
+	}
+}
+
+// NewWatchableStore notifies the broadcaster when access rules or tenants are written,
+// as they change who can see an object or how it is presented. Objects are notified by
+// the index, that is written after the store.
+func NewWatchableStore(s Store, b *Broadcaster) Store {
+	return &watchableStore{Store: s, broadcaster: b}
+}
+
+type watchableStore struct {
+	Store
+	broadcaster *Broadcaster
+}
+
+func (s *watchableStore) StoreRoles(ctx context.Context, roles []models.Role) error {
+	return s.notify(s.Store.StoreRoles(ctx, roles))
+}
+
+func (s *watchableStore) StoreRoleBindings(ctx context.Context, roleBindings []models.RoleBinding) error {
+	return s.notify(s.Store.StoreRoleBindings(ctx, roleBindings))
+}
+
+func (s *watchableStore) StoreTenants(ctx context.Context, tenants []models.Tenant) error {
+	return s.notify(s.Store.StoreTenants(ctx, tenants))
+}
+
+func (s *watchableStore) DeleteRoles(ctx context.Context, roles []models.Role) error {
+	return s.notify(s.Store.DeleteRoles(ctx, roles))
+}
+
+func (s *watchableStore) DeleteAllRoles(ctx context.Context, clusters []string) error {
+	return s.notify(s.Store.DeleteAllRoles(ctx, clusters))
+}
+
+func (s *watchableStore) DeleteRoleBindings(ctx context.Context, roleBindings []models.RoleBinding) error {
+	return s.notify(s.Store.DeleteRoleBindings(ctx, roleBindings))
+}
+
+func (s *watchableStore) DeleteAllRoleBindings(ctx context.Context, clusters []string) error {
+	return s.notify(s.Store.DeleteAllRoleBindings(ctx, clusters))
+}
+
+func (s *watchableStore) DeleteTenants(ctx context.Context, tenants []models.Tenant) error {
+	return s.notify(s.Store.DeleteTenants(ctx, tenants))
+}
+
+func (s *watchableStore) notify(err error) error {
+	if err == nil {
+		s.broadcaster.notify(nil, nil, true)
+	}
+	return err
+}
+
+// NewWatchableIndexer notifies the broadcaster when objects are added to or removed from the index.
+func NewWatchableIndexer(idx Indexer, b *Broadcaster) Indexer {
+	return &watchableIndexer{Indexer: idx, broadcaster: b}
+}
+
+type watchableIndexer struct {
+	Indexer
+	broadcaster *Broadcaster
+}
+
+func (i *watchableIndexer) Add(ctx context.Context, objects []models.Object) error {
+	if err := i.Indexer.Add(ctx, objects); err != nil {
+		return err
+	}
+
+	i.broadcaster.notify(objectIDs(objects), nil, false)
+
+	return nil
+}
+
+func (i *watchableIndexer) Remove(ctx context.Context, objects []models.Object) error {
+	if err := i.Indexer.Remove(ctx, objects); err != nil {
+		return err
+	}
+
+	i.broadcaster.notify(nil, objectIDs(objects), false)
+	return nil
+}
+
+func (i *watchableIndexer) RemoveByQuery(ctx context.Context, q string) error {
+	if err := i.Indexer.RemoveByQuery(ctx, q); err != nil {
+		return err
+	}
+
+	i.broadcaster.notify(nil, nil, true)
+	return nil
+}
+
+func (i *watchableIndexer) Reindex(ctx context.Context) (int, error) {
+	count, err := i.Indexer.Reindex(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	i.broadcaster.notify(nil, nil, true)
+	return count, nil
+}
+
+func (i *watchableIndexer) IndexChanges(ctx context.Context, since time.Time) ([]string, []string, error) {
+	indexed, removed, err := i.Indexer.IndexChanges(ctx, since)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(indexed) > 0 || len(removed) > 0 {
+		i.broadcaster.notify(indexed, removed, false)
+	}
+	return indexed, removed, nil
+}
+
+func objectIDs(objects []models.Object) []string {
+	ids := []string{}
+	for _, obj := range objects {
+		ids = append(ids, obj.GetID())
+	}
+	return ids
+}
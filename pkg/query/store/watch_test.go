@@ -0,0 +1,83 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/store"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/store/storefakes"
+)
+
+func TestBroadcaster(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	b := store.NewBroadcaster()
+	idx := store.NewWatchableIndexer(&storefakes.FakeIndexer{}, b)
+	s := store.NewWatchableStore(&storefakes.FakeStore{}, b)
+
+	obj := models.Object{Cluster: "management", Namespace: "flux-system", Kind: "Kustomization", Name: "flux-system"}
+
+	watch := b.Watch()
+	defer watch.Stop()
+
+	t.Run("notifies upserted objects", func(t *testing.T) {
+		g.Expect(idx.Add(ctx, []models.Object{obj})).To(Succeed())
+		g.Expect(idx.Add(ctx, []models.Object{obj})).To(Succeed())
+
+		g.Expect(watch.Changed()).To(Receive())
+		g.Expect(watch.Changed()).NotTo(Receive(), "changes should be coalesced")
+		g.Expect(watch.Take()).To(Equal(store.Changes{
+			Upserted: map[string]bool{obj.GetID(): true},
+			Removed:  map[string]bool{},
+		}))
+		g.Expect(watch.Take()).To(Equal(store.Changes{
+			Upserted: map[string]bool{},
+			Removed:  map[string]bool{},
+		}))
+	})
+
+	t.Run("notifies removed objects", func(t *testing.T) {
+		g.Expect(idx.Remove(ctx, []models.Object{obj})).To(Succeed())
+
+		g.Expect(watch.Changed()).To(Receive())
+		g.Expect(watch.Take()).To(Equal(store.Changes{
+			Upserted: map[string]bool{},
+			Removed:  map[string]bool{obj.GetID(): true},
+		}))
+	})
+
+	t.Run("keeps the last change of an object", func(t *testing.T) {
+		g.Expect(idx.Remove(ctx, []models.Object{obj})).To(Succeed())
+		g.Expect(idx.Add(ctx, []models.Object{obj})).To(Succeed())
+
+		g.Expect(watch.Changed()).To(Receive())
+		changes := watch.Take()
+		g.Expect(changes.Upserted).To(HaveKey(obj.GetID()))
+		g.Expect(changes.Removed).To(BeEmpty())
+	})
+
+	t.Run("notifies access rules changes", func(t *testing.T) {
+		g.Expect(s.StoreRoles(ctx, []models.Role{})).To(Succeed())
+
+		g.Expect(watch.Changed()).To(Receive())
+		g.Expect(watch.Take().Unscoped).To(BeTrue())
+	})
+
+	t.Run("does not notify objects stored before they are indexed", func(t *testing.T) {
+		g.Expect(s.StoreObjects(ctx, []models.Object{obj})).To(Succeed())
+
+		g.Expect(watch.Changed()).NotTo(Receive())
+	})
+
+	t.Run("does not notify stopped watches", func(t *testing.T) {
+		stopped := b.Watch()
+		stopped.Stop()
+
+		g.Expect(idx.Add(ctx, []models.Object{obj})).To(Succeed())
+
+		g.Expect(stopped.Changed()).NotTo(Receive())
+	})
+}
@@ -52,6 +52,17 @@ func NewTenantsCollector(s store.Store, mgr clusters.Subscriber, sa collector.Im
 		Name:           "tenants",
 		Log:            log,
 		NewWatcherFunc: newWatcher,
+		CountObjectsFunc: func(ctx context.Context) (map[string]int, error) {
+			tenants, err := s.GetTenants(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get tenants: %w", err)
+			}
+			counts := map[string]int{}
+			for _, tenant := range tenants {
+				counts[tenant.ClusterName]++
+			}
+			return counts, nil
+		},
 		Clusters:       mgr,
 		ServiceAccount: sa,
 	}
@@ -15,6 +15,13 @@ export enum EnabledComponent {
   clusterdiscovery = "clusterdiscovery",
 }
 
+export enum WatchEventType {
+  snapshot = "snapshot",
+  added = "added",
+  updated = "updated",
+  deleted = "deleted",
+}
+
 export type DoQueryRequest = {
   terms?: string
   filters?: string[]
@@ -22,12 +29,208 @@ export type DoQueryRequest = {
   limit?: number
   orderBy?: string
   descending?: boolean
+  filterGroups?: FilterGroup[]
+  asOf?: string
+}
+
+export type QueryFilter = {
+  field?: string
+  operand?: string
+  values?: string[]
+  min?: string
+  max?: string
+}
+
+export type FilterGroup = {
+  operand?: string
+  filters?: QueryFilter[]
 }
 
 export type DoQueryResponse = {
   objects?: Object[]
 }
 
+export type GetObjectHistoryRequest = {
+  id?: string
+}
+
+export type GetObjectHistoryResponse = {
+  transitions?: ObjectTransition[]
+}
+
+export type ObjectTransition = {
+  status?: string
+  message?: string
+  deleted?: boolean
+  timestamp?: string
+}
+
+export type GetObjectRelationsRequest = {
+  id?: string
+  depth?: number
+}
+
+export type GetObjectRelationsResponse = {
+  objects?: RelatedObject[]
+  relations?: ObjectRelation[]
+}
+
+export type ObjectReference = {
+  cluster?: string
+  namespace?: string
+  apiGroup?: string
+  kind?: string
+  name?: string
+}
+
+export type RelatedObject = {
+  reference?: ObjectReference
+  object?: Object
+  direction?: string
+  depth?: number
+}
+
+export type ObjectRelation = {
+  from?: ObjectReference
+  to?: ObjectReference
+}
+
+export type CompareObjectsRequest = {
+  kind?: string
+  namespace?: string
+  name?: string
+  ids?: string[]
+  baselineCluster?: string
+}
+
+export type CompareObjectsResponse = {
+  baseline?: Object
+  comparisons?: ObjectComparison[]
+}
+
+export type ObjectComparison = {
+  object?: Object
+  spec?: FieldDifference[]
+  status?: FieldDifference[]
+  labels?: FieldDifference[]
+}
+
+export type FieldDifference = {
+  path?: string
+  change?: string
+  baselineValue?: string
+  value?: string
+}
+
+export type DoAggregateRequest = {
+  terms?: string
+  filters?: string[]
+  groupBy?: string[]
+  filterGroups?: FilterGroup[]
+}
+
+export type DoAggregateResponse = {
+  buckets?: AggregateBucket[]
+  total?: number
+}
+
+export type AggregateBucket = {
+  group?: {[key: string]: string}
+  count?: number
+}
+
+export type WatchQueryRequest = {
+  terms?: string
+  filters?: string[]
+  orderBy?: string
+  descending?: boolean
+  filterGroups?: FilterGroup[]
+}
+
+export type WatchQueryResponse = {
+  type?: WatchEventType
+  objects?: Object[]
+}
+
+export type SavedQuery = {
+  id?: string
+  name?: string
+  terms?: string
+  filters?: string[]
+  orderBy?: string
+  descending?: boolean
+  owner?: string
+  visibility?: string
+  group?: string
+  readOnly?: boolean
+  createdAt?: string
+  updatedAt?: string
+  filterGroups?: FilterGroup[]
+}
+
+export type ListSavedQueriesRequest = {
+}
+
+export type ListSavedQueriesResponse = {
+  savedQueries?: SavedQuery[]
+}
+
+export type GetSavedQueryRequest = {
+  id?: string
+}
+
+export type GetSavedQueryResponse = {
+  savedQuery?: SavedQuery
+}
+
+export type CreateSavedQueryRequest = {
+  name?: string
+  terms?: string
+  filters?: string[]
+  orderBy?: string
+  descending?: boolean
+  visibility?: string
+  group?: string
+  filterGroups?: FilterGroup[]
+}
+
+export type CreateSavedQueryResponse = {
+  savedQuery?: SavedQuery
+}
+
+export type UpdateSavedQueryRequest = {
+  id?: string
+  name?: string
+  terms?: string
+  filters?: string[]
+  orderBy?: string
+  descending?: boolean
+  visibility?: string
+  group?: string
+  filterGroups?: FilterGroup[]
+}
+
+export type UpdateSavedQueryResponse = {
+  savedQuery?: SavedQuery
+}
+
+export type DeleteSavedQueryRequest = {
+  id?: string
+}
+
+export type DeleteSavedQueryResponse = {
+}
+
+export type ExecuteSavedQueryRequest = {
+  id?: string
+  offset?: number
+  limit?: number
+}
+
+export type ExecuteSavedQueryResponse = {
+  objects?: Object[]
+}
+
 export type Object = {
   cluster?: string
   namespace?: string
@@ -66,6 +269,157 @@ export type Subject = {
   name?: string
 }
 
+export type ExplainAccessRequest = {
+  user?: string
+  groups?: string[]
+  id?: string
+  reference?: ObjectReference
+}
+
+export type ExplainAccessResponse = {
+  allowed?: boolean
+  grants?: AccessGrant[]
+  nearMisses?: AccessGrant[]
+  error?: string
+}
+
+export type AccessGrant = {
+  cluster?: string
+  roleKind?: string
+  roleNamespace?: string
+  roleName?: string
+  bindingKind?: string
+  bindingNamespace?: string
+  bindingName?: string
+  apiGroups?: string[]
+  resources?: string[]
+  verbs?: string[]
+  resourceNames?: string[]
+  mismatches?: string[]
+}
+
+export type RebuildIndexRequest = {
+}
+
+export type RebuildIndexResponse = {
+  count?: number
+}
+
+export type CheckIndexRequest = {
+}
+
+export type CheckIndexResponse = {
+  consistent?: boolean
+  storeCount?: number
+  indexCount?: number
+  missingFromIndex?: string[]
+  missingFromStore?: string[]
+}
+
+export type ListCollectorStatusRequest = {
+}
+
+export type ListCollectorStatusResponse = {
+  statuses?: CollectorStatus[]
+}
+
+export type CollectorStatus = {
+  collector?: string
+  cluster?: string
+  state?: string
+  lastSyncedAt?: string
+  error?: string
+  objectCount?: number
+  retries?: number
+  nextRetryAt?: string
+  replica?: string
+}
+
+export type AlertRule = {
+  id?: string
+  name?: string
+  terms?: string
+  filters?: string[]
+  for?: string
+  webhookUrl?: string
+  webhookFormat?: string
+  owner?: string
+  createdAt?: string
+  updatedAt?: string
+  state?: AlertRuleState
+}
+
+export type AlertRuleState = {
+  state?: string
+  matchingCount?: number
+  firing?: AlertObject[]
+  firingSince?: string
+  resolvedAt?: string
+  lastEvaluatedAt?: string
+  lastNotifiedAt?: string
+  lastError?: string
+}
+
+export type AlertObject = {
+  id?: string
+  cluster?: string
+  namespace?: string
+  kind?: string
+  name?: string
+  status?: string
+  message?: string
+  since?: string
+}
+
+export type ListAlertRulesRequest = {
+}
+
+export type ListAlertRulesResponse = {
+  rules?: AlertRule[]
+}
+
+export type GetAlertRuleRequest = {
+  id?: string
+}
+
+export type GetAlertRuleResponse = {
+  rule?: AlertRule
+}
+
+export type CreateAlertRuleRequest = {
+  name?: string
+  terms?: string
+  filters?: string[]
+  for?: string
+  webhookUrl?: string
+  webhookFormat?: string
+}
+
+export type CreateAlertRuleResponse = {
+  rule?: AlertRule
+}
+
+export type UpdateAlertRuleRequest = {
+  id?: string
+  name?: string
+  terms?: string
+  filters?: string[]
+  for?: string
+  webhookUrl?: string
+  webhookFormat?: string
+}
+
+export type UpdateAlertRuleResponse = {
+  rule?: AlertRule
+}
+
+export type DeleteAlertRuleRequest = {
+  id?: string
+}
+
+export type DeleteAlertRuleResponse = {
+}
+
 export type ListFacetsRequest = {
   category?: string
 }
@@ -91,12 +445,72 @@ export class Query {
   static DoQuery(req: DoQueryRequest, initReq?: fm.InitReq): Promise<DoQueryResponse> {
     return fm.fetchReq<DoQueryRequest, DoQueryResponse>(`/v1/query`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
   }
+  static GetObjectHistory(req: GetObjectHistoryRequest, initReq?: fm.InitReq): Promise<GetObjectHistoryResponse> {
+    return fm.fetchReq<GetObjectHistoryRequest, GetObjectHistoryResponse>(`/v1/query/history?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
+  }
+  static GetObjectRelations(req: GetObjectRelationsRequest, initReq?: fm.InitReq): Promise<GetObjectRelationsResponse> {
+    return fm.fetchReq<GetObjectRelationsRequest, GetObjectRelationsResponse>(`/v1/query/relations?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
+  }
+  static CompareObjects(req: CompareObjectsRequest, initReq?: fm.InitReq): Promise<CompareObjectsResponse> {
+    return fm.fetchReq<CompareObjectsRequest, CompareObjectsResponse>(`/v1/query/compare?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
+  }
+  static DoAggregate(req: DoAggregateRequest, initReq?: fm.InitReq): Promise<DoAggregateResponse> {
+    return fm.fetchReq<DoAggregateRequest, DoAggregateResponse>(`/v1/query/aggregate`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static WatchQuery(req: WatchQueryRequest, entityNotifier?: fm.NotifyStreamEntityArrival<WatchQueryResponse>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<WatchQueryRequest, WatchQueryResponse>(`/v1/query/watch?${fm.renderURLSearchParams(req, [])}`, entityNotifier, {...initReq, method: "GET"})
+  }
+  static ListSavedQueries(req: ListSavedQueriesRequest, initReq?: fm.InitReq): Promise<ListSavedQueriesResponse> {
+    return fm.fetchReq<ListSavedQueriesRequest, ListSavedQueriesResponse>(`/v1/query/saved-queries?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
+  }
+  static GetSavedQuery(req: GetSavedQueryRequest, initReq?: fm.InitReq): Promise<GetSavedQueryResponse> {
+    return fm.fetchReq<GetSavedQueryRequest, GetSavedQueryResponse>(`/v1/query/saved-queries/${req["id"]}?${fm.renderURLSearchParams(req, ["id"])}`, {...initReq, method: "GET"})
+  }
+  static CreateSavedQuery(req: CreateSavedQueryRequest, initReq?: fm.InitReq): Promise<CreateSavedQueryResponse> {
+    return fm.fetchReq<CreateSavedQueryRequest, CreateSavedQueryResponse>(`/v1/query/saved-queries`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static UpdateSavedQuery(req: UpdateSavedQueryRequest, initReq?: fm.InitReq): Promise<UpdateSavedQueryResponse> {
+    return fm.fetchReq<UpdateSavedQueryRequest, UpdateSavedQueryResponse>(`/v1/query/saved-queries/${req["id"]}`, {...initReq, method: "PUT", body: JSON.stringify(req, fm.replacer)})
+  }
+  static DeleteSavedQuery(req: DeleteSavedQueryRequest, initReq?: fm.InitReq): Promise<DeleteSavedQueryResponse> {
+    return fm.fetchReq<DeleteSavedQueryRequest, DeleteSavedQueryResponse>(`/v1/query/saved-queries/${req["id"]}`, {...initReq, method: "DELETE"})
+  }
+  static ExecuteSavedQuery(req: ExecuteSavedQueryRequest, initReq?: fm.InitReq): Promise<ExecuteSavedQueryResponse> {
+    return fm.fetchReq<ExecuteSavedQueryRequest, ExecuteSavedQueryResponse>(`/v1/query/saved-queries/${req["id"]}/execute`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
   static ListFacets(req: ListFacetsRequest, initReq?: fm.InitReq): Promise<ListFacetsResponse> {
     return fm.fetchReq<ListFacetsRequest, ListFacetsResponse>(`/v1/facets?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
   }
   static DebugGetAccessRules(req: DebugGetAccessRulesRequest, initReq?: fm.InitReq): Promise<DebugGetAccessRulesResponse> {
     return fm.fetchReq<DebugGetAccessRulesRequest, DebugGetAccessRulesResponse>(`/v1/debug/access-rules?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
   }
+  static ExplainAccess(req: ExplainAccessRequest, initReq?: fm.InitReq): Promise<ExplainAccessResponse> {
+    return fm.fetchReq<ExplainAccessRequest, ExplainAccessResponse>(`/v1/debug/explain-access`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static RebuildIndex(req: RebuildIndexRequest, initReq?: fm.InitReq): Promise<RebuildIndexResponse> {
+    return fm.fetchReq<RebuildIndexRequest, RebuildIndexResponse>(`/v1/debug/index/rebuild`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static CheckIndex(req: CheckIndexRequest, initReq?: fm.InitReq): Promise<CheckIndexResponse> {
+    return fm.fetchReq<CheckIndexRequest, CheckIndexResponse>(`/v1/debug/index/check?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
+  }
+  static ListCollectorStatus(req: ListCollectorStatusRequest, initReq?: fm.InitReq): Promise<ListCollectorStatusResponse> {
+    return fm.fetchReq<ListCollectorStatusRequest, ListCollectorStatusResponse>(`/v1/collectors/status?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
+  }
+  static ListAlertRules(req: ListAlertRulesRequest, initReq?: fm.InitReq): Promise<ListAlertRulesResponse> {
+    return fm.fetchReq<ListAlertRulesRequest, ListAlertRulesResponse>(`/v1/alerts/rules?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
+  }
+  static GetAlertRule(req: GetAlertRuleRequest, initReq?: fm.InitReq): Promise<GetAlertRuleResponse> {
+    return fm.fetchReq<GetAlertRuleRequest, GetAlertRuleResponse>(`/v1/alerts/rules/${req["id"]}?${fm.renderURLSearchParams(req, ["id"])}`, {...initReq, method: "GET"})
+  }
+  static CreateAlertRule(req: CreateAlertRuleRequest, initReq?: fm.InitReq): Promise<CreateAlertRuleResponse> {
+    return fm.fetchReq<CreateAlertRuleRequest, CreateAlertRuleResponse>(`/v1/alerts/rules`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static UpdateAlertRule(req: UpdateAlertRuleRequest, initReq?: fm.InitReq): Promise<UpdateAlertRuleResponse> {
+    return fm.fetchReq<UpdateAlertRuleRequest, UpdateAlertRuleResponse>(`/v1/alerts/rules/${req["id"]}`, {...initReq, method: "PUT", body: JSON.stringify(req, fm.replacer)})
+  }
+  static DeleteAlertRule(req: DeleteAlertRuleRequest, initReq?: fm.InitReq): Promise<DeleteAlertRuleResponse> {
+    return fm.fetchReq<DeleteAlertRuleRequest, DeleteAlertRuleResponse>(`/v1/alerts/rules/${req["id"]}`, {...initReq, method: "DELETE"})
+  }
   static ListEnabledComponents(req: ListEnabledComponentsRequest, initReq?: fm.InitReq): Promise<ListEnabledComponentsResponse> {
     return fm.fetchReq<ListEnabledComponentsRequest, ListEnabledComponentsResponse>(`/v1/enabled-components?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
   }
@@ -48,6 +48,7 @@ export type ListTemplateParamsRequest = {
 export type ListTemplateParamsResponse = {
   parameters?: Parameter[]
   objects?: TemplateObject[]
+  schema?: GoogleProtobufStruct.Struct
 }
 
 export type ListTemplateProfilesRequest = {
@@ -200,6 +201,29 @@ export type CreateDeletionPullRequestResponse = {
   webUrl?: string
 }
 
+export type UpgradeFromTemplateRequest = {
+  name?: string
+  namespace?: string
+  templateKind?: string
+  resources?: TemplatedResources[]
+  repositoryUrl?: string
+  headBranch?: string
+  baseBranch?: string
+  title?: string
+  description?: string
+  commitMessage?: string
+}
+
+export type TemplatedResources = {
+  createRequest?: string
+  createdFiles?: string[]
+}
+
+export type UpgradeFromTemplateResponse = {
+  webUrl?: string
+  upgraded?: number
+}
+
 export type ListCredentialsRequest = {
 }
 
@@ -873,6 +897,9 @@ export class ClustersService {
   static CreateDeletionPullRequest(req: CreateDeletionPullRequestRequest, initReq?: fm.InitReq): Promise<CreateDeletionPullRequestResponse> {
     return fm.fetchReq<CreateDeletionPullRequestRequest, CreateDeletionPullRequestResponse>(`/v1/templates/deletion-pull-request`, {...initReq, method: "POST", body: JSON.stringify(req)})
   }
+  static UpgradeFromTemplate(req: UpgradeFromTemplateRequest, initReq?: fm.InitReq): Promise<UpgradeFromTemplateResponse> {
+    return fm.fetchReq<UpgradeFromTemplateRequest, UpgradeFromTemplateResponse>(`/v1/namespaces/${req["namespace"]}/templates/${req["name"]}/upgrade-pull-request`, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
   static RenderAutomation(req: RenderAutomationRequest, initReq?: fm.InitReq): Promise<RenderAutomationResponse> {
     return fm.fetchReq<RenderAutomationRequest, RenderAutomationResponse>(`/v1/automations/render`, {...initReq, method: "POST", body: JSON.stringify(req)})
   }
@@ -31,7 +31,10 @@ type Props = {
     | 'source'
     | 'gitopsset'
     | 'template'
-    | 'clusterdiscovery';
+    | 'clusterdiscovery'
+    | 'terraform'
+    | 'pipeline'
+    | 'policy';
   enableBatchSync?: boolean;
   manager?: QueryStateManager;
   fields?: ExplorerField[];
@@ -192,6 +195,9 @@ const categoryKinds = {
   gitopsset: ['GitOpsSet'],
   template: ['Template'],
   clusterdiscovery: ['AutomatedClusterDiscovery'],
+  terraform: ['Terraform'],
+  pipeline: ['Pipeline'],
+  policy: ['Policy', 'PolicyConfig'],
 };
 
 function filterFacetsForCategory(
@@ -201,7 +207,10 @@ function filterFacetsForCategory(
     | 'source'
     | 'gitopsset'
     | 'template'
-    | 'clusterdiscovery',
+    | 'clusterdiscovery'
+    | 'terraform'
+    | 'pipeline'
+    | 'policy',
 ): Facet[] {
   if (!category) {
     return _.sortBy(facets, 'field') as Facet[];